@@ -0,0 +1,29 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/allenkallz/provider-snowflake/internal/protection"
+)
+
+// +kubebuilder:webhook:verbs=delete,path=/validate-account-snowflake-com-v1alpha1-account,mutating=false,failurePolicy=fail,groups=account.snowflake.com,resources=accounts,versions=v1alpha1,name=accounts.account.snowflake.com,sideEffects=None,admissionReviewVersions=v1
+
+var _ admission.Validator = &Account{}
+
+// ValidateCreate implements admission.Validator. Creates are always allowed.
+func (tr *Account) ValidateCreate() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.Validator. Updates are always allowed so
+// that the deletion protection annotation can be lifted.
+func (tr *Account) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements admission.Validator. It refuses to delete a
+// Account carrying the deletion protection annotation.
+func (tr *Account) ValidateDelete() (admission.Warnings, error) {
+	return nil, protection.ValidateDelete(tr)
+}
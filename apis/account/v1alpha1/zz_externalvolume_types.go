@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DescribeOutputInitParameters struct {
+}
+
+type DescribeOutputObservation struct {
+	Default *string `json:"default,omitempty" tf:"default,omitempty"`
+
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	Parent *string `json:"parent,omitempty" tf:"parent,omitempty"`
+
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type DescribeOutputParameters struct {
+}
+
+type ExternalVolumeInitParameters struct {
+
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether write operations are allowed for the external volume; must be set to TRUE for Iceberg tables that use Snowflake as the catalog. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	AllowWrites *string `json:"allowWrites,omitempty" tf:"allow_writes,omitempty"`
+
+	// Specifies a comment for the external volume.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// Identifier for the external volume; must be unique for your account. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// List of named cloud storage locations in different regions and, optionally, cloud platforms. Minimum 1 required. The order of the list is important as it impacts the active storage location, and updates will be triggered if it changes. Note that not all parameter combinations are valid as they depend on the given storage_provider. Consult [the docs](https://docs.snowflake.com/en/sql-reference/sql/create-external-volume#cloud-provider-parameters-cloudproviderparams) for more details on this.
+	StorageLocation []StorageLocationInitParameters `json:"storageLocation,omitempty" tf:"storage_location,omitempty"`
+}
+
+type ExternalVolumeObservation struct {
+
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether write operations are allowed for the external volume; must be set to TRUE for Iceberg tables that use Snowflake as the catalog. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	AllowWrites *string `json:"allowWrites,omitempty" tf:"allow_writes,omitempty"`
+
+	// Specifies a comment for the external volume.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// Outputs the result of `DESCRIBE EXTERNAL VOLUME` for the given external volume.
+	DescribeOutput []DescribeOutputObservation `json:"describeOutput,omitempty" tf:"describe_output,omitempty"`
+
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Identifier for the external volume; must be unique for your account. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Outputs the result of `SHOW EXTERNAL VOLUMES` for the given external volume.
+	ShowOutput []ExternalVolumeShowOutputObservation `json:"showOutput,omitempty" tf:"show_output,omitempty"`
+
+	// List of named cloud storage locations in different regions and, optionally, cloud platforms. Minimum 1 required. The order of the list is important as it impacts the active storage location, and updates will be triggered if it changes. Note that not all parameter combinations are valid as they depend on the given storage_provider. Consult [the docs](https://docs.snowflake.com/en/sql-reference/sql/create-external-volume#cloud-provider-parameters-cloudproviderparams) for more details on this.
+	StorageLocation []StorageLocationObservation `json:"storageLocation,omitempty" tf:"storage_location,omitempty"`
+}
+
+type ExternalVolumeParameters struct {
+
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether write operations are allowed for the external volume; must be set to TRUE for Iceberg tables that use Snowflake as the catalog. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// +kubebuilder:validation:Optional
+	AllowWrites *string `json:"allowWrites,omitempty" tf:"allow_writes,omitempty"`
+
+	// Specifies a comment for the external volume.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// Identifier for the external volume; must be unique for your account. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// List of named cloud storage locations in different regions and, optionally, cloud platforms. Minimum 1 required. The order of the list is important as it impacts the active storage location, and updates will be triggered if it changes. Note that not all parameter combinations are valid as they depend on the given storage_provider. Consult [the docs](https://docs.snowflake.com/en/sql-reference/sql/create-external-volume#cloud-provider-parameters-cloudproviderparams) for more details on this.
+	// +kubebuilder:validation:Optional
+	StorageLocation []StorageLocationParameters `json:"storageLocation,omitempty" tf:"storage_location,omitempty"`
+}
+
+type ExternalVolumeShowOutputInitParameters struct {
+}
+
+type ExternalVolumeShowOutputObservation struct {
+	AllowWrites *bool `json:"allowWrites,omitempty" tf:"allow_writes,omitempty"`
+
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+}
+
+type ExternalVolumeShowOutputParameters struct {
+}
+
+type StorageLocationInitParameters struct {
+
+	// Specifies the ID for your Office 365 tenant that the allowed and blocked storage accounts belong to.
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// Specifies the ID for the KMS-managed key used to encrypt files.
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// Specifies the encryption type used.
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	// Specifies the case-sensitive Amazon Resource Name (ARN) of the AWS identity and access management (IAM) role that grants privileges on the S3 bucket containing your data files.
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// Specifies the base URL for your cloud storage location.
+	StorageBaseURL *string `json:"storageBaseUrl,omitempty" tf:"storage_base_url,omitempty"`
+
+	// Name of the storage location. Must be unique for the external volume. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	StorageLocationName *string `json:"storageLocationName,omitempty" tf:"storage_location_name,omitempty"`
+
+	// Specifies the cloud storage provider that stores your data files. Valid values are (case-insensitive): `GCS` | `AZURE` | `S3` | `S3GOV`.
+	StorageProvider *string `json:"storageProvider,omitempty" tf:"storage_provider,omitempty"`
+}
+
+type StorageLocationObservation struct {
+
+	// Specifies the ID for your Office 365 tenant that the allowed and blocked storage accounts belong to.
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// Specifies the ID for the KMS-managed key used to encrypt files.
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// Specifies the encryption type used.
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	// External ID that Snowflake uses to establish a trust relationship with AWS.
+	StorageAwsExternalID *string `json:"storageAwsExternalId,omitempty" tf:"storage_aws_external_id,omitempty"`
+
+	// Specifies the case-sensitive Amazon Resource Name (ARN) of the AWS identity and access management (IAM) role that grants privileges on the S3 bucket containing your data files.
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// Specifies the base URL for your cloud storage location.
+	StorageBaseURL *string `json:"storageBaseUrl,omitempty" tf:"storage_base_url,omitempty"`
+
+	// Name of the storage location. Must be unique for the external volume. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	StorageLocationName *string `json:"storageLocationName,omitempty" tf:"storage_location_name,omitempty"`
+
+	// Specifies the cloud storage provider that stores your data files. Valid values are (case-insensitive): `GCS` | `AZURE` | `S3` | `S3GOV`.
+	StorageProvider *string `json:"storageProvider,omitempty" tf:"storage_provider,omitempty"`
+}
+
+type StorageLocationParameters struct {
+
+	// Specifies the ID for your Office 365 tenant that the allowed and blocked storage accounts belong to.
+	// +kubebuilder:validation:Optional
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// Specifies the ID for the KMS-managed key used to encrypt files.
+	// +kubebuilder:validation:Optional
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// Specifies the encryption type used.
+	// +kubebuilder:validation:Optional
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	// Specifies the case-sensitive Amazon Resource Name (ARN) of the AWS identity and access management (IAM) role that grants privileges on the S3 bucket containing your data files.
+	// +kubebuilder:validation:Optional
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// Specifies the base URL for your cloud storage location.
+	// +kubebuilder:validation:Optional
+	StorageBaseURL *string `json:"storageBaseUrl" tf:"storage_base_url,omitempty"`
+
+	// Name of the storage location. Must be unique for the external volume. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +kubebuilder:validation:Optional
+	StorageLocationName *string `json:"storageLocationName" tf:"storage_location_name,omitempty"`
+
+	// Specifies the cloud storage provider that stores your data files. Valid values are (case-insensitive): `GCS` | `AZURE` | `S3` | `S3GOV`.
+	// +kubebuilder:validation:Optional
+	StorageProvider *string `json:"storageProvider" tf:"storage_provider,omitempty"`
+}
+
+// ExternalVolumeSpec defines the desired state of ExternalVolume
+type ExternalVolumeSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ExternalVolumeParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider ExternalVolumeInitParameters `json:"initProvider,omitempty"`
+}
+
+// ExternalVolumeStatus defines the observed state of ExternalVolume.
+type ExternalVolumeStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ExternalVolumeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ExternalVolume is the Schema for the ExternalVolumes API. <no value>
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.storageLocation) || (has(self.initProvider) && has(self.initProvider.storageLocation))",message="spec.forProvider.storageLocation is a required parameter"
+	Spec   ExternalVolumeSpec   `json:"spec"`
+	Status ExternalVolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalVolumeList contains a list of ExternalVolumes
+type ExternalVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalVolume `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ExternalVolume_Kind             = "ExternalVolume"
+	ExternalVolume_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ExternalVolume_Kind}.String()
+	ExternalVolume_KindAPIVersion   = ExternalVolume_Kind + "." + CRDGroupVersion.String()
+	ExternalVolume_GroupVersionKind = CRDGroupVersion.WithKind(ExternalVolume_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalVolume{}, &ExternalVolumeList{})
+}
@@ -11,3 +11,15 @@ func (tr *Account) Hub() {}
 
 // Hub marks this type as a conversion hub.
 func (tr *AccountRole) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ExternalVolume) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ResourceMonitor) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *User) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Warehouse) Hub() {}
@@ -10,9 +10,79 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbortDetachedQueryInitParameters) DeepCopyInto(out *AbortDetachedQueryInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbortDetachedQueryInitParameters.
+func (in *AbortDetachedQueryInitParameters) DeepCopy() *AbortDetachedQueryInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AbortDetachedQueryInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbortDetachedQueryObservation) DeepCopyInto(out *AbortDetachedQueryObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbortDetachedQueryObservation.
+func (in *AbortDetachedQueryObservation) DeepCopy() *AbortDetachedQueryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AbortDetachedQueryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbortDetachedQueryParameters) DeepCopyInto(out *AbortDetachedQueryParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbortDetachedQueryParameters.
+func (in *AbortDetachedQueryParameters) DeepCopy() *AbortDetachedQueryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AbortDetachedQueryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Account) DeepCopyInto(out *Account) {
 	*out = *in
@@ -635,191 +705,8000 @@ func (in *AccountStatus) DeepCopy() *AccountStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShowOutputInitParameters) DeepCopyInto(out *ShowOutputInitParameters) {
+func (in *AutocommitInitParameters) DeepCopyInto(out *AutocommitInitParameters) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputInitParameters.
-func (in *ShowOutputInitParameters) DeepCopy() *ShowOutputInitParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutocommitInitParameters.
+func (in *AutocommitInitParameters) DeepCopy() *AutocommitInitParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ShowOutputInitParameters)
+	out := new(AutocommitInitParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShowOutputObservation) DeepCopyInto(out *ShowOutputObservation) {
+func (in *AutocommitObservation) DeepCopyInto(out *AutocommitObservation) {
 	*out = *in
-	if in.AccountLocator != nil {
-		in, out := &in.AccountLocator, &out.AccountLocator
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
 		*out = new(string)
 		**out = **in
 	}
-	if in.AccountLocatorURL != nil {
-		in, out := &in.AccountLocatorURL, &out.AccountLocatorURL
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.AccountName != nil {
-		in, out := &in.AccountName, &out.AccountName
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.AccountOldURLLastUsed != nil {
-		in, out := &in.AccountOldURLLastUsed, &out.AccountOldURLLastUsed
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
 		*out = new(string)
 		**out = **in
 	}
-	if in.AccountOldURLSavedOn != nil {
-		in, out := &in.AccountOldURLSavedOn, &out.AccountOldURLSavedOn
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
-	if in.AccountURL != nil {
-		in, out := &in.AccountURL, &out.AccountURL
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutocommitObservation.
+func (in *AutocommitObservation) DeepCopy() *AutocommitObservation {
+	if in == nil {
+		return nil
 	}
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
+	out := new(AutocommitObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutocommitParameters) DeepCopyInto(out *AutocommitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutocommitParameters.
+func (in *AutocommitParameters) DeepCopy() *AutocommitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AutocommitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryInputFormatInitParameters) DeepCopyInto(out *BinaryInputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryInputFormatInitParameters.
+func (in *BinaryInputFormatInitParameters) DeepCopy() *BinaryInputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BinaryInputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryInputFormatObservation) DeepCopyInto(out *BinaryInputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
 		*out = new(string)
 		**out = **in
 	}
-	if in.ConsumptionBillingEntityName != nil {
-		in, out := &in.ConsumptionBillingEntityName, &out.ConsumptionBillingEntityName
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.CreatedOn != nil {
-		in, out := &in.CreatedOn, &out.CreatedOn
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.DroppedOn != nil {
-		in, out := &in.DroppedOn, &out.DroppedOn
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
 		*out = new(string)
 		**out = **in
 	}
-	if in.Edition != nil {
-		in, out := &in.Edition, &out.Edition
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsEventsAccount != nil {
-		in, out := &in.IsEventsAccount, &out.IsEventsAccount
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryInputFormatObservation.
+func (in *BinaryInputFormatObservation) DeepCopy() *BinaryInputFormatObservation {
+	if in == nil {
+		return nil
 	}
-	if in.IsOrgAdmin != nil {
-		in, out := &in.IsOrgAdmin, &out.IsOrgAdmin
-		*out = new(bool)
-		**out = **in
+	out := new(BinaryInputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryInputFormatParameters) DeepCopyInto(out *BinaryInputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryInputFormatParameters.
+func (in *BinaryInputFormatParameters) DeepCopy() *BinaryInputFormatParameters {
+	if in == nil {
+		return nil
 	}
-	if in.IsOrganizationAccount != nil {
-		in, out := &in.IsOrganizationAccount, &out.IsOrganizationAccount
-		*out = new(bool)
-		**out = **in
+	out := new(BinaryInputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryOutputFormatInitParameters) DeepCopyInto(out *BinaryOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryOutputFormatInitParameters.
+func (in *BinaryOutputFormatInitParameters) DeepCopy() *BinaryOutputFormatInitParameters {
+	if in == nil {
+		return nil
 	}
-	if in.ManagedAccounts != nil {
-		in, out := &in.ManagedAccounts, &out.ManagedAccounts
-		*out = new(float64)
+	out := new(BinaryOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryOutputFormatObservation) DeepCopyInto(out *BinaryOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
 		**out = **in
 	}
-	if in.MarketplaceConsumerBillingEntityName != nil {
-		in, out := &in.MarketplaceConsumerBillingEntityName, &out.MarketplaceConsumerBillingEntityName
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.MarketplaceProviderBillingEntityName != nil {
-		in, out := &in.MarketplaceProviderBillingEntityName, &out.MarketplaceProviderBillingEntityName
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.MovedOn != nil {
-		in, out := &in.MovedOn, &out.MovedOn
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
 		*out = new(string)
 		**out = **in
 	}
-	if in.MovedToOrganization != nil {
-		in, out := &in.MovedToOrganization, &out.MovedToOrganization
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
-	if in.OldAccountURL != nil {
-		in, out := &in.OldAccountURL, &out.OldAccountURL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryOutputFormatObservation.
+func (in *BinaryOutputFormatObservation) DeepCopy() *BinaryOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BinaryOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryOutputFormatParameters) DeepCopyInto(out *BinaryOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryOutputFormatParameters.
+func (in *BinaryOutputFormatParameters) DeepCopy() *BinaryOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BinaryOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMemoryLimitInitParameters) DeepCopyInto(out *ClientMemoryLimitInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMemoryLimitInitParameters.
+func (in *ClientMemoryLimitInitParameters) DeepCopy() *ClientMemoryLimitInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMemoryLimitInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMemoryLimitObservation) DeepCopyInto(out *ClientMemoryLimitObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationName != nil {
-		in, out := &in.OrganizationName, &out.OrganizationName
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationOldURL != nil {
-		in, out := &in.OrganizationOldURL, &out.OrganizationOldURL
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationOldURLLastUsed != nil {
-		in, out := &in.OrganizationOldURLLastUsed, &out.OrganizationOldURLLastUsed
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationOldURLSavedOn != nil {
-		in, out := &in.OrganizationOldURLSavedOn, &out.OrganizationOldURLSavedOn
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationURLExpirationOn != nil {
-		in, out := &in.OrganizationURLExpirationOn, &out.OrganizationURLExpirationOn
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMemoryLimitObservation.
+func (in *ClientMemoryLimitObservation) DeepCopy() *ClientMemoryLimitObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMemoryLimitObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMemoryLimitParameters) DeepCopyInto(out *ClientMemoryLimitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMemoryLimitParameters.
+func (in *ClientMemoryLimitParameters) DeepCopy() *ClientMemoryLimitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMemoryLimitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMetadataRequestUseConnectionCtxInitParameters) DeepCopyInto(out *ClientMetadataRequestUseConnectionCtxInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMetadataRequestUseConnectionCtxInitParameters.
+func (in *ClientMetadataRequestUseConnectionCtxInitParameters) DeepCopy() *ClientMetadataRequestUseConnectionCtxInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientMetadataRequestUseConnectionCtxInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientMetadataRequestUseConnectionCtxObservation) DeepCopyInto(out *ClientMetadataRequestUseConnectionCtxObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
 		*out = new(string)
 		**out = **in
 	}
-	if in.RegionGroup != nil {
-		in, out := &in.RegionGroup, &out.RegionGroup
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.RestoredOn != nil {
-		in, out := &in.RestoredOn, &out.RestoredOn
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.ScheduledDeletionTime != nil {
-		in, out := &in.ScheduledDeletionTime, &out.ScheduledDeletionTime
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
 		*out = new(string)
 		**out = **in
 	}
-	if in.SnowflakeRegion != nil {
-		in, out := &in.SnowflakeRegion, &out.SnowflakeRegion
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputObservation.
-func (in *ShowOutputObservation) DeepCopy() *ShowOutputObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMetadataRequestUseConnectionCtxObservation.
+func (in *ClientMetadataRequestUseConnectionCtxObservation) DeepCopy() *ClientMetadataRequestUseConnectionCtxObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ShowOutputObservation)
+	out := new(ClientMetadataRequestUseConnectionCtxObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShowOutputParameters) DeepCopyInto(out *ShowOutputParameters) {
+func (in *ClientMetadataRequestUseConnectionCtxParameters) DeepCopyInto(out *ClientMetadataRequestUseConnectionCtxParameters) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputParameters.
-func (in *ShowOutputParameters) DeepCopy() *ShowOutputParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientMetadataRequestUseConnectionCtxParameters.
+func (in *ClientMetadataRequestUseConnectionCtxParameters) DeepCopy() *ClientMetadataRequestUseConnectionCtxParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ShowOutputParameters)
+	out := new(ClientMetadataRequestUseConnectionCtxParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientPrefetchThreadsInitParameters) DeepCopyInto(out *ClientPrefetchThreadsInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientPrefetchThreadsInitParameters.
+func (in *ClientPrefetchThreadsInitParameters) DeepCopy() *ClientPrefetchThreadsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientPrefetchThreadsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientPrefetchThreadsObservation) DeepCopyInto(out *ClientPrefetchThreadsObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientPrefetchThreadsObservation.
+func (in *ClientPrefetchThreadsObservation) DeepCopy() *ClientPrefetchThreadsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientPrefetchThreadsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientPrefetchThreadsParameters) DeepCopyInto(out *ClientPrefetchThreadsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientPrefetchThreadsParameters.
+func (in *ClientPrefetchThreadsParameters) DeepCopy() *ClientPrefetchThreadsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientPrefetchThreadsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultChunkSizeInitParameters) DeepCopyInto(out *ClientResultChunkSizeInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultChunkSizeInitParameters.
+func (in *ClientResultChunkSizeInitParameters) DeepCopy() *ClientResultChunkSizeInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultChunkSizeInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultChunkSizeObservation) DeepCopyInto(out *ClientResultChunkSizeObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultChunkSizeObservation.
+func (in *ClientResultChunkSizeObservation) DeepCopy() *ClientResultChunkSizeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultChunkSizeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultChunkSizeParameters) DeepCopyInto(out *ClientResultChunkSizeParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultChunkSizeParameters.
+func (in *ClientResultChunkSizeParameters) DeepCopy() *ClientResultChunkSizeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultChunkSizeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultColumnCaseInsensitiveInitParameters) DeepCopyInto(out *ClientResultColumnCaseInsensitiveInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultColumnCaseInsensitiveInitParameters.
+func (in *ClientResultColumnCaseInsensitiveInitParameters) DeepCopy() *ClientResultColumnCaseInsensitiveInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultColumnCaseInsensitiveInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultColumnCaseInsensitiveObservation) DeepCopyInto(out *ClientResultColumnCaseInsensitiveObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultColumnCaseInsensitiveObservation.
+func (in *ClientResultColumnCaseInsensitiveObservation) DeepCopy() *ClientResultColumnCaseInsensitiveObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultColumnCaseInsensitiveObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResultColumnCaseInsensitiveParameters) DeepCopyInto(out *ClientResultColumnCaseInsensitiveParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResultColumnCaseInsensitiveParameters.
+func (in *ClientResultColumnCaseInsensitiveParameters) DeepCopy() *ClientResultColumnCaseInsensitiveParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResultColumnCaseInsensitiveParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyInitParameters) DeepCopyInto(out *ClientSessionKeepAliveHeartbeatFrequencyInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveHeartbeatFrequencyInitParameters.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyInitParameters) DeepCopy() *ClientSessionKeepAliveHeartbeatFrequencyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveHeartbeatFrequencyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyObservation) DeepCopyInto(out *ClientSessionKeepAliveHeartbeatFrequencyObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveHeartbeatFrequencyObservation.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyObservation) DeepCopy() *ClientSessionKeepAliveHeartbeatFrequencyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveHeartbeatFrequencyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyParameters) DeepCopyInto(out *ClientSessionKeepAliveHeartbeatFrequencyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveHeartbeatFrequencyParameters.
+func (in *ClientSessionKeepAliveHeartbeatFrequencyParameters) DeepCopy() *ClientSessionKeepAliveHeartbeatFrequencyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveHeartbeatFrequencyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveInitParameters) DeepCopyInto(out *ClientSessionKeepAliveInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveInitParameters.
+func (in *ClientSessionKeepAliveInitParameters) DeepCopy() *ClientSessionKeepAliveInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveObservation) DeepCopyInto(out *ClientSessionKeepAliveObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveObservation.
+func (in *ClientSessionKeepAliveObservation) DeepCopy() *ClientSessionKeepAliveObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSessionKeepAliveParameters) DeepCopyInto(out *ClientSessionKeepAliveParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSessionKeepAliveParameters.
+func (in *ClientSessionKeepAliveParameters) DeepCopy() *ClientSessionKeepAliveParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSessionKeepAliveParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientTimestampTypeMappingInitParameters) DeepCopyInto(out *ClientTimestampTypeMappingInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTimestampTypeMappingInitParameters.
+func (in *ClientTimestampTypeMappingInitParameters) DeepCopy() *ClientTimestampTypeMappingInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTimestampTypeMappingInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientTimestampTypeMappingObservation) DeepCopyInto(out *ClientTimestampTypeMappingObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTimestampTypeMappingObservation.
+func (in *ClientTimestampTypeMappingObservation) DeepCopy() *ClientTimestampTypeMappingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTimestampTypeMappingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientTimestampTypeMappingParameters) DeepCopyInto(out *ClientTimestampTypeMappingParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTimestampTypeMappingParameters.
+func (in *ClientTimestampTypeMappingParameters) DeepCopy() *ClientTimestampTypeMappingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTimestampTypeMappingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateInputFormatInitParameters) DeepCopyInto(out *DateInputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateInputFormatInitParameters.
+func (in *DateInputFormatInitParameters) DeepCopy() *DateInputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DateInputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateInputFormatObservation) DeepCopyInto(out *DateInputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateInputFormatObservation.
+func (in *DateInputFormatObservation) DeepCopy() *DateInputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DateInputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateInputFormatParameters) DeepCopyInto(out *DateInputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateInputFormatParameters.
+func (in *DateInputFormatParameters) DeepCopy() *DateInputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DateInputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateOutputFormatInitParameters) DeepCopyInto(out *DateOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateOutputFormatInitParameters.
+func (in *DateOutputFormatInitParameters) DeepCopy() *DateOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DateOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateOutputFormatObservation) DeepCopyInto(out *DateOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateOutputFormatObservation.
+func (in *DateOutputFormatObservation) DeepCopy() *DateOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DateOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateOutputFormatParameters) DeepCopyInto(out *DateOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateOutputFormatParameters.
+func (in *DateOutputFormatParameters) DeepCopy() *DateOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DateOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputInitParameters) DeepCopyInto(out *DescribeOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputInitParameters.
+func (in *DescribeOutputInitParameters) DeepCopy() *DescribeOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputObservation) DeepCopyInto(out *DescribeOutputObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputObservation.
+func (in *DescribeOutputObservation) DeepCopy() *DescribeOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputParameters) DeepCopyInto(out *DescribeOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputParameters.
+func (in *DescribeOutputParameters) DeepCopy() *DescribeOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnloadPhysicalTypeOptimizationInitParameters) DeepCopyInto(out *EnableUnloadPhysicalTypeOptimizationInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnloadPhysicalTypeOptimizationInitParameters.
+func (in *EnableUnloadPhysicalTypeOptimizationInitParameters) DeepCopy() *EnableUnloadPhysicalTypeOptimizationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnloadPhysicalTypeOptimizationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnloadPhysicalTypeOptimizationObservation) DeepCopyInto(out *EnableUnloadPhysicalTypeOptimizationObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnloadPhysicalTypeOptimizationObservation.
+func (in *EnableUnloadPhysicalTypeOptimizationObservation) DeepCopy() *EnableUnloadPhysicalTypeOptimizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnloadPhysicalTypeOptimizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnloadPhysicalTypeOptimizationParameters) DeepCopyInto(out *EnableUnloadPhysicalTypeOptimizationParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnloadPhysicalTypeOptimizationParameters.
+func (in *EnableUnloadPhysicalTypeOptimizationParameters) DeepCopy() *EnableUnloadPhysicalTypeOptimizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnloadPhysicalTypeOptimizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnredactedQuerySyntaxErrorInitParameters) DeepCopyInto(out *EnableUnredactedQuerySyntaxErrorInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnredactedQuerySyntaxErrorInitParameters.
+func (in *EnableUnredactedQuerySyntaxErrorInitParameters) DeepCopy() *EnableUnredactedQuerySyntaxErrorInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnredactedQuerySyntaxErrorInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnredactedQuerySyntaxErrorObservation) DeepCopyInto(out *EnableUnredactedQuerySyntaxErrorObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnredactedQuerySyntaxErrorObservation.
+func (in *EnableUnredactedQuerySyntaxErrorObservation) DeepCopy() *EnableUnredactedQuerySyntaxErrorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnredactedQuerySyntaxErrorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnableUnredactedQuerySyntaxErrorParameters) DeepCopyInto(out *EnableUnredactedQuerySyntaxErrorParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnableUnredactedQuerySyntaxErrorParameters.
+func (in *EnableUnredactedQuerySyntaxErrorParameters) DeepCopy() *EnableUnredactedQuerySyntaxErrorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnableUnredactedQuerySyntaxErrorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicMergeInitParameters) DeepCopyInto(out *ErrorOnNondeterministicMergeInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicMergeInitParameters.
+func (in *ErrorOnNondeterministicMergeInitParameters) DeepCopy() *ErrorOnNondeterministicMergeInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicMergeInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicMergeObservation) DeepCopyInto(out *ErrorOnNondeterministicMergeObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicMergeObservation.
+func (in *ErrorOnNondeterministicMergeObservation) DeepCopy() *ErrorOnNondeterministicMergeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicMergeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicMergeParameters) DeepCopyInto(out *ErrorOnNondeterministicMergeParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicMergeParameters.
+func (in *ErrorOnNondeterministicMergeParameters) DeepCopy() *ErrorOnNondeterministicMergeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicMergeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicUpdateInitParameters) DeepCopyInto(out *ErrorOnNondeterministicUpdateInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicUpdateInitParameters.
+func (in *ErrorOnNondeterministicUpdateInitParameters) DeepCopy() *ErrorOnNondeterministicUpdateInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicUpdateInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicUpdateObservation) DeepCopyInto(out *ErrorOnNondeterministicUpdateObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicUpdateObservation.
+func (in *ErrorOnNondeterministicUpdateObservation) DeepCopy() *ErrorOnNondeterministicUpdateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicUpdateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorOnNondeterministicUpdateParameters) DeepCopyInto(out *ErrorOnNondeterministicUpdateParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorOnNondeterministicUpdateParameters.
+func (in *ErrorOnNondeterministicUpdateParameters) DeepCopy() *ErrorOnNondeterministicUpdateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorOnNondeterministicUpdateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolume) DeepCopyInto(out *ExternalVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolume.
+func (in *ExternalVolume) DeepCopy() *ExternalVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeInitParameters) DeepCopyInto(out *ExternalVolumeInitParameters) {
+	*out = *in
+	if in.AllowWrites != nil {
+		in, out := &in.AllowWrites, &out.AllowWrites
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = make([]StorageLocationInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeInitParameters.
+func (in *ExternalVolumeInitParameters) DeepCopy() *ExternalVolumeInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeList) DeepCopyInto(out *ExternalVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeList.
+func (in *ExternalVolumeList) DeepCopy() *ExternalVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeObservation) DeepCopyInto(out *ExternalVolumeObservation) {
+	*out = *in
+	if in.AllowWrites != nil {
+		in, out := &in.AllowWrites, &out.AllowWrites
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.DescribeOutput != nil {
+		in, out := &in.DescribeOutput, &out.DescribeOutput
+		*out = make([]DescribeOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShowOutput != nil {
+		in, out := &in.ShowOutput, &out.ShowOutput
+		*out = make([]ExternalVolumeShowOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = make([]StorageLocationObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeObservation.
+func (in *ExternalVolumeObservation) DeepCopy() *ExternalVolumeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeParameters) DeepCopyInto(out *ExternalVolumeParameters) {
+	*out = *in
+	if in.AllowWrites != nil {
+		in, out := &in.AllowWrites, &out.AllowWrites
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = make([]StorageLocationParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeParameters.
+func (in *ExternalVolumeParameters) DeepCopy() *ExternalVolumeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeShowOutputInitParameters) DeepCopyInto(out *ExternalVolumeShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeShowOutputInitParameters.
+func (in *ExternalVolumeShowOutputInitParameters) DeepCopy() *ExternalVolumeShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeShowOutputObservation) DeepCopyInto(out *ExternalVolumeShowOutputObservation) {
+	*out = *in
+	if in.AllowWrites != nil {
+		in, out := &in.AllowWrites, &out.AllowWrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeShowOutputObservation.
+func (in *ExternalVolumeShowOutputObservation) DeepCopy() *ExternalVolumeShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeShowOutputParameters) DeepCopyInto(out *ExternalVolumeShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeShowOutputParameters.
+func (in *ExternalVolumeShowOutputParameters) DeepCopy() *ExternalVolumeShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeSpec) DeepCopyInto(out *ExternalVolumeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeSpec.
+func (in *ExternalVolumeSpec) DeepCopy() *ExternalVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeStatus) DeepCopyInto(out *ExternalVolumeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeStatus.
+func (in *ExternalVolumeStatus) DeepCopy() *ExternalVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeographyOutputFormatInitParameters) DeepCopyInto(out *GeographyOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeographyOutputFormatInitParameters.
+func (in *GeographyOutputFormatInitParameters) DeepCopy() *GeographyOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GeographyOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeographyOutputFormatObservation) DeepCopyInto(out *GeographyOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeographyOutputFormatObservation.
+func (in *GeographyOutputFormatObservation) DeepCopy() *GeographyOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GeographyOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeographyOutputFormatParameters) DeepCopyInto(out *GeographyOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeographyOutputFormatParameters.
+func (in *GeographyOutputFormatParameters) DeepCopy() *GeographyOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GeographyOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeometryOutputFormatInitParameters) DeepCopyInto(out *GeometryOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeometryOutputFormatInitParameters.
+func (in *GeometryOutputFormatInitParameters) DeepCopy() *GeometryOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GeometryOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeometryOutputFormatObservation) DeepCopyInto(out *GeometryOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeometryOutputFormatObservation.
+func (in *GeometryOutputFormatObservation) DeepCopy() *GeometryOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GeometryOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeometryOutputFormatParameters) DeepCopyInto(out *GeometryOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeometryOutputFormatParameters.
+func (in *GeometryOutputFormatParameters) DeepCopy() *GeometryOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GeometryOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONIndentInitParameters) DeepCopyInto(out *JSONIndentInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONIndentInitParameters.
+func (in *JSONIndentInitParameters) DeepCopy() *JSONIndentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONIndentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONIndentObservation) DeepCopyInto(out *JSONIndentObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONIndentObservation.
+func (in *JSONIndentObservation) DeepCopy() *JSONIndentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONIndentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONIndentParameters) DeepCopyInto(out *JSONIndentParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONIndentParameters.
+func (in *JSONIndentParameters) DeepCopy() *JSONIndentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONIndentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatDecimalAsIntInitParameters) DeepCopyInto(out *JdbcTreatDecimalAsIntInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatDecimalAsIntInitParameters.
+func (in *JdbcTreatDecimalAsIntInitParameters) DeepCopy() *JdbcTreatDecimalAsIntInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatDecimalAsIntInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatDecimalAsIntObservation) DeepCopyInto(out *JdbcTreatDecimalAsIntObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatDecimalAsIntObservation.
+func (in *JdbcTreatDecimalAsIntObservation) DeepCopy() *JdbcTreatDecimalAsIntObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatDecimalAsIntObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatDecimalAsIntParameters) DeepCopyInto(out *JdbcTreatDecimalAsIntParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatDecimalAsIntParameters.
+func (in *JdbcTreatDecimalAsIntParameters) DeepCopy() *JdbcTreatDecimalAsIntParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatDecimalAsIntParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatTimestampNtzAsUtcInitParameters) DeepCopyInto(out *JdbcTreatTimestampNtzAsUtcInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatTimestampNtzAsUtcInitParameters.
+func (in *JdbcTreatTimestampNtzAsUtcInitParameters) DeepCopy() *JdbcTreatTimestampNtzAsUtcInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatTimestampNtzAsUtcInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatTimestampNtzAsUtcObservation) DeepCopyInto(out *JdbcTreatTimestampNtzAsUtcObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatTimestampNtzAsUtcObservation.
+func (in *JdbcTreatTimestampNtzAsUtcObservation) DeepCopy() *JdbcTreatTimestampNtzAsUtcObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatTimestampNtzAsUtcObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcTreatTimestampNtzAsUtcParameters) DeepCopyInto(out *JdbcTreatTimestampNtzAsUtcParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcTreatTimestampNtzAsUtcParameters.
+func (in *JdbcTreatTimestampNtzAsUtcParameters) DeepCopy() *JdbcTreatTimestampNtzAsUtcParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcTreatTimestampNtzAsUtcParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcUseSessionTimezoneInitParameters) DeepCopyInto(out *JdbcUseSessionTimezoneInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcUseSessionTimezoneInitParameters.
+func (in *JdbcUseSessionTimezoneInitParameters) DeepCopy() *JdbcUseSessionTimezoneInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcUseSessionTimezoneInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcUseSessionTimezoneObservation) DeepCopyInto(out *JdbcUseSessionTimezoneObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcUseSessionTimezoneObservation.
+func (in *JdbcUseSessionTimezoneObservation) DeepCopy() *JdbcUseSessionTimezoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcUseSessionTimezoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JdbcUseSessionTimezoneParameters) DeepCopyInto(out *JdbcUseSessionTimezoneParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JdbcUseSessionTimezoneParameters.
+func (in *JdbcUseSessionTimezoneParameters) DeepCopy() *JdbcUseSessionTimezoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JdbcUseSessionTimezoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockTimeoutInitParameters) DeepCopyInto(out *LockTimeoutInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockTimeoutInitParameters.
+func (in *LockTimeoutInitParameters) DeepCopy() *LockTimeoutInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LockTimeoutInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockTimeoutObservation) DeepCopyInto(out *LockTimeoutObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockTimeoutObservation.
+func (in *LockTimeoutObservation) DeepCopy() *LockTimeoutObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LockTimeoutObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockTimeoutParameters) DeepCopyInto(out *LockTimeoutParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockTimeoutParameters.
+func (in *LockTimeoutParameters) DeepCopy() *LockTimeoutParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LockTimeoutParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogLevelInitParameters) DeepCopyInto(out *LogLevelInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogLevelInitParameters.
+func (in *LogLevelInitParameters) DeepCopy() *LogLevelInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogLevelInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogLevelObservation) DeepCopyInto(out *LogLevelObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogLevelObservation.
+func (in *LogLevelObservation) DeepCopy() *LogLevelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LogLevelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogLevelParameters) DeepCopyInto(out *LogLevelParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogLevelParameters.
+func (in *LogLevelParameters) DeepCopy() *LogLevelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogLevelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxConcurrencyLevelInitParameters) DeepCopyInto(out *MaxConcurrencyLevelInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxConcurrencyLevelInitParameters.
+func (in *MaxConcurrencyLevelInitParameters) DeepCopy() *MaxConcurrencyLevelInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxConcurrencyLevelInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxConcurrencyLevelObservation) DeepCopyInto(out *MaxConcurrencyLevelObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxConcurrencyLevelObservation.
+func (in *MaxConcurrencyLevelObservation) DeepCopy() *MaxConcurrencyLevelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxConcurrencyLevelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxConcurrencyLevelParameters) DeepCopyInto(out *MaxConcurrencyLevelParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxConcurrencyLevelParameters.
+func (in *MaxConcurrencyLevelParameters) DeepCopy() *MaxConcurrencyLevelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxConcurrencyLevelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiStatementCountInitParameters) DeepCopyInto(out *MultiStatementCountInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiStatementCountInitParameters.
+func (in *MultiStatementCountInitParameters) DeepCopy() *MultiStatementCountInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiStatementCountInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiStatementCountObservation) DeepCopyInto(out *MultiStatementCountObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiStatementCountObservation.
+func (in *MultiStatementCountObservation) DeepCopy() *MultiStatementCountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiStatementCountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiStatementCountParameters) DeepCopyInto(out *MultiStatementCountParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiStatementCountParameters.
+func (in *MultiStatementCountParameters) DeepCopy() *MultiStatementCountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiStatementCountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyInitParameters) DeepCopyInto(out *NetworkPolicyInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyInitParameters.
+func (in *NetworkPolicyInitParameters) DeepCopy() *NetworkPolicyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyObservation) DeepCopyInto(out *NetworkPolicyObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyObservation.
+func (in *NetworkPolicyObservation) DeepCopy() *NetworkPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyParameters) DeepCopyInto(out *NetworkPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyParameters.
+func (in *NetworkPolicyParameters) DeepCopy() *NetworkPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoorderSequenceAsDefaultInitParameters) DeepCopyInto(out *NoorderSequenceAsDefaultInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoorderSequenceAsDefaultInitParameters.
+func (in *NoorderSequenceAsDefaultInitParameters) DeepCopy() *NoorderSequenceAsDefaultInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NoorderSequenceAsDefaultInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoorderSequenceAsDefaultObservation) DeepCopyInto(out *NoorderSequenceAsDefaultObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoorderSequenceAsDefaultObservation.
+func (in *NoorderSequenceAsDefaultObservation) DeepCopy() *NoorderSequenceAsDefaultObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NoorderSequenceAsDefaultObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoorderSequenceAsDefaultParameters) DeepCopyInto(out *NoorderSequenceAsDefaultParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoorderSequenceAsDefaultParameters.
+func (in *NoorderSequenceAsDefaultParameters) DeepCopy() *NoorderSequenceAsDefaultParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NoorderSequenceAsDefaultParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OdbcTreatDecimalAsIntInitParameters) DeepCopyInto(out *OdbcTreatDecimalAsIntInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OdbcTreatDecimalAsIntInitParameters.
+func (in *OdbcTreatDecimalAsIntInitParameters) DeepCopy() *OdbcTreatDecimalAsIntInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OdbcTreatDecimalAsIntInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OdbcTreatDecimalAsIntObservation) DeepCopyInto(out *OdbcTreatDecimalAsIntObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OdbcTreatDecimalAsIntObservation.
+func (in *OdbcTreatDecimalAsIntObservation) DeepCopy() *OdbcTreatDecimalAsIntObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OdbcTreatDecimalAsIntObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OdbcTreatDecimalAsIntParameters) DeepCopyInto(out *OdbcTreatDecimalAsIntParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OdbcTreatDecimalAsIntParameters.
+func (in *OdbcTreatDecimalAsIntParameters) DeepCopy() *OdbcTreatDecimalAsIntParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OdbcTreatDecimalAsIntParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersInitParameters) DeepCopyInto(out *ParametersInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersInitParameters.
+func (in *ParametersInitParameters) DeepCopy() *ParametersInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersObservation) DeepCopyInto(out *ParametersObservation) {
+	*out = *in
+	if in.AbortDetachedQuery != nil {
+		in, out := &in.AbortDetachedQuery, &out.AbortDetachedQuery
+		*out = make([]AbortDetachedQueryObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Autocommit != nil {
+		in, out := &in.Autocommit, &out.Autocommit
+		*out = make([]AutocommitObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BinaryInputFormat != nil {
+		in, out := &in.BinaryInputFormat, &out.BinaryInputFormat
+		*out = make([]BinaryInputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BinaryOutputFormat != nil {
+		in, out := &in.BinaryOutputFormat, &out.BinaryOutputFormat
+		*out = make([]BinaryOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientMemoryLimit != nil {
+		in, out := &in.ClientMemoryLimit, &out.ClientMemoryLimit
+		*out = make([]ClientMemoryLimitObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientMetadataRequestUseConnectionCtx != nil {
+		in, out := &in.ClientMetadataRequestUseConnectionCtx, &out.ClientMetadataRequestUseConnectionCtx
+		*out = make([]ClientMetadataRequestUseConnectionCtxObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientPrefetchThreads != nil {
+		in, out := &in.ClientPrefetchThreads, &out.ClientPrefetchThreads
+		*out = make([]ClientPrefetchThreadsObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientResultChunkSize != nil {
+		in, out := &in.ClientResultChunkSize, &out.ClientResultChunkSize
+		*out = make([]ClientResultChunkSizeObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientResultColumnCaseInsensitive != nil {
+		in, out := &in.ClientResultColumnCaseInsensitive, &out.ClientResultColumnCaseInsensitive
+		*out = make([]ClientResultColumnCaseInsensitiveObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientSessionKeepAlive != nil {
+		in, out := &in.ClientSessionKeepAlive, &out.ClientSessionKeepAlive
+		*out = make([]ClientSessionKeepAliveObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientSessionKeepAliveHeartbeatFrequency != nil {
+		in, out := &in.ClientSessionKeepAliveHeartbeatFrequency, &out.ClientSessionKeepAliveHeartbeatFrequency
+		*out = make([]ClientSessionKeepAliveHeartbeatFrequencyObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientTimestampTypeMapping != nil {
+		in, out := &in.ClientTimestampTypeMapping, &out.ClientTimestampTypeMapping
+		*out = make([]ClientTimestampTypeMappingObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DateInputFormat != nil {
+		in, out := &in.DateInputFormat, &out.DateInputFormat
+		*out = make([]DateInputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DateOutputFormat != nil {
+		in, out := &in.DateOutputFormat, &out.DateOutputFormat
+		*out = make([]DateOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableUnloadPhysicalTypeOptimization != nil {
+		in, out := &in.EnableUnloadPhysicalTypeOptimization, &out.EnableUnloadPhysicalTypeOptimization
+		*out = make([]EnableUnloadPhysicalTypeOptimizationObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableUnredactedQuerySyntaxError != nil {
+		in, out := &in.EnableUnredactedQuerySyntaxError, &out.EnableUnredactedQuerySyntaxError
+		*out = make([]EnableUnredactedQuerySyntaxErrorObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ErrorOnNondeterministicMerge != nil {
+		in, out := &in.ErrorOnNondeterministicMerge, &out.ErrorOnNondeterministicMerge
+		*out = make([]ErrorOnNondeterministicMergeObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ErrorOnNondeterministicUpdate != nil {
+		in, out := &in.ErrorOnNondeterministicUpdate, &out.ErrorOnNondeterministicUpdate
+		*out = make([]ErrorOnNondeterministicUpdateObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GeographyOutputFormat != nil {
+		in, out := &in.GeographyOutputFormat, &out.GeographyOutputFormat
+		*out = make([]GeographyOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GeometryOutputFormat != nil {
+		in, out := &in.GeometryOutputFormat, &out.GeometryOutputFormat
+		*out = make([]GeometryOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JSONIndent != nil {
+		in, out := &in.JSONIndent, &out.JSONIndent
+		*out = make([]JSONIndentObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JdbcTreatDecimalAsInt != nil {
+		in, out := &in.JdbcTreatDecimalAsInt, &out.JdbcTreatDecimalAsInt
+		*out = make([]JdbcTreatDecimalAsIntObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JdbcTreatTimestampNtzAsUtc != nil {
+		in, out := &in.JdbcTreatTimestampNtzAsUtc, &out.JdbcTreatTimestampNtzAsUtc
+		*out = make([]JdbcTreatTimestampNtzAsUtcObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JdbcUseSessionTimezone != nil {
+		in, out := &in.JdbcUseSessionTimezone, &out.JdbcUseSessionTimezone
+		*out = make([]JdbcUseSessionTimezoneObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LockTimeout != nil {
+		in, out := &in.LockTimeout, &out.LockTimeout
+		*out = make([]LockTimeoutObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = make([]LogLevelObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MultiStatementCount != nil {
+		in, out := &in.MultiStatementCount, &out.MultiStatementCount
+		*out = make([]MultiStatementCountObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = make([]NetworkPolicyObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NoorderSequenceAsDefault != nil {
+		in, out := &in.NoorderSequenceAsDefault, &out.NoorderSequenceAsDefault
+		*out = make([]NoorderSequenceAsDefaultObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OdbcTreatDecimalAsInt != nil {
+		in, out := &in.OdbcTreatDecimalAsInt, &out.OdbcTreatDecimalAsInt
+		*out = make([]OdbcTreatDecimalAsIntObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreventUnloadToInternalStages != nil {
+		in, out := &in.PreventUnloadToInternalStages, &out.PreventUnloadToInternalStages
+		*out = make([]PreventUnloadToInternalStagesObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueryTag != nil {
+		in, out := &in.QueryTag, &out.QueryTag
+		*out = make([]QueryTagObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QuotedIdentifiersIgnoreCase != nil {
+		in, out := &in.QuotedIdentifiersIgnoreCase, &out.QuotedIdentifiersIgnoreCase
+		*out = make([]QuotedIdentifiersIgnoreCaseObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RowsPerResultset != nil {
+		in, out := &in.RowsPerResultset, &out.RowsPerResultset
+		*out = make([]RowsPerResultsetObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.S3StageVpceDNSName != nil {
+		in, out := &in.S3StageVpceDNSName, &out.S3StageVpceDNSName
+		*out = make([]S3StageVpceDNSNameObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SearchPath != nil {
+		in, out := &in.SearchPath, &out.SearchPath
+		*out = make([]SearchPathObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SimulatedDataSharingConsumer != nil {
+		in, out := &in.SimulatedDataSharingConsumer, &out.SimulatedDataSharingConsumer
+		*out = make([]SimulatedDataSharingConsumerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = make([]StatementQueuedTimeoutInSecondsObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = make([]StatementTimeoutInSecondsObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StrictJSONOutput != nil {
+		in, out := &in.StrictJSONOutput, &out.StrictJSONOutput
+		*out = make([]StrictJSONOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeInputFormat != nil {
+		in, out := &in.TimeInputFormat, &out.TimeInputFormat
+		*out = make([]TimeInputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeOutputFormat != nil {
+		in, out := &in.TimeOutputFormat, &out.TimeOutputFormat
+		*out = make([]TimeOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampDayIsAlways24H != nil {
+		in, out := &in.TimestampDayIsAlways24H, &out.TimestampDayIsAlways24H
+		*out = make([]TimestampDayIsAlways24HObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampInputFormat != nil {
+		in, out := &in.TimestampInputFormat, &out.TimestampInputFormat
+		*out = make([]TimestampInputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampLtzOutputFormat != nil {
+		in, out := &in.TimestampLtzOutputFormat, &out.TimestampLtzOutputFormat
+		*out = make([]TimestampLtzOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampNtzOutputFormat != nil {
+		in, out := &in.TimestampNtzOutputFormat, &out.TimestampNtzOutputFormat
+		*out = make([]TimestampNtzOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampOutputFormat != nil {
+		in, out := &in.TimestampOutputFormat, &out.TimestampOutputFormat
+		*out = make([]TimestampOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampTypeMapping != nil {
+		in, out := &in.TimestampTypeMapping, &out.TimestampTypeMapping
+		*out = make([]TimestampTypeMappingObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimestampTzOutputFormat != nil {
+		in, out := &in.TimestampTzOutputFormat, &out.TimestampTzOutputFormat
+		*out = make([]TimestampTzOutputFormatObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = make([]TimezoneObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TraceLevel != nil {
+		in, out := &in.TraceLevel, &out.TraceLevel
+		*out = make([]TraceLevelObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransactionAbortOnError != nil {
+		in, out := &in.TransactionAbortOnError, &out.TransactionAbortOnError
+		*out = make([]TransactionAbortOnErrorObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransactionDefaultIsolationLevel != nil {
+		in, out := &in.TransactionDefaultIsolationLevel, &out.TransactionDefaultIsolationLevel
+		*out = make([]TransactionDefaultIsolationLevelObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TwoDigitCenturyStart != nil {
+		in, out := &in.TwoDigitCenturyStart, &out.TwoDigitCenturyStart
+		*out = make([]TwoDigitCenturyStartObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnsupportedDdlAction != nil {
+		in, out := &in.UnsupportedDdlAction, &out.UnsupportedDdlAction
+		*out = make([]UnsupportedDdlActionObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UseCachedResult != nil {
+		in, out := &in.UseCachedResult, &out.UseCachedResult
+		*out = make([]UseCachedResultObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WeekOfYearPolicy != nil {
+		in, out := &in.WeekOfYearPolicy, &out.WeekOfYearPolicy
+		*out = make([]WeekOfYearPolicyObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WeekStart != nil {
+		in, out := &in.WeekStart, &out.WeekStart
+		*out = make([]WeekStartObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersObservation.
+func (in *ParametersObservation) DeepCopy() *ParametersObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersParameters) DeepCopyInto(out *ParametersParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersParameters.
+func (in *ParametersParameters) DeepCopy() *ParametersParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementQueuedTimeoutInSecondsInitParameters) DeepCopyInto(out *ParametersStatementQueuedTimeoutInSecondsInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementQueuedTimeoutInSecondsInitParameters.
+func (in *ParametersStatementQueuedTimeoutInSecondsInitParameters) DeepCopy() *ParametersStatementQueuedTimeoutInSecondsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementQueuedTimeoutInSecondsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementQueuedTimeoutInSecondsObservation) DeepCopyInto(out *ParametersStatementQueuedTimeoutInSecondsObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementQueuedTimeoutInSecondsObservation.
+func (in *ParametersStatementQueuedTimeoutInSecondsObservation) DeepCopy() *ParametersStatementQueuedTimeoutInSecondsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementQueuedTimeoutInSecondsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementQueuedTimeoutInSecondsParameters) DeepCopyInto(out *ParametersStatementQueuedTimeoutInSecondsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementQueuedTimeoutInSecondsParameters.
+func (in *ParametersStatementQueuedTimeoutInSecondsParameters) DeepCopy() *ParametersStatementQueuedTimeoutInSecondsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementQueuedTimeoutInSecondsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementTimeoutInSecondsInitParameters) DeepCopyInto(out *ParametersStatementTimeoutInSecondsInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementTimeoutInSecondsInitParameters.
+func (in *ParametersStatementTimeoutInSecondsInitParameters) DeepCopy() *ParametersStatementTimeoutInSecondsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementTimeoutInSecondsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementTimeoutInSecondsObservation) DeepCopyInto(out *ParametersStatementTimeoutInSecondsObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementTimeoutInSecondsObservation.
+func (in *ParametersStatementTimeoutInSecondsObservation) DeepCopy() *ParametersStatementTimeoutInSecondsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementTimeoutInSecondsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersStatementTimeoutInSecondsParameters) DeepCopyInto(out *ParametersStatementTimeoutInSecondsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersStatementTimeoutInSecondsParameters.
+func (in *ParametersStatementTimeoutInSecondsParameters) DeepCopy() *ParametersStatementTimeoutInSecondsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersStatementTimeoutInSecondsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreventUnloadToInternalStagesInitParameters) DeepCopyInto(out *PreventUnloadToInternalStagesInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreventUnloadToInternalStagesInitParameters.
+func (in *PreventUnloadToInternalStagesInitParameters) DeepCopy() *PreventUnloadToInternalStagesInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PreventUnloadToInternalStagesInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreventUnloadToInternalStagesObservation) DeepCopyInto(out *PreventUnloadToInternalStagesObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreventUnloadToInternalStagesObservation.
+func (in *PreventUnloadToInternalStagesObservation) DeepCopy() *PreventUnloadToInternalStagesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PreventUnloadToInternalStagesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreventUnloadToInternalStagesParameters) DeepCopyInto(out *PreventUnloadToInternalStagesParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreventUnloadToInternalStagesParameters.
+func (in *PreventUnloadToInternalStagesParameters) DeepCopy() *PreventUnloadToInternalStagesParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PreventUnloadToInternalStagesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTagInitParameters) DeepCopyInto(out *QueryTagInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTagInitParameters.
+func (in *QueryTagInitParameters) DeepCopy() *QueryTagInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryTagInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTagObservation) DeepCopyInto(out *QueryTagObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTagObservation.
+func (in *QueryTagObservation) DeepCopy() *QueryTagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryTagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTagParameters) DeepCopyInto(out *QueryTagParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTagParameters.
+func (in *QueryTagParameters) DeepCopy() *QueryTagParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryTagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotedIdentifiersIgnoreCaseInitParameters) DeepCopyInto(out *QuotedIdentifiersIgnoreCaseInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotedIdentifiersIgnoreCaseInitParameters.
+func (in *QuotedIdentifiersIgnoreCaseInitParameters) DeepCopy() *QuotedIdentifiersIgnoreCaseInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotedIdentifiersIgnoreCaseInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotedIdentifiersIgnoreCaseObservation) DeepCopyInto(out *QuotedIdentifiersIgnoreCaseObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotedIdentifiersIgnoreCaseObservation.
+func (in *QuotedIdentifiersIgnoreCaseObservation) DeepCopy() *QuotedIdentifiersIgnoreCaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotedIdentifiersIgnoreCaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotedIdentifiersIgnoreCaseParameters) DeepCopyInto(out *QuotedIdentifiersIgnoreCaseParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotedIdentifiersIgnoreCaseParameters.
+func (in *QuotedIdentifiersIgnoreCaseParameters) DeepCopy() *QuotedIdentifiersIgnoreCaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotedIdentifiersIgnoreCaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitor) DeepCopyInto(out *ResourceMonitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitor.
+func (in *ResourceMonitor) DeepCopy() *ResourceMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceMonitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorInitParameters) DeepCopyInto(out *ResourceMonitorInitParameters) {
+	*out = *in
+	if in.CreditQuota != nil {
+		in, out := &in.CreditQuota, &out.CreditQuota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotifyTriggers != nil {
+		in, out := &in.NotifyTriggers, &out.NotifyTriggers
+		*out = make([]*float64, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(float64)
+				**out = **in
+			}
+		}
+	}
+	if in.NotifyUsers != nil {
+		in, out := &in.NotifyUsers, &out.NotifyUsers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuspendImmediateTrigger != nil {
+		in, out := &in.SuspendImmediateTrigger, &out.SuspendImmediateTrigger
+		*out = new(float64)
+		**out = **in
+	}
+	if in.SuspendTrigger != nil {
+		in, out := &in.SuspendTrigger, &out.SuspendTrigger
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorInitParameters.
+func (in *ResourceMonitorInitParameters) DeepCopy() *ResourceMonitorInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorList) DeepCopyInto(out *ResourceMonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceMonitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorList.
+func (in *ResourceMonitorList) DeepCopy() *ResourceMonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceMonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorObservation) DeepCopyInto(out *ResourceMonitorObservation) {
+	*out = *in
+	if in.CreditQuota != nil {
+		in, out := &in.CreditQuota, &out.CreditQuota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotifyTriggers != nil {
+		in, out := &in.NotifyTriggers, &out.NotifyTriggers
+		*out = make([]*float64, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(float64)
+				**out = **in
+			}
+		}
+	}
+	if in.NotifyUsers != nil {
+		in, out := &in.NotifyUsers, &out.NotifyUsers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.RemainingCredits != nil {
+		in, out := &in.RemainingCredits, &out.RemainingCredits
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ShowOutput != nil {
+		in, out := &in.ShowOutput, &out.ShowOutput
+		*out = make([]ResourceMonitorShowOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuspendImmediateTrigger != nil {
+		in, out := &in.SuspendImmediateTrigger, &out.SuspendImmediateTrigger
+		*out = new(float64)
+		**out = **in
+	}
+	if in.SuspendTrigger != nil {
+		in, out := &in.SuspendTrigger, &out.SuspendTrigger
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UsedCredits != nil {
+		in, out := &in.UsedCredits, &out.UsedCredits
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorObservation.
+func (in *ResourceMonitorObservation) DeepCopy() *ResourceMonitorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorParameters) DeepCopyInto(out *ResourceMonitorParameters) {
+	*out = *in
+	if in.CreditQuota != nil {
+		in, out := &in.CreditQuota, &out.CreditQuota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotifyTriggers != nil {
+		in, out := &in.NotifyTriggers, &out.NotifyTriggers
+		*out = make([]*float64, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(float64)
+				**out = **in
+			}
+		}
+	}
+	if in.NotifyUsers != nil {
+		in, out := &in.NotifyUsers, &out.NotifyUsers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuspendImmediateTrigger != nil {
+		in, out := &in.SuspendImmediateTrigger, &out.SuspendImmediateTrigger
+		*out = new(float64)
+		**out = **in
+	}
+	if in.SuspendTrigger != nil {
+		in, out := &in.SuspendTrigger, &out.SuspendTrigger
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorParameters.
+func (in *ResourceMonitorParameters) DeepCopy() *ResourceMonitorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorShowOutputInitParameters) DeepCopyInto(out *ResourceMonitorShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorShowOutputInitParameters.
+func (in *ResourceMonitorShowOutputInitParameters) DeepCopy() *ResourceMonitorShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorShowOutputObservation) DeepCopyInto(out *ResourceMonitorShowOutputObservation) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreditQuota != nil {
+		in, out := &in.CreditQuota, &out.CreditQuota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemainingCredits != nil {
+		in, out := &in.RemainingCredits, &out.RemainingCredits
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuspendAt != nil {
+		in, out := &in.SuspendAt, &out.SuspendAt
+		*out = new(float64)
+		**out = **in
+	}
+	if in.SuspendImmediateAt != nil {
+		in, out := &in.SuspendImmediateAt, &out.SuspendImmediateAt
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UsedCredits != nil {
+		in, out := &in.UsedCredits, &out.UsedCredits
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorShowOutputObservation.
+func (in *ResourceMonitorShowOutputObservation) DeepCopy() *ResourceMonitorShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorShowOutputParameters) DeepCopyInto(out *ResourceMonitorShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorShowOutputParameters.
+func (in *ResourceMonitorShowOutputParameters) DeepCopy() *ResourceMonitorShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorSpec) DeepCopyInto(out *ResourceMonitorSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorSpec.
+func (in *ResourceMonitorSpec) DeepCopy() *ResourceMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorStatus) DeepCopyInto(out *ResourceMonitorStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorStatus.
+func (in *ResourceMonitorStatus) DeepCopy() *ResourceMonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RowsPerResultsetInitParameters) DeepCopyInto(out *RowsPerResultsetInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RowsPerResultsetInitParameters.
+func (in *RowsPerResultsetInitParameters) DeepCopy() *RowsPerResultsetInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RowsPerResultsetInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RowsPerResultsetObservation) DeepCopyInto(out *RowsPerResultsetObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RowsPerResultsetObservation.
+func (in *RowsPerResultsetObservation) DeepCopy() *RowsPerResultsetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RowsPerResultsetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RowsPerResultsetParameters) DeepCopyInto(out *RowsPerResultsetParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RowsPerResultsetParameters.
+func (in *RowsPerResultsetParameters) DeepCopy() *RowsPerResultsetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RowsPerResultsetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3StageVpceDNSNameInitParameters) DeepCopyInto(out *S3StageVpceDNSNameInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StageVpceDNSNameInitParameters.
+func (in *S3StageVpceDNSNameInitParameters) DeepCopy() *S3StageVpceDNSNameInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(S3StageVpceDNSNameInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3StageVpceDNSNameObservation) DeepCopyInto(out *S3StageVpceDNSNameObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StageVpceDNSNameObservation.
+func (in *S3StageVpceDNSNameObservation) DeepCopy() *S3StageVpceDNSNameObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(S3StageVpceDNSNameObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3StageVpceDNSNameParameters) DeepCopyInto(out *S3StageVpceDNSNameParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StageVpceDNSNameParameters.
+func (in *S3StageVpceDNSNameParameters) DeepCopy() *S3StageVpceDNSNameParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(S3StageVpceDNSNameParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchPathInitParameters) DeepCopyInto(out *SearchPathInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchPathInitParameters.
+func (in *SearchPathInitParameters) DeepCopy() *SearchPathInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchPathInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchPathObservation) DeepCopyInto(out *SearchPathObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchPathObservation.
+func (in *SearchPathObservation) DeepCopy() *SearchPathObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchPathObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchPathParameters) DeepCopyInto(out *SearchPathParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchPathParameters.
+func (in *SearchPathParameters) DeepCopy() *SearchPathParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchPathParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputInitParameters) DeepCopyInto(out *ShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputInitParameters.
+func (in *ShowOutputInitParameters) DeepCopy() *ShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputObservation) DeepCopyInto(out *ShowOutputObservation) {
+	*out = *in
+	if in.AccountLocator != nil {
+		in, out := &in.AccountLocator, &out.AccountLocator
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountLocatorURL != nil {
+		in, out := &in.AccountLocatorURL, &out.AccountLocatorURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountName != nil {
+		in, out := &in.AccountName, &out.AccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountOldURLLastUsed != nil {
+		in, out := &in.AccountOldURLLastUsed, &out.AccountOldURLLastUsed
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountOldURLSavedOn != nil {
+		in, out := &in.AccountOldURLSavedOn, &out.AccountOldURLSavedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountURL != nil {
+		in, out := &in.AccountURL, &out.AccountURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConsumptionBillingEntityName != nil {
+		in, out := &in.ConsumptionBillingEntityName, &out.ConsumptionBillingEntityName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DroppedOn != nil {
+		in, out := &in.DroppedOn, &out.DroppedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Edition != nil {
+		in, out := &in.Edition, &out.Edition
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsEventsAccount != nil {
+		in, out := &in.IsEventsAccount, &out.IsEventsAccount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsOrgAdmin != nil {
+		in, out := &in.IsOrgAdmin, &out.IsOrgAdmin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsOrganizationAccount != nil {
+		in, out := &in.IsOrganizationAccount, &out.IsOrganizationAccount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ManagedAccounts != nil {
+		in, out := &in.ManagedAccounts, &out.ManagedAccounts
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MarketplaceConsumerBillingEntityName != nil {
+		in, out := &in.MarketplaceConsumerBillingEntityName, &out.MarketplaceConsumerBillingEntityName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MarketplaceProviderBillingEntityName != nil {
+		in, out := &in.MarketplaceProviderBillingEntityName, &out.MarketplaceProviderBillingEntityName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MovedOn != nil {
+		in, out := &in.MovedOn, &out.MovedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.MovedToOrganization != nil {
+		in, out := &in.MovedToOrganization, &out.MovedToOrganization
+		*out = new(string)
+		**out = **in
+	}
+	if in.OldAccountURL != nil {
+		in, out := &in.OldAccountURL, &out.OldAccountURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationName != nil {
+		in, out := &in.OrganizationName, &out.OrganizationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationOldURL != nil {
+		in, out := &in.OrganizationOldURL, &out.OrganizationOldURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationOldURLLastUsed != nil {
+		in, out := &in.OrganizationOldURLLastUsed, &out.OrganizationOldURLLastUsed
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationOldURLSavedOn != nil {
+		in, out := &in.OrganizationOldURLSavedOn, &out.OrganizationOldURLSavedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationURLExpirationOn != nil {
+		in, out := &in.OrganizationURLExpirationOn, &out.OrganizationURLExpirationOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegionGroup != nil {
+		in, out := &in.RegionGroup, &out.RegionGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoredOn != nil {
+		in, out := &in.RestoredOn, &out.RestoredOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduledDeletionTime != nil {
+		in, out := &in.ScheduledDeletionTime, &out.ScheduledDeletionTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnowflakeRegion != nil {
+		in, out := &in.SnowflakeRegion, &out.SnowflakeRegion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputObservation.
+func (in *ShowOutputObservation) DeepCopy() *ShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputParameters) DeepCopyInto(out *ShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputParameters.
+func (in *ShowOutputParameters) DeepCopy() *ShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedDataSharingConsumerInitParameters) DeepCopyInto(out *SimulatedDataSharingConsumerInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedDataSharingConsumerInitParameters.
+func (in *SimulatedDataSharingConsumerInitParameters) DeepCopy() *SimulatedDataSharingConsumerInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedDataSharingConsumerInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedDataSharingConsumerObservation) DeepCopyInto(out *SimulatedDataSharingConsumerObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedDataSharingConsumerObservation.
+func (in *SimulatedDataSharingConsumerObservation) DeepCopy() *SimulatedDataSharingConsumerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedDataSharingConsumerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedDataSharingConsumerParameters) DeepCopyInto(out *SimulatedDataSharingConsumerParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedDataSharingConsumerParameters.
+func (in *SimulatedDataSharingConsumerParameters) DeepCopy() *SimulatedDataSharingConsumerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedDataSharingConsumerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementQueuedTimeoutInSecondsInitParameters) DeepCopyInto(out *StatementQueuedTimeoutInSecondsInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementQueuedTimeoutInSecondsInitParameters.
+func (in *StatementQueuedTimeoutInSecondsInitParameters) DeepCopy() *StatementQueuedTimeoutInSecondsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementQueuedTimeoutInSecondsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementQueuedTimeoutInSecondsObservation) DeepCopyInto(out *StatementQueuedTimeoutInSecondsObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementQueuedTimeoutInSecondsObservation.
+func (in *StatementQueuedTimeoutInSecondsObservation) DeepCopy() *StatementQueuedTimeoutInSecondsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementQueuedTimeoutInSecondsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementQueuedTimeoutInSecondsParameters) DeepCopyInto(out *StatementQueuedTimeoutInSecondsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementQueuedTimeoutInSecondsParameters.
+func (in *StatementQueuedTimeoutInSecondsParameters) DeepCopy() *StatementQueuedTimeoutInSecondsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementQueuedTimeoutInSecondsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementTimeoutInSecondsInitParameters) DeepCopyInto(out *StatementTimeoutInSecondsInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementTimeoutInSecondsInitParameters.
+func (in *StatementTimeoutInSecondsInitParameters) DeepCopy() *StatementTimeoutInSecondsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementTimeoutInSecondsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementTimeoutInSecondsObservation) DeepCopyInto(out *StatementTimeoutInSecondsObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementTimeoutInSecondsObservation.
+func (in *StatementTimeoutInSecondsObservation) DeepCopy() *StatementTimeoutInSecondsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementTimeoutInSecondsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatementTimeoutInSecondsParameters) DeepCopyInto(out *StatementTimeoutInSecondsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatementTimeoutInSecondsParameters.
+func (in *StatementTimeoutInSecondsParameters) DeepCopy() *StatementTimeoutInSecondsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StatementTimeoutInSecondsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocationInitParameters) DeepCopyInto(out *StorageLocationInitParameters) {
+	*out = *in
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionKMSKeyID != nil {
+		in, out := &in.EncryptionKMSKeyID, &out.EncryptionKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBaseURL != nil {
+		in, out := &in.StorageBaseURL, &out.StorageBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocationName != nil {
+		in, out := &in.StorageLocationName, &out.StorageLocationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocationInitParameters.
+func (in *StorageLocationInitParameters) DeepCopy() *StorageLocationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocationObservation) DeepCopyInto(out *StorageLocationObservation) {
+	*out = *in
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionKMSKeyID != nil {
+		in, out := &in.EncryptionKMSKeyID, &out.EncryptionKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsExternalID != nil {
+		in, out := &in.StorageAwsExternalID, &out.StorageAwsExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBaseURL != nil {
+		in, out := &in.StorageBaseURL, &out.StorageBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocationName != nil {
+		in, out := &in.StorageLocationName, &out.StorageLocationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocationObservation.
+func (in *StorageLocationObservation) DeepCopy() *StorageLocationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocationParameters) DeepCopyInto(out *StorageLocationParameters) {
+	*out = *in
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionKMSKeyID != nil {
+		in, out := &in.EncryptionKMSKeyID, &out.EncryptionKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBaseURL != nil {
+		in, out := &in.StorageBaseURL, &out.StorageBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocationName != nil {
+		in, out := &in.StorageLocationName, &out.StorageLocationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocationParameters.
+func (in *StorageLocationParameters) DeepCopy() *StorageLocationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrictJSONOutputInitParameters) DeepCopyInto(out *StrictJSONOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrictJSONOutputInitParameters.
+func (in *StrictJSONOutputInitParameters) DeepCopy() *StrictJSONOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StrictJSONOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrictJSONOutputObservation) DeepCopyInto(out *StrictJSONOutputObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrictJSONOutputObservation.
+func (in *StrictJSONOutputObservation) DeepCopy() *StrictJSONOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StrictJSONOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrictJSONOutputParameters) DeepCopyInto(out *StrictJSONOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrictJSONOutputParameters.
+func (in *StrictJSONOutputParameters) DeepCopy() *StrictJSONOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StrictJSONOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeInputFormatInitParameters) DeepCopyInto(out *TimeInputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInputFormatInitParameters.
+func (in *TimeInputFormatInitParameters) DeepCopy() *TimeInputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeInputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeInputFormatObservation) DeepCopyInto(out *TimeInputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInputFormatObservation.
+func (in *TimeInputFormatObservation) DeepCopy() *TimeInputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeInputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeInputFormatParameters) DeepCopyInto(out *TimeInputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInputFormatParameters.
+func (in *TimeInputFormatParameters) DeepCopy() *TimeInputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeInputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeOutputFormatInitParameters) DeepCopyInto(out *TimeOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeOutputFormatInitParameters.
+func (in *TimeOutputFormatInitParameters) DeepCopy() *TimeOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeOutputFormatObservation) DeepCopyInto(out *TimeOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeOutputFormatObservation.
+func (in *TimeOutputFormatObservation) DeepCopy() *TimeOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeOutputFormatParameters) DeepCopyInto(out *TimeOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeOutputFormatParameters.
+func (in *TimeOutputFormatParameters) DeepCopy() *TimeOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampDayIsAlways24HInitParameters) DeepCopyInto(out *TimestampDayIsAlways24HInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampDayIsAlways24HInitParameters.
+func (in *TimestampDayIsAlways24HInitParameters) DeepCopy() *TimestampDayIsAlways24HInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampDayIsAlways24HInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampDayIsAlways24HObservation) DeepCopyInto(out *TimestampDayIsAlways24HObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampDayIsAlways24HObservation.
+func (in *TimestampDayIsAlways24HObservation) DeepCopy() *TimestampDayIsAlways24HObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampDayIsAlways24HObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampDayIsAlways24HParameters) DeepCopyInto(out *TimestampDayIsAlways24HParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampDayIsAlways24HParameters.
+func (in *TimestampDayIsAlways24HParameters) DeepCopy() *TimestampDayIsAlways24HParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampDayIsAlways24HParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampInputFormatInitParameters) DeepCopyInto(out *TimestampInputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampInputFormatInitParameters.
+func (in *TimestampInputFormatInitParameters) DeepCopy() *TimestampInputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampInputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampInputFormatObservation) DeepCopyInto(out *TimestampInputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampInputFormatObservation.
+func (in *TimestampInputFormatObservation) DeepCopy() *TimestampInputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampInputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampInputFormatParameters) DeepCopyInto(out *TimestampInputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampInputFormatParameters.
+func (in *TimestampInputFormatParameters) DeepCopy() *TimestampInputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampInputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampLtzOutputFormatInitParameters) DeepCopyInto(out *TimestampLtzOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampLtzOutputFormatInitParameters.
+func (in *TimestampLtzOutputFormatInitParameters) DeepCopy() *TimestampLtzOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampLtzOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampLtzOutputFormatObservation) DeepCopyInto(out *TimestampLtzOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampLtzOutputFormatObservation.
+func (in *TimestampLtzOutputFormatObservation) DeepCopy() *TimestampLtzOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampLtzOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampLtzOutputFormatParameters) DeepCopyInto(out *TimestampLtzOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampLtzOutputFormatParameters.
+func (in *TimestampLtzOutputFormatParameters) DeepCopy() *TimestampLtzOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampLtzOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampNtzOutputFormatInitParameters) DeepCopyInto(out *TimestampNtzOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampNtzOutputFormatInitParameters.
+func (in *TimestampNtzOutputFormatInitParameters) DeepCopy() *TimestampNtzOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampNtzOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampNtzOutputFormatObservation) DeepCopyInto(out *TimestampNtzOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampNtzOutputFormatObservation.
+func (in *TimestampNtzOutputFormatObservation) DeepCopy() *TimestampNtzOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampNtzOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampNtzOutputFormatParameters) DeepCopyInto(out *TimestampNtzOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampNtzOutputFormatParameters.
+func (in *TimestampNtzOutputFormatParameters) DeepCopy() *TimestampNtzOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampNtzOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampOutputFormatInitParameters) DeepCopyInto(out *TimestampOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampOutputFormatInitParameters.
+func (in *TimestampOutputFormatInitParameters) DeepCopy() *TimestampOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampOutputFormatObservation) DeepCopyInto(out *TimestampOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampOutputFormatObservation.
+func (in *TimestampOutputFormatObservation) DeepCopy() *TimestampOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampOutputFormatParameters) DeepCopyInto(out *TimestampOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampOutputFormatParameters.
+func (in *TimestampOutputFormatParameters) DeepCopy() *TimestampOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTypeMappingInitParameters) DeepCopyInto(out *TimestampTypeMappingInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTypeMappingInitParameters.
+func (in *TimestampTypeMappingInitParameters) DeepCopy() *TimestampTypeMappingInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTypeMappingInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTypeMappingObservation) DeepCopyInto(out *TimestampTypeMappingObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTypeMappingObservation.
+func (in *TimestampTypeMappingObservation) DeepCopy() *TimestampTypeMappingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTypeMappingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTypeMappingParameters) DeepCopyInto(out *TimestampTypeMappingParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTypeMappingParameters.
+func (in *TimestampTypeMappingParameters) DeepCopy() *TimestampTypeMappingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTypeMappingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTzOutputFormatInitParameters) DeepCopyInto(out *TimestampTzOutputFormatInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTzOutputFormatInitParameters.
+func (in *TimestampTzOutputFormatInitParameters) DeepCopy() *TimestampTzOutputFormatInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTzOutputFormatInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTzOutputFormatObservation) DeepCopyInto(out *TimestampTzOutputFormatObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTzOutputFormatObservation.
+func (in *TimestampTzOutputFormatObservation) DeepCopy() *TimestampTzOutputFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTzOutputFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampTzOutputFormatParameters) DeepCopyInto(out *TimestampTzOutputFormatParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampTzOutputFormatParameters.
+func (in *TimestampTzOutputFormatParameters) DeepCopy() *TimestampTzOutputFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampTzOutputFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimezoneInitParameters) DeepCopyInto(out *TimezoneInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimezoneInitParameters.
+func (in *TimezoneInitParameters) DeepCopy() *TimezoneInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimezoneInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimezoneObservation) DeepCopyInto(out *TimezoneObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimezoneObservation.
+func (in *TimezoneObservation) DeepCopy() *TimezoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimezoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimezoneParameters) DeepCopyInto(out *TimezoneParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimezoneParameters.
+func (in *TimezoneParameters) DeepCopy() *TimezoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TimezoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceLevelInitParameters) DeepCopyInto(out *TraceLevelInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceLevelInitParameters.
+func (in *TraceLevelInitParameters) DeepCopy() *TraceLevelInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceLevelInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceLevelObservation) DeepCopyInto(out *TraceLevelObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceLevelObservation.
+func (in *TraceLevelObservation) DeepCopy() *TraceLevelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceLevelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceLevelParameters) DeepCopyInto(out *TraceLevelParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceLevelParameters.
+func (in *TraceLevelParameters) DeepCopy() *TraceLevelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceLevelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionAbortOnErrorInitParameters) DeepCopyInto(out *TransactionAbortOnErrorInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionAbortOnErrorInitParameters.
+func (in *TransactionAbortOnErrorInitParameters) DeepCopy() *TransactionAbortOnErrorInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionAbortOnErrorInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionAbortOnErrorObservation) DeepCopyInto(out *TransactionAbortOnErrorObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionAbortOnErrorObservation.
+func (in *TransactionAbortOnErrorObservation) DeepCopy() *TransactionAbortOnErrorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionAbortOnErrorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionAbortOnErrorParameters) DeepCopyInto(out *TransactionAbortOnErrorParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionAbortOnErrorParameters.
+func (in *TransactionAbortOnErrorParameters) DeepCopy() *TransactionAbortOnErrorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionAbortOnErrorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionDefaultIsolationLevelInitParameters) DeepCopyInto(out *TransactionDefaultIsolationLevelInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionDefaultIsolationLevelInitParameters.
+func (in *TransactionDefaultIsolationLevelInitParameters) DeepCopy() *TransactionDefaultIsolationLevelInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionDefaultIsolationLevelInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionDefaultIsolationLevelObservation) DeepCopyInto(out *TransactionDefaultIsolationLevelObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionDefaultIsolationLevelObservation.
+func (in *TransactionDefaultIsolationLevelObservation) DeepCopy() *TransactionDefaultIsolationLevelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionDefaultIsolationLevelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionDefaultIsolationLevelParameters) DeepCopyInto(out *TransactionDefaultIsolationLevelParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionDefaultIsolationLevelParameters.
+func (in *TransactionDefaultIsolationLevelParameters) DeepCopy() *TransactionDefaultIsolationLevelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionDefaultIsolationLevelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwoDigitCenturyStartInitParameters) DeepCopyInto(out *TwoDigitCenturyStartInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwoDigitCenturyStartInitParameters.
+func (in *TwoDigitCenturyStartInitParameters) DeepCopy() *TwoDigitCenturyStartInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TwoDigitCenturyStartInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwoDigitCenturyStartObservation) DeepCopyInto(out *TwoDigitCenturyStartObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwoDigitCenturyStartObservation.
+func (in *TwoDigitCenturyStartObservation) DeepCopy() *TwoDigitCenturyStartObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TwoDigitCenturyStartObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwoDigitCenturyStartParameters) DeepCopyInto(out *TwoDigitCenturyStartParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwoDigitCenturyStartParameters.
+func (in *TwoDigitCenturyStartParameters) DeepCopy() *TwoDigitCenturyStartParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TwoDigitCenturyStartParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsupportedDdlActionInitParameters) DeepCopyInto(out *UnsupportedDdlActionInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsupportedDdlActionInitParameters.
+func (in *UnsupportedDdlActionInitParameters) DeepCopy() *UnsupportedDdlActionInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsupportedDdlActionInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsupportedDdlActionObservation) DeepCopyInto(out *UnsupportedDdlActionObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsupportedDdlActionObservation.
+func (in *UnsupportedDdlActionObservation) DeepCopy() *UnsupportedDdlActionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsupportedDdlActionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsupportedDdlActionParameters) DeepCopyInto(out *UnsupportedDdlActionParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsupportedDdlActionParameters.
+func (in *UnsupportedDdlActionParameters) DeepCopy() *UnsupportedDdlActionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsupportedDdlActionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UseCachedResultInitParameters) DeepCopyInto(out *UseCachedResultInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UseCachedResultInitParameters.
+func (in *UseCachedResultInitParameters) DeepCopy() *UseCachedResultInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UseCachedResultInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UseCachedResultObservation) DeepCopyInto(out *UseCachedResultObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UseCachedResultObservation.
+func (in *UseCachedResultObservation) DeepCopy() *UseCachedResultObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UseCachedResultObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UseCachedResultParameters) DeepCopyInto(out *UseCachedResultParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UseCachedResultParameters.
+func (in *UseCachedResultParameters) DeepCopy() *UseCachedResultParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UseCachedResultParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserInitParameters) DeepCopyInto(out *UserInitParameters) {
+	*out = *in
+	if in.AbortDetachedQuery != nil {
+		in, out := &in.AbortDetachedQuery, &out.AbortDetachedQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Autocommit != nil {
+		in, out := &in.Autocommit, &out.Autocommit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BinaryInputFormat != nil {
+		in, out := &in.BinaryInputFormat, &out.BinaryInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryOutputFormat != nil {
+		in, out := &in.BinaryOutputFormat, &out.BinaryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientMemoryLimit != nil {
+		in, out := &in.ClientMemoryLimit, &out.ClientMemoryLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientMetadataRequestUseConnectionCtx != nil {
+		in, out := &in.ClientMetadataRequestUseConnectionCtx, &out.ClientMetadataRequestUseConnectionCtx
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientPrefetchThreads != nil {
+		in, out := &in.ClientPrefetchThreads, &out.ClientPrefetchThreads
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultChunkSize != nil {
+		in, out := &in.ClientResultChunkSize, &out.ClientResultChunkSize
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultColumnCaseInsensitive != nil {
+		in, out := &in.ClientResultColumnCaseInsensitive, &out.ClientResultColumnCaseInsensitive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAlive != nil {
+		in, out := &in.ClientSessionKeepAlive, &out.ClientSessionKeepAlive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAliveHeartbeatFrequency != nil {
+		in, out := &in.ClientSessionKeepAliveHeartbeatFrequency, &out.ClientSessionKeepAliveHeartbeatFrequency
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientTimestampTypeMapping != nil {
+		in, out := &in.ClientTimestampTypeMapping, &out.ClientTimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateInputFormat != nil {
+		in, out := &in.DateInputFormat, &out.DateInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateOutputFormat != nil {
+		in, out := &in.DateOutputFormat, &out.DateOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DaysToExpiry != nil {
+		in, out := &in.DaysToExpiry, &out.DaysToExpiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSecondaryRolesOption != nil {
+		in, out := &in.DefaultSecondaryRolesOption, &out.DefaultSecondaryRolesOption
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouse != nil {
+		in, out := &in.DefaultWarehouse, &out.DefaultWarehouse
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouseRef != nil {
+		in, out := &in.DefaultWarehouseRef, &out.DefaultWarehouseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultWarehouseSelector != nil {
+		in, out := &in.DefaultWarehouseSelector, &out.DefaultWarehouseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableMfa != nil {
+		in, out := &in.DisableMfa, &out.DisableMfa
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailSecretRef != nil {
+		in, out := &in.EmailSecretRef, &out.EmailSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.EnableUnloadPhysicalTypeOptimization != nil {
+		in, out := &in.EnableUnloadPhysicalTypeOptimization, &out.EnableUnloadPhysicalTypeOptimization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableUnredactedQuerySyntaxError != nil {
+		in, out := &in.EnableUnredactedQuerySyntaxError, &out.EnableUnredactedQuerySyntaxError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicMerge != nil {
+		in, out := &in.ErrorOnNondeterministicMerge, &out.ErrorOnNondeterministicMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicUpdate != nil {
+		in, out := &in.ErrorOnNondeterministicUpdate, &out.ErrorOnNondeterministicUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FirstNameSecretRef != nil {
+		in, out := &in.FirstNameSecretRef, &out.FirstNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.GeographyOutputFormat != nil {
+		in, out := &in.GeographyOutputFormat, &out.GeographyOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeometryOutputFormat != nil {
+		in, out := &in.GeometryOutputFormat, &out.GeometryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.JSONIndent != nil {
+		in, out := &in.JSONIndent, &out.JSONIndent
+		*out = new(float64)
+		**out = **in
+	}
+	if in.JdbcTreatDecimalAsInt != nil {
+		in, out := &in.JdbcTreatDecimalAsInt, &out.JdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcTreatTimestampNtzAsUtc != nil {
+		in, out := &in.JdbcTreatTimestampNtzAsUtc, &out.JdbcTreatTimestampNtzAsUtc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcUseSessionTimezone != nil {
+		in, out := &in.JdbcUseSessionTimezone, &out.JdbcUseSessionTimezone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastNameSecretRef != nil {
+		in, out := &in.LastNameSecretRef, &out.LastNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.LockTimeout != nil {
+		in, out := &in.LockTimeout, &out.LockTimeout
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoginNameSecretRef != nil {
+		in, out := &in.LoginNameSecretRef, &out.LoginNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.MiddleNameSecretRef != nil {
+		in, out := &in.MiddleNameSecretRef, &out.MiddleNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.MinsToBypassMfa != nil {
+		in, out := &in.MinsToBypassMfa, &out.MinsToBypassMfa
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinsToUnlock != nil {
+		in, out := &in.MinsToUnlock, &out.MinsToUnlock
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MultiStatementCount != nil {
+		in, out := &in.MultiStatementCount, &out.MultiStatementCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MustChangePassword != nil {
+		in, out := &in.MustChangePassword, &out.MustChangePassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoorderSequenceAsDefault != nil {
+		in, out := &in.NoorderSequenceAsDefault, &out.NoorderSequenceAsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OdbcTreatDecimalAsInt != nil {
+		in, out := &in.OdbcTreatDecimalAsInt, &out.OdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PreventUnloadToInternalStages != nil {
+		in, out := &in.PreventUnloadToInternalStages, &out.PreventUnloadToInternalStages
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueryTag != nil {
+		in, out := &in.QueryTag, &out.QueryTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.QuotedIdentifiersIgnoreCase != nil {
+		in, out := &in.QuotedIdentifiersIgnoreCase, &out.QuotedIdentifiersIgnoreCase
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RowsPerResultset != nil {
+		in, out := &in.RowsPerResultset, &out.RowsPerResultset
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RsaPublicKey != nil {
+		in, out := &in.RsaPublicKey, &out.RsaPublicKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.RsaPublicKey2 != nil {
+		in, out := &in.RsaPublicKey2, &out.RsaPublicKey2
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3StageVpceDNSName != nil {
+		in, out := &in.S3StageVpceDNSName, &out.S3StageVpceDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchPath != nil {
+		in, out := &in.SearchPath, &out.SearchPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.SimulatedDataSharingConsumer != nil {
+		in, out := &in.SimulatedDataSharingConsumer, &out.SimulatedDataSharingConsumer
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StrictJSONOutput != nil {
+		in, out := &in.StrictJSONOutput, &out.StrictJSONOutput
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeInputFormat != nil {
+		in, out := &in.TimeInputFormat, &out.TimeInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeOutputFormat != nil {
+		in, out := &in.TimeOutputFormat, &out.TimeOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampDayIsAlways24H != nil {
+		in, out := &in.TimestampDayIsAlways24H, &out.TimestampDayIsAlways24H
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimestampInputFormat != nil {
+		in, out := &in.TimestampInputFormat, &out.TimestampInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampLtzOutputFormat != nil {
+		in, out := &in.TimestampLtzOutputFormat, &out.TimestampLtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampNtzOutputFormat != nil {
+		in, out := &in.TimestampNtzOutputFormat, &out.TimestampNtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampOutputFormat != nil {
+		in, out := &in.TimestampOutputFormat, &out.TimestampOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTypeMapping != nil {
+		in, out := &in.TimestampTypeMapping, &out.TimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTzOutputFormat != nil {
+		in, out := &in.TimestampTzOutputFormat, &out.TimestampTzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.TraceLevel != nil {
+		in, out := &in.TraceLevel, &out.TraceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransactionAbortOnError != nil {
+		in, out := &in.TransactionAbortOnError, &out.TransactionAbortOnError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransactionDefaultIsolationLevel != nil {
+		in, out := &in.TransactionDefaultIsolationLevel, &out.TransactionDefaultIsolationLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TwoDigitCenturyStart != nil {
+		in, out := &in.TwoDigitCenturyStart, &out.TwoDigitCenturyStart
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UnsupportedDdlAction != nil {
+		in, out := &in.UnsupportedDdlAction, &out.UnsupportedDdlAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseCachedResult != nil {
+		in, out := &in.UseCachedResult, &out.UseCachedResult
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WeekOfYearPolicy != nil {
+		in, out := &in.WeekOfYearPolicy, &out.WeekOfYearPolicy
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WeekStart != nil {
+		in, out := &in.WeekStart, &out.WeekStart
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserInitParameters.
+func (in *UserInitParameters) DeepCopy() *UserInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
+	*out = *in
+	if in.AbortDetachedQuery != nil {
+		in, out := &in.AbortDetachedQuery, &out.AbortDetachedQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Autocommit != nil {
+		in, out := &in.Autocommit, &out.Autocommit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BinaryInputFormat != nil {
+		in, out := &in.BinaryInputFormat, &out.BinaryInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryOutputFormat != nil {
+		in, out := &in.BinaryOutputFormat, &out.BinaryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientMemoryLimit != nil {
+		in, out := &in.ClientMemoryLimit, &out.ClientMemoryLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientMetadataRequestUseConnectionCtx != nil {
+		in, out := &in.ClientMetadataRequestUseConnectionCtx, &out.ClientMetadataRequestUseConnectionCtx
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientPrefetchThreads != nil {
+		in, out := &in.ClientPrefetchThreads, &out.ClientPrefetchThreads
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultChunkSize != nil {
+		in, out := &in.ClientResultChunkSize, &out.ClientResultChunkSize
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultColumnCaseInsensitive != nil {
+		in, out := &in.ClientResultColumnCaseInsensitive, &out.ClientResultColumnCaseInsensitive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAlive != nil {
+		in, out := &in.ClientSessionKeepAlive, &out.ClientSessionKeepAlive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAliveHeartbeatFrequency != nil {
+		in, out := &in.ClientSessionKeepAliveHeartbeatFrequency, &out.ClientSessionKeepAliveHeartbeatFrequency
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientTimestampTypeMapping != nil {
+		in, out := &in.ClientTimestampTypeMapping, &out.ClientTimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateInputFormat != nil {
+		in, out := &in.DateInputFormat, &out.DateInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateOutputFormat != nil {
+		in, out := &in.DateOutputFormat, &out.DateOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DaysToExpiry != nil {
+		in, out := &in.DaysToExpiry, &out.DaysToExpiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSecondaryRolesOption != nil {
+		in, out := &in.DefaultSecondaryRolesOption, &out.DefaultSecondaryRolesOption
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouse != nil {
+		in, out := &in.DefaultWarehouse, &out.DefaultWarehouse
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableMfa != nil {
+		in, out := &in.DisableMfa, &out.DisableMfa
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableUnloadPhysicalTypeOptimization != nil {
+		in, out := &in.EnableUnloadPhysicalTypeOptimization, &out.EnableUnloadPhysicalTypeOptimization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableUnredactedQuerySyntaxError != nil {
+		in, out := &in.EnableUnredactedQuerySyntaxError, &out.EnableUnredactedQuerySyntaxError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicMerge != nil {
+		in, out := &in.ErrorOnNondeterministicMerge, &out.ErrorOnNondeterministicMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicUpdate != nil {
+		in, out := &in.ErrorOnNondeterministicUpdate, &out.ErrorOnNondeterministicUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeographyOutputFormat != nil {
+		in, out := &in.GeographyOutputFormat, &out.GeographyOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeometryOutputFormat != nil {
+		in, out := &in.GeometryOutputFormat, &out.GeometryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.JSONIndent != nil {
+		in, out := &in.JSONIndent, &out.JSONIndent
+		*out = new(float64)
+		**out = **in
+	}
+	if in.JdbcTreatDecimalAsInt != nil {
+		in, out := &in.JdbcTreatDecimalAsInt, &out.JdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcTreatTimestampNtzAsUtc != nil {
+		in, out := &in.JdbcTreatTimestampNtzAsUtc, &out.JdbcTreatTimestampNtzAsUtc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcUseSessionTimezone != nil {
+		in, out := &in.JdbcUseSessionTimezone, &out.JdbcUseSessionTimezone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockTimeout != nil {
+		in, out := &in.LockTimeout, &out.LockTimeout
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinsToBypassMfa != nil {
+		in, out := &in.MinsToBypassMfa, &out.MinsToBypassMfa
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinsToUnlock != nil {
+		in, out := &in.MinsToUnlock, &out.MinsToUnlock
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MultiStatementCount != nil {
+		in, out := &in.MultiStatementCount, &out.MultiStatementCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MustChangePassword != nil {
+		in, out := &in.MustChangePassword, &out.MustChangePassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoorderSequenceAsDefault != nil {
+		in, out := &in.NoorderSequenceAsDefault, &out.NoorderSequenceAsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OdbcTreatDecimalAsInt != nil {
+		in, out := &in.OdbcTreatDecimalAsInt, &out.OdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParametersObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreventUnloadToInternalStages != nil {
+		in, out := &in.PreventUnloadToInternalStages, &out.PreventUnloadToInternalStages
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueryTag != nil {
+		in, out := &in.QueryTag, &out.QueryTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.QuotedIdentifiersIgnoreCase != nil {
+		in, out := &in.QuotedIdentifiersIgnoreCase, &out.QuotedIdentifiersIgnoreCase
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RowsPerResultset != nil {
+		in, out := &in.RowsPerResultset, &out.RowsPerResultset
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RsaPublicKey != nil {
+		in, out := &in.RsaPublicKey, &out.RsaPublicKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.RsaPublicKey2 != nil {
+		in, out := &in.RsaPublicKey2, &out.RsaPublicKey2
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3StageVpceDNSName != nil {
+		in, out := &in.S3StageVpceDNSName, &out.S3StageVpceDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchPath != nil {
+		in, out := &in.SearchPath, &out.SearchPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShowOutput != nil {
+		in, out := &in.ShowOutput, &out.ShowOutput
+		*out = make([]UserShowOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SimulatedDataSharingConsumer != nil {
+		in, out := &in.SimulatedDataSharingConsumer, &out.SimulatedDataSharingConsumer
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StrictJSONOutput != nil {
+		in, out := &in.StrictJSONOutput, &out.StrictJSONOutput
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeInputFormat != nil {
+		in, out := &in.TimeInputFormat, &out.TimeInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeOutputFormat != nil {
+		in, out := &in.TimeOutputFormat, &out.TimeOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampDayIsAlways24H != nil {
+		in, out := &in.TimestampDayIsAlways24H, &out.TimestampDayIsAlways24H
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimestampInputFormat != nil {
+		in, out := &in.TimestampInputFormat, &out.TimestampInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampLtzOutputFormat != nil {
+		in, out := &in.TimestampLtzOutputFormat, &out.TimestampLtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampNtzOutputFormat != nil {
+		in, out := &in.TimestampNtzOutputFormat, &out.TimestampNtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampOutputFormat != nil {
+		in, out := &in.TimestampOutputFormat, &out.TimestampOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTypeMapping != nil {
+		in, out := &in.TimestampTypeMapping, &out.TimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTzOutputFormat != nil {
+		in, out := &in.TimestampTzOutputFormat, &out.TimestampTzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.TraceLevel != nil {
+		in, out := &in.TraceLevel, &out.TraceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransactionAbortOnError != nil {
+		in, out := &in.TransactionAbortOnError, &out.TransactionAbortOnError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransactionDefaultIsolationLevel != nil {
+		in, out := &in.TransactionDefaultIsolationLevel, &out.TransactionDefaultIsolationLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TwoDigitCenturyStart != nil {
+		in, out := &in.TwoDigitCenturyStart, &out.TwoDigitCenturyStart
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UnsupportedDdlAction != nil {
+		in, out := &in.UnsupportedDdlAction, &out.UnsupportedDdlAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseCachedResult != nil {
+		in, out := &in.UseCachedResult, &out.UseCachedResult
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserType != nil {
+		in, out := &in.UserType, &out.UserType
+		*out = new(string)
+		**out = **in
+	}
+	if in.WeekOfYearPolicy != nil {
+		in, out := &in.WeekOfYearPolicy, &out.WeekOfYearPolicy
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WeekStart != nil {
+		in, out := &in.WeekStart, &out.WeekStart
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserParameters) DeepCopyInto(out *UserParameters) {
+	*out = *in
+	if in.AbortDetachedQuery != nil {
+		in, out := &in.AbortDetachedQuery, &out.AbortDetachedQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Autocommit != nil {
+		in, out := &in.Autocommit, &out.Autocommit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BinaryInputFormat != nil {
+		in, out := &in.BinaryInputFormat, &out.BinaryInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryOutputFormat != nil {
+		in, out := &in.BinaryOutputFormat, &out.BinaryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientMemoryLimit != nil {
+		in, out := &in.ClientMemoryLimit, &out.ClientMemoryLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientMetadataRequestUseConnectionCtx != nil {
+		in, out := &in.ClientMetadataRequestUseConnectionCtx, &out.ClientMetadataRequestUseConnectionCtx
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientPrefetchThreads != nil {
+		in, out := &in.ClientPrefetchThreads, &out.ClientPrefetchThreads
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultChunkSize != nil {
+		in, out := &in.ClientResultChunkSize, &out.ClientResultChunkSize
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientResultColumnCaseInsensitive != nil {
+		in, out := &in.ClientResultColumnCaseInsensitive, &out.ClientResultColumnCaseInsensitive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAlive != nil {
+		in, out := &in.ClientSessionKeepAlive, &out.ClientSessionKeepAlive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientSessionKeepAliveHeartbeatFrequency != nil {
+		in, out := &in.ClientSessionKeepAliveHeartbeatFrequency, &out.ClientSessionKeepAliveHeartbeatFrequency
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClientTimestampTypeMapping != nil {
+		in, out := &in.ClientTimestampTypeMapping, &out.ClientTimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateInputFormat != nil {
+		in, out := &in.DateInputFormat, &out.DateInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateOutputFormat != nil {
+		in, out := &in.DateOutputFormat, &out.DateOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DaysToExpiry != nil {
+		in, out := &in.DaysToExpiry, &out.DaysToExpiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSecondaryRolesOption != nil {
+		in, out := &in.DefaultSecondaryRolesOption, &out.DefaultSecondaryRolesOption
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouse != nil {
+		in, out := &in.DefaultWarehouse, &out.DefaultWarehouse
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouseRef != nil {
+		in, out := &in.DefaultWarehouseRef, &out.DefaultWarehouseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultWarehouseSelector != nil {
+		in, out := &in.DefaultWarehouseSelector, &out.DefaultWarehouseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableMfa != nil {
+		in, out := &in.DisableMfa, &out.DisableMfa
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailSecretRef != nil {
+		in, out := &in.EmailSecretRef, &out.EmailSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.EnableUnloadPhysicalTypeOptimization != nil {
+		in, out := &in.EnableUnloadPhysicalTypeOptimization, &out.EnableUnloadPhysicalTypeOptimization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableUnredactedQuerySyntaxError != nil {
+		in, out := &in.EnableUnredactedQuerySyntaxError, &out.EnableUnredactedQuerySyntaxError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicMerge != nil {
+		in, out := &in.ErrorOnNondeterministicMerge, &out.ErrorOnNondeterministicMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorOnNondeterministicUpdate != nil {
+		in, out := &in.ErrorOnNondeterministicUpdate, &out.ErrorOnNondeterministicUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FirstNameSecretRef != nil {
+		in, out := &in.FirstNameSecretRef, &out.FirstNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.GeographyOutputFormat != nil {
+		in, out := &in.GeographyOutputFormat, &out.GeographyOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeometryOutputFormat != nil {
+		in, out := &in.GeometryOutputFormat, &out.GeometryOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.JSONIndent != nil {
+		in, out := &in.JSONIndent, &out.JSONIndent
+		*out = new(float64)
+		**out = **in
+	}
+	if in.JdbcTreatDecimalAsInt != nil {
+		in, out := &in.JdbcTreatDecimalAsInt, &out.JdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcTreatTimestampNtzAsUtc != nil {
+		in, out := &in.JdbcTreatTimestampNtzAsUtc, &out.JdbcTreatTimestampNtzAsUtc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JdbcUseSessionTimezone != nil {
+		in, out := &in.JdbcUseSessionTimezone, &out.JdbcUseSessionTimezone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastNameSecretRef != nil {
+		in, out := &in.LastNameSecretRef, &out.LastNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.LockTimeout != nil {
+		in, out := &in.LockTimeout, &out.LockTimeout
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoginNameSecretRef != nil {
+		in, out := &in.LoginNameSecretRef, &out.LoginNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.MiddleNameSecretRef != nil {
+		in, out := &in.MiddleNameSecretRef, &out.MiddleNameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.MinsToBypassMfa != nil {
+		in, out := &in.MinsToBypassMfa, &out.MinsToBypassMfa
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinsToUnlock != nil {
+		in, out := &in.MinsToUnlock, &out.MinsToUnlock
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MultiStatementCount != nil {
+		in, out := &in.MultiStatementCount, &out.MultiStatementCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MustChangePassword != nil {
+		in, out := &in.MustChangePassword, &out.MustChangePassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoorderSequenceAsDefault != nil {
+		in, out := &in.NoorderSequenceAsDefault, &out.NoorderSequenceAsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OdbcTreatDecimalAsInt != nil {
+		in, out := &in.OdbcTreatDecimalAsInt, &out.OdbcTreatDecimalAsInt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PreventUnloadToInternalStages != nil {
+		in, out := &in.PreventUnloadToInternalStages, &out.PreventUnloadToInternalStages
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueryTag != nil {
+		in, out := &in.QueryTag, &out.QueryTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.QuotedIdentifiersIgnoreCase != nil {
+		in, out := &in.QuotedIdentifiersIgnoreCase, &out.QuotedIdentifiersIgnoreCase
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RowsPerResultset != nil {
+		in, out := &in.RowsPerResultset, &out.RowsPerResultset
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RsaPublicKey != nil {
+		in, out := &in.RsaPublicKey, &out.RsaPublicKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.RsaPublicKey2 != nil {
+		in, out := &in.RsaPublicKey2, &out.RsaPublicKey2
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3StageVpceDNSName != nil {
+		in, out := &in.S3StageVpceDNSName, &out.S3StageVpceDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchPath != nil {
+		in, out := &in.SearchPath, &out.SearchPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.SimulatedDataSharingConsumer != nil {
+		in, out := &in.SimulatedDataSharingConsumer, &out.SimulatedDataSharingConsumer
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StrictJSONOutput != nil {
+		in, out := &in.StrictJSONOutput, &out.StrictJSONOutput
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeInputFormat != nil {
+		in, out := &in.TimeInputFormat, &out.TimeInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeOutputFormat != nil {
+		in, out := &in.TimeOutputFormat, &out.TimeOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampDayIsAlways24H != nil {
+		in, out := &in.TimestampDayIsAlways24H, &out.TimestampDayIsAlways24H
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimestampInputFormat != nil {
+		in, out := &in.TimestampInputFormat, &out.TimestampInputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampLtzOutputFormat != nil {
+		in, out := &in.TimestampLtzOutputFormat, &out.TimestampLtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampNtzOutputFormat != nil {
+		in, out := &in.TimestampNtzOutputFormat, &out.TimestampNtzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampOutputFormat != nil {
+		in, out := &in.TimestampOutputFormat, &out.TimestampOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTypeMapping != nil {
+		in, out := &in.TimestampTypeMapping, &out.TimestampTypeMapping
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampTzOutputFormat != nil {
+		in, out := &in.TimestampTzOutputFormat, &out.TimestampTzOutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.TraceLevel != nil {
+		in, out := &in.TraceLevel, &out.TraceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransactionAbortOnError != nil {
+		in, out := &in.TransactionAbortOnError, &out.TransactionAbortOnError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransactionDefaultIsolationLevel != nil {
+		in, out := &in.TransactionDefaultIsolationLevel, &out.TransactionDefaultIsolationLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TwoDigitCenturyStart != nil {
+		in, out := &in.TwoDigitCenturyStart, &out.TwoDigitCenturyStart
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UnsupportedDdlAction != nil {
+		in, out := &in.UnsupportedDdlAction, &out.UnsupportedDdlAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseCachedResult != nil {
+		in, out := &in.UseCachedResult, &out.UseCachedResult
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WeekOfYearPolicy != nil {
+		in, out := &in.WeekOfYearPolicy, &out.WeekOfYearPolicy
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WeekStart != nil {
+		in, out := &in.WeekStart, &out.WeekStart
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
+func (in *UserParameters) DeepCopy() *UserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserShowOutputInitParameters) DeepCopyInto(out *UserShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserShowOutputInitParameters.
+func (in *UserShowOutputInitParameters) DeepCopy() *UserShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserShowOutputObservation) DeepCopyInto(out *UserShowOutputObservation) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DaysToExpiry != nil {
+		in, out := &in.DaysToExpiry, &out.DaysToExpiry
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSecondaryRoles != nil {
+		in, out := &in.DefaultSecondaryRoles, &out.DefaultSecondaryRoles
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultWarehouse != nil {
+		in, out := &in.DefaultWarehouse, &out.DefaultWarehouse
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAtTime != nil {
+		in, out := &in.ExpiresAtTime, &out.ExpiresAtTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtAuthnDuo != nil {
+		in, out := &in.ExtAuthnDuo, &out.ExtAuthnDuo
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAuthnUID != nil {
+		in, out := &in.ExtAuthnUID, &out.ExtAuthnUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirstName != nil {
+		in, out := &in.FirstName, &out.FirstName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HasMfa != nil {
+		in, out := &in.HasMfa, &out.HasMfa
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasPassword != nil {
+		in, out := &in.HasPassword, &out.HasPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasRsaPublicKey != nil {
+		in, out := &in.HasRsaPublicKey, &out.HasRsaPublicKey
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastName != nil {
+		in, out := &in.LastName, &out.LastName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastSuccessLogin != nil {
+		in, out := &in.LastSuccessLogin, &out.LastSuccessLogin
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockedUntilTime != nil {
+		in, out := &in.LockedUntilTime, &out.LockedUntilTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoginName != nil {
+		in, out := &in.LoginName, &out.LoginName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinsToBypassMfa != nil {
+		in, out := &in.MinsToBypassMfa, &out.MinsToBypassMfa
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinsToUnlock != nil {
+		in, out := &in.MinsToUnlock, &out.MinsToUnlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.MustChangePassword != nil {
+		in, out := &in.MustChangePassword, &out.MustChangePassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnowflakeLock != nil {
+		in, out := &in.SnowflakeLock, &out.SnowflakeLock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserShowOutputObservation.
+func (in *UserShowOutputObservation) DeepCopy() *UserShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserShowOutputParameters) DeepCopyInto(out *UserShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserShowOutputParameters.
+func (in *UserShowOutputParameters) DeepCopy() *UserShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Warehouse) DeepCopyInto(out *Warehouse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
+func (in *Warehouse) DeepCopy() *Warehouse {
+	if in == nil {
+		return nil
+	}
+	out := new(Warehouse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Warehouse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseInitParameters) DeepCopyInto(out *WarehouseInitParameters) {
+	*out = *in
+	if in.AutoResume != nil {
+		in, out := &in.AutoResume, &out.AutoResume
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoSuspend != nil {
+		in, out := &in.AutoSuspend, &out.AutoSuspend
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableQueryAcceleration != nil {
+		in, out := &in.EnableQueryAcceleration, &out.EnableQueryAcceleration
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitiallySuspended != nil {
+		in, out := &in.InitiallySuspended, &out.InitiallySuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxClusterCount != nil {
+		in, out := &in.MaxClusterCount, &out.MaxClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxConcurrencyLevel != nil {
+		in, out := &in.MaxConcurrencyLevel, &out.MaxConcurrencyLevel
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinClusterCount != nil {
+		in, out := &in.MinClusterCount, &out.MinClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryAccelerationMaxScaleFactor != nil {
+		in, out := &in.QueryAccelerationMaxScaleFactor, &out.QueryAccelerationMaxScaleFactor
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ResourceMonitor != nil {
+		in, out := &in.ResourceMonitor, &out.ResourceMonitor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceMonitorRef != nil {
+		in, out := &in.ResourceMonitorRef, &out.ResourceMonitorRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceMonitorSelector != nil {
+		in, out := &in.ResourceMonitorSelector, &out.ResourceMonitorSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingPolicy != nil {
+		in, out := &in.ScalingPolicy, &out.ScalingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WarehouseSize != nil {
+		in, out := &in.WarehouseSize, &out.WarehouseSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.WarehouseType != nil {
+		in, out := &in.WarehouseType, &out.WarehouseType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseInitParameters.
+func (in *WarehouseInitParameters) DeepCopy() *WarehouseInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseList) DeepCopyInto(out *WarehouseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Warehouse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseList.
+func (in *WarehouseList) DeepCopy() *WarehouseList {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WarehouseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseObservation) DeepCopyInto(out *WarehouseObservation) {
+	*out = *in
+	if in.AutoResume != nil {
+		in, out := &in.AutoResume, &out.AutoResume
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoSuspend != nil {
+		in, out := &in.AutoSuspend, &out.AutoSuspend
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableQueryAcceleration != nil {
+		in, out := &in.EnableQueryAcceleration, &out.EnableQueryAcceleration
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitiallySuspended != nil {
+		in, out := &in.InitiallySuspended, &out.InitiallySuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxClusterCount != nil {
+		in, out := &in.MaxClusterCount, &out.MaxClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxConcurrencyLevel != nil {
+		in, out := &in.MaxConcurrencyLevel, &out.MaxConcurrencyLevel
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinClusterCount != nil {
+		in, out := &in.MinClusterCount, &out.MinClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]WarehouseParametersObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueryAccelerationMaxScaleFactor != nil {
+		in, out := &in.QueryAccelerationMaxScaleFactor, &out.QueryAccelerationMaxScaleFactor
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ResourceMonitor != nil {
+		in, out := &in.ResourceMonitor, &out.ResourceMonitor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingPolicy != nil {
+		in, out := &in.ScalingPolicy, &out.ScalingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShowOutput != nil {
+		in, out := &in.ShowOutput, &out.ShowOutput
+		*out = make([]WarehouseShowOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WarehouseSize != nil {
+		in, out := &in.WarehouseSize, &out.WarehouseSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.WarehouseType != nil {
+		in, out := &in.WarehouseType, &out.WarehouseType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseObservation.
+func (in *WarehouseObservation) DeepCopy() *WarehouseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseParameters) DeepCopyInto(out *WarehouseParameters) {
+	*out = *in
+	if in.AutoResume != nil {
+		in, out := &in.AutoResume, &out.AutoResume
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoSuspend != nil {
+		in, out := &in.AutoSuspend, &out.AutoSuspend
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableQueryAcceleration != nil {
+		in, out := &in.EnableQueryAcceleration, &out.EnableQueryAcceleration
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitiallySuspended != nil {
+		in, out := &in.InitiallySuspended, &out.InitiallySuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxClusterCount != nil {
+		in, out := &in.MaxClusterCount, &out.MaxClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxConcurrencyLevel != nil {
+		in, out := &in.MaxConcurrencyLevel, &out.MaxConcurrencyLevel
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinClusterCount != nil {
+		in, out := &in.MinClusterCount, &out.MinClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryAccelerationMaxScaleFactor != nil {
+		in, out := &in.QueryAccelerationMaxScaleFactor, &out.QueryAccelerationMaxScaleFactor
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ResourceMonitor != nil {
+		in, out := &in.ResourceMonitor, &out.ResourceMonitor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceMonitorRef != nil {
+		in, out := &in.ResourceMonitorRef, &out.ResourceMonitorRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceMonitorSelector != nil {
+		in, out := &in.ResourceMonitorSelector, &out.ResourceMonitorSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingPolicy != nil {
+		in, out := &in.ScalingPolicy, &out.ScalingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WarehouseSize != nil {
+		in, out := &in.WarehouseSize, &out.WarehouseSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.WarehouseType != nil {
+		in, out := &in.WarehouseType, &out.WarehouseType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseParameters.
+func (in *WarehouseParameters) DeepCopy() *WarehouseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseParametersInitParameters) DeepCopyInto(out *WarehouseParametersInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseParametersInitParameters.
+func (in *WarehouseParametersInitParameters) DeepCopy() *WarehouseParametersInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseParametersInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseParametersObservation) DeepCopyInto(out *WarehouseParametersObservation) {
+	*out = *in
+	if in.MaxConcurrencyLevel != nil {
+		in, out := &in.MaxConcurrencyLevel, &out.MaxConcurrencyLevel
+		*out = make([]MaxConcurrencyLevelObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StatementQueuedTimeoutInSeconds != nil {
+		in, out := &in.StatementQueuedTimeoutInSeconds, &out.StatementQueuedTimeoutInSeconds
+		*out = make([]ParametersStatementQueuedTimeoutInSecondsObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StatementTimeoutInSeconds != nil {
+		in, out := &in.StatementTimeoutInSeconds, &out.StatementTimeoutInSeconds
+		*out = make([]ParametersStatementTimeoutInSecondsObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseParametersObservation.
+func (in *WarehouseParametersObservation) DeepCopy() *WarehouseParametersObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseParametersObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseParametersParameters) DeepCopyInto(out *WarehouseParametersParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseParametersParameters.
+func (in *WarehouseParametersParameters) DeepCopy() *WarehouseParametersParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseParametersParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseShowOutputInitParameters) DeepCopyInto(out *WarehouseShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseShowOutputInitParameters.
+func (in *WarehouseShowOutputInitParameters) DeepCopy() *WarehouseShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseShowOutputObservation) DeepCopyInto(out *WarehouseShowOutputObservation) {
+	*out = *in
+	if in.AutoResume != nil {
+		in, out := &in.AutoResume, &out.AutoResume
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoSuspend != nil {
+		in, out := &in.AutoSuspend, &out.AutoSuspend
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Available != nil {
+		in, out := &in.Available, &out.Available
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableQueryAcceleration != nil {
+		in, out := &in.EnableQueryAcceleration, &out.EnableQueryAcceleration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsCurrent != nil {
+		in, out := &in.IsCurrent, &out.IsCurrent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxClusterCount != nil {
+		in, out := &in.MaxClusterCount, &out.MaxClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinClusterCount != nil {
+		in, out := &in.MinClusterCount, &out.MinClusterCount
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Other != nil {
+		in, out := &in.Other, &out.Other
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerRoleType != nil {
+		in, out := &in.OwnerRoleType, &out.OwnerRoleType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Provisioning != nil {
+		in, out := &in.Provisioning, &out.Provisioning
+		*out = new(float64)
+		**out = **in
+	}
+	if in.QueryAccelerationMaxScaleFactor != nil {
+		in, out := &in.QueryAccelerationMaxScaleFactor, &out.QueryAccelerationMaxScaleFactor
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Queued != nil {
+		in, out := &in.Queued, &out.Queued
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Quiescing != nil {
+		in, out := &in.Quiescing, &out.Quiescing
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ResourceMonitor != nil {
+		in, out := &in.ResourceMonitor, &out.ResourceMonitor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResumedOn != nil {
+		in, out := &in.ResumedOn, &out.ResumedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Running != nil {
+		in, out := &in.Running, &out.Running
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ScalingPolicy != nil {
+		in, out := &in.ScalingPolicy, &out.ScalingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartedClusters != nil {
+		in, out := &in.StartedClusters, &out.StartedClusters
+		*out = new(float64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedOn != nil {
+		in, out := &in.UpdatedOn, &out.UpdatedOn
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseShowOutputObservation.
+func (in *WarehouseShowOutputObservation) DeepCopy() *WarehouseShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseShowOutputParameters) DeepCopyInto(out *WarehouseShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseShowOutputParameters.
+func (in *WarehouseShowOutputParameters) DeepCopy() *WarehouseShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
+func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
+func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekOfYearPolicyInitParameters) DeepCopyInto(out *WeekOfYearPolicyInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekOfYearPolicyInitParameters.
+func (in *WeekOfYearPolicyInitParameters) DeepCopy() *WeekOfYearPolicyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekOfYearPolicyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekOfYearPolicyObservation) DeepCopyInto(out *WeekOfYearPolicyObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekOfYearPolicyObservation.
+func (in *WeekOfYearPolicyObservation) DeepCopy() *WeekOfYearPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekOfYearPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekOfYearPolicyParameters) DeepCopyInto(out *WeekOfYearPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekOfYearPolicyParameters.
+func (in *WeekOfYearPolicyParameters) DeepCopy() *WeekOfYearPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekOfYearPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekStartInitParameters) DeepCopyInto(out *WeekStartInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekStartInitParameters.
+func (in *WeekStartInitParameters) DeepCopy() *WeekStartInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekStartInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekStartObservation) DeepCopyInto(out *WeekStartObservation) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekStartObservation.
+func (in *WeekStartObservation) DeepCopy() *WeekStartObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekStartObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekStartParameters) DeepCopyInto(out *WeekStartParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekStartParameters.
+func (in *WeekStartParameters) DeepCopy() *WeekStartParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekStartParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this User.
+func (mg *User) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DefaultWarehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DefaultWarehouseRef,
+		Selector:     mg.Spec.ForProvider.DefaultWarehouseSelector,
+		To: reference.To{
+			List:    &WarehouseList{},
+			Managed: &Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DefaultWarehouse")
+	}
+	mg.Spec.ForProvider.DefaultWarehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DefaultWarehouseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.DefaultWarehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DefaultWarehouseRef,
+		Selector:     mg.Spec.InitProvider.DefaultWarehouseSelector,
+		To: reference.To{
+			List:    &WarehouseList{},
+			Managed: &Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.DefaultWarehouse")
+	}
+	mg.Spec.InitProvider.DefaultWarehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DefaultWarehouseRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Warehouse.
+func (mg *Warehouse) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceMonitor),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.ResourceMonitorRef,
+		Selector:     mg.Spec.ForProvider.ResourceMonitorSelector,
+		To: reference.To{
+			List:    &ResourceMonitorList{},
+			Managed: &ResourceMonitor{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ResourceMonitor")
+	}
+	mg.Spec.ForProvider.ResourceMonitor = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceMonitorRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.ResourceMonitor),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.ResourceMonitorRef,
+		Selector:     mg.Spec.InitProvider.ResourceMonitorSelector,
+		To: reference.To{
+			List:    &ResourceMonitorList{},
+			Managed: &ResourceMonitor{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.ResourceMonitor")
+	}
+	mg.Spec.InitProvider.ResourceMonitor = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ResourceMonitorRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/allenkallz/provider-snowflake/internal/protection"
+)
+
+// +kubebuilder:webhook:verbs=delete,path=/validate-database-snowflake-com-v1alpha1-database,mutating=false,failurePolicy=fail,groups=database.snowflake.com,resources=databases,versions=v1alpha1,name=databases.database.snowflake.com,sideEffects=None,admissionReviewVersions=v1
+
+var _ admission.Validator = &Database{}
+
+// ValidateCreate implements admission.Validator. Creates are always allowed.
+func (tr *Database) ValidateCreate() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.Validator. Updates are always allowed so
+// that the deletion protection annotation can be lifted.
+func (tr *Database) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements admission.Validator. It refuses to delete a
+// Database carrying the deletion protection annotation.
+func (tr *Database) ValidateDelete() (admission.Warnings, error) {
+	return nil, protection.ValidateDelete(tr)
+}
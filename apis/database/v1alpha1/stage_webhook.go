@@ -0,0 +1,33 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/allenkallz/provider-snowflake/internal/stage"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-snowflake-com-v1alpha1-stage,mutating=false,failurePolicy=fail,groups=database.snowflake.com,resources=stages,versions=v1alpha1,name=stages.database.snowflake.com,sideEffects=None,admissionReviewVersions=v1
+
+var _ admission.Validator = &Stage{}
+
+// ValidateCreate implements admission.Validator. It refuses Stages whose
+// credentials or encryption do not fit the cloud they store files in, and
+// warns about their deprecated credentials and encryption fields.
+func (tr *Stage) ValidateCreate() (admission.Warnings, error) {
+	return stage.Warnings(tr), stage.Validate(tr)
+}
+
+// ValidateUpdate implements admission.Validator. It refuses changes to the
+// URL, storage integration, credentials or encryption of a Stage that leave
+// credentials or encryption that do not fit the cloud it stores files in,
+// and warns about its deprecated credentials and
+// encryption fields.
+func (tr *Stage) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	return stage.Warnings(tr), stage.ValidateUpdate(old, tr)
+}
+
+// ValidateDelete implements admission.Validator. Deletes are always allowed.
+func (tr *Stage) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,33 @@
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/allenkallz/provider-snowflake/internal/protection"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-snowflake-com-v1alpha1-table,mutating=false,failurePolicy=fail,groups=database.snowflake.com,resources=tables,versions=v1alpha1,name=tables.database.snowflake.com,sideEffects=None,admissionReviewVersions=v1
+
+var _ admission.Validator = &Table{}
+
+// ValidateCreate implements admission.Validator. Creates are always allowed.
+func (tr *Table) ValidateCreate() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.Validator. It refuses updates that drop
+// columns or narrow their types, unless the Table allows destructive changes.
+func (tr *Table) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	o, ok := old.(*Table)
+	if !ok {
+		return nil, errors.New("old object is not a Table")
+	}
+	return nil, protection.ValidateTableUpdate(o, tr)
+}
+
+// ValidateDelete implements admission.Validator. Deletes are always allowed.
+func (tr *Table) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
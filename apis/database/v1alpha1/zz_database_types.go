@@ -41,8 +41,18 @@ type DatabaseInitParameters struct {
 
 	// (String) The database parameter that specifies the default external volume to use for Iceberg tables. For more information, see EXTERNAL_VOLUME.
 	// The database parameter that specifies the default external volume to use for Iceberg tables. For more information, see [EXTERNAL_VOLUME](https://docs.snowflake.com/en/sql-reference/parameters#external-volume).
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/account/v1alpha1.ExternalVolume
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	ExternalVolume *string `json:"externalVolume,omitempty" tf:"external_volume,omitempty"`
 
+	// Reference to a ExternalVolume in account to populate externalVolume.
+	// +kubebuilder:validation:Optional
+	ExternalVolumeRef *v1.Reference `json:"externalVolumeRef,omitempty" tf:"-"`
+
+	// Selector for a ExternalVolume in account to populate externalVolume.
+	// +kubebuilder:validation:Optional
+	ExternalVolumeSelector *v1.Selector `json:"externalVolumeSelector,omitempty" tf:"-"`
+
 	// safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.
 	// Specifies the database as transient. Transient databases do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.
 	IsTransient *bool `json:"isTransient,omitempty" tf:"is_transient,omitempty"`
@@ -228,9 +238,19 @@ type DatabaseParameters struct {
 
 	// (String) The database parameter that specifies the default external volume to use for Iceberg tables. For more information, see EXTERNAL_VOLUME.
 	// The database parameter that specifies the default external volume to use for Iceberg tables. For more information, see [EXTERNAL_VOLUME](https://docs.snowflake.com/en/sql-reference/parameters#external-volume).
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/account/v1alpha1.ExternalVolume
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	// +kubebuilder:validation:Optional
 	ExternalVolume *string `json:"externalVolume,omitempty" tf:"external_volume,omitempty"`
 
+	// Reference to a ExternalVolume in account to populate externalVolume.
+	// +kubebuilder:validation:Optional
+	ExternalVolumeRef *v1.Reference `json:"externalVolumeRef,omitempty" tf:"-"`
+
+	// Selector for a ExternalVolume in account to populate externalVolume.
+	// +kubebuilder:validation:Optional
+	ExternalVolumeSelector *v1.Selector `json:"externalVolumeSelector,omitempty" tf:"-"`
+
 	// safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.
 	// Specifies the database as transient. Transient databases do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.
 	// +kubebuilder:validation:Optional
@@ -0,0 +1,376 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DynamicTableInitParameters struct {
+
+	// (String) Specifies a comment for the dynamic table.
+	// Specifies a comment for the dynamic table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the dynamic table.
+	// The database in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) (Default: ON_CREATE) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	// (Default: `ON_CREATE`) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	Initialize *string `json:"initialize,omitempty" tf:"initialize,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	// Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Specifies whether to replace the dynamic table if it already exists.
+	// (Default: `false`) Specifies whether to replace the dynamic table if it already exists.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) Specifies the query to use to populate the dynamic table.
+	// Specifies the query to use to populate the dynamic table.
+	Query *string `json:"query,omitempty" tf:"query,omitempty"`
+
+	// (String) (Default: AUTO) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	// (Default: `AUTO`) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	RefreshMode *string `json:"refreshMode,omitempty" tf:"refresh_mode,omitempty"`
+
+	// (String) The schema in which to create the dynamic table.
+	// The schema in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (Block List, Min: 1, Max: 1) Specifies the target lag time for the dynamic table. (see below for nested schema)
+	// Specifies the target lag time for the dynamic table.
+	TargetLag []TargetLagInitParameters `json:"targetLag,omitempty" tf:"target_lag,omitempty"`
+
+	// (String) The warehouse in which to create the dynamic table.
+	// The warehouse in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/account/v1alpha1.Warehouse
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+
+	// Reference to a Warehouse in account to populate warehouse.
+	// +kubebuilder:validation:Optional
+	WarehouseRef *v1.Reference `json:"warehouseRef,omitempty" tf:"-"`
+
+	// Selector for a Warehouse in account to populate warehouse.
+	// +kubebuilder:validation:Optional
+	WarehouseSelector *v1.Selector `json:"warehouseSelector,omitempty" tf:"-"`
+}
+
+type DynamicTableObservation struct {
+
+	// clustering is enabled on the dynamic table. Not currently supported for dynamic tables.
+	// Whether auto-clustering is enabled on the dynamic table. Not currently supported for dynamic tables.
+	AutomaticClustering *bool `json:"automaticClustering,omitempty" tf:"automatic_clustering,omitempty"`
+
+	// (Number) Number of bytes that will be scanned if the entire dynamic table is scanned in a query.
+	// Number of bytes that will be scanned if the entire dynamic table is scanned in a query.
+	Bytes *float64 `json:"bytes,omitempty" tf:"bytes,omitempty"`
+
+	// (String) The clustering key for the dynamic table.
+	// The clustering key for the dynamic table.
+	ClusterBy *string `json:"clusterBy,omitempty" tf:"cluster_by,omitempty"`
+
+	// (String) Specifies a comment for the dynamic table.
+	// Specifies a comment for the dynamic table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) Time when this dynamic table was created.
+	// Time when this dynamic table was created.
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (String) Timestamp of the data in the base object(s) that is included in the dynamic table.
+	// Timestamp of the data in the base object(s) that is included in the dynamic table.
+	DataTimestamp *string `json:"dataTimestamp,omitempty" tf:"data_timestamp,omitempty"`
+
+	// (String) The database in which to create the dynamic table.
+	// The database in which to create the dynamic table.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String) (Default: ON_CREATE) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	// (Default: `ON_CREATE`) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	Initialize *string `json:"initialize,omitempty" tf:"initialize,omitempty"`
+
+	// (Boolean) TRUE if the dynamic table has been cloned, else FALSE.
+	// TRUE if the dynamic table has been cloned, else FALSE.
+	IsClone *bool `json:"isClone,omitempty" tf:"is_clone,omitempty"`
+
+	// (Boolean) TRUE if the dynamic table is a replica. else FALSE.
+	// TRUE if the dynamic table is a replica. else FALSE.
+	IsReplica *bool `json:"isReplica,omitempty" tf:"is_replica,omitempty"`
+
+	// Time the latest refresh completed.
+	LastRefreshEndTime *string `json:"lastRefreshEndTime,omitempty" tf:"last_refresh_end_time,omitempty"`
+
+	// State of the latest refresh that was not just scheduled: EXECUTING, SUCCEEDED, FAILED, CANCELLED or UPSTREAM_FAILED.
+	LastRefreshState *string `json:"lastRefreshState,omitempty" tf:"last_refresh_state,omitempty"`
+
+	// Description of the state of the latest refresh, such as the error of a failed refresh.
+	LastRefreshStateMessage *string `json:"lastRefreshStateMessage,omitempty" tf:"last_refresh_state_message,omitempty"`
+
+	// (String) Timestamp of last suspension.
+	// Timestamp of last suspension.
+	LastSuspendedOn *string `json:"lastSuspendedOn,omitempty" tf:"last_suspended_on,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	// Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Specifies whether to replace the dynamic table if it already exists.
+	// (Default: `false`) Specifies whether to replace the dynamic table if it already exists.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) Role that owns the dynamic table.
+	// Role that owns the dynamic table.
+	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
+
+	// (String) Specifies the query to use to populate the dynamic table.
+	// Specifies the query to use to populate the dynamic table.
+	Query *string `json:"query,omitempty" tf:"query,omitempty"`
+
+	// (String) (Default: AUTO) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	// (Default: `AUTO`) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	RefreshMode *string `json:"refreshMode,omitempty" tf:"refresh_mode,omitempty"`
+
+	// (String) Explanation for why FULL refresh mode was chosen. NULL if refresh mode is not FULL.
+	// Explanation for why FULL refresh mode was chosen. NULL if refresh mode is not FULL.
+	RefreshModeReason *string `json:"refreshModeReason,omitempty" tf:"refresh_mode_reason,omitempty"`
+
+	// (Number) Number of rows in the table.
+	// Number of rows in the table.
+	Rows *float64 `json:"rows,omitempty" tf:"rows,omitempty"`
+
+	// (String) Displays ACTIVE for dynamic tables that are actively scheduling refreshes and SUSPENDED for suspended dynamic tables.
+	// Displays ACTIVE for dynamic tables that are actively scheduling refreshes and SUSPENDED for suspended dynamic tables.
+	SchedulingState *string `json:"schedulingState,omitempty" tf:"scheduling_state,omitempty"`
+
+	// (String) The schema in which to create the dynamic table.
+	// The schema in which to create the dynamic table.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (Block List, Min: 1, Max: 1) Specifies the target lag time for the dynamic table. (see below for nested schema)
+	// Specifies the target lag time for the dynamic table.
+	TargetLag []TargetLagObservation `json:"targetLag,omitempty" tf:"target_lag,omitempty"`
+
+	// (String) The warehouse in which to create the dynamic table.
+	// The warehouse in which to create the dynamic table.
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+}
+
+type DynamicTableParameters struct {
+
+	// (String) Specifies a comment for the dynamic table.
+	// Specifies a comment for the dynamic table.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the dynamic table.
+	// The database in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) (Default: ON_CREATE) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	// (Default: `ON_CREATE`) Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.
+	// +kubebuilder:validation:Optional
+	Initialize *string `json:"initialize,omitempty" tf:"initialize,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	// Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Specifies whether to replace the dynamic table if it already exists.
+	// (Default: `false`) Specifies whether to replace the dynamic table if it already exists.
+	// +kubebuilder:validation:Optional
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) Specifies the query to use to populate the dynamic table.
+	// Specifies the query to use to populate the dynamic table.
+	// +kubebuilder:validation:Optional
+	Query *string `json:"query,omitempty" tf:"query,omitempty"`
+
+	// (String) (Default: AUTO) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	// (Default: `AUTO`) INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.
+	// +kubebuilder:validation:Optional
+	RefreshMode *string `json:"refreshMode,omitempty" tf:"refresh_mode,omitempty"`
+
+	// (String) The schema in which to create the dynamic table.
+	// The schema in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (Block List, Min: 1, Max: 1) Specifies the target lag time for the dynamic table. (see below for nested schema)
+	// Specifies the target lag time for the dynamic table.
+	// +kubebuilder:validation:Optional
+	TargetLag []TargetLagParameters `json:"targetLag,omitempty" tf:"target_lag,omitempty"`
+
+	// (String) The warehouse in which to create the dynamic table.
+	// The warehouse in which to create the dynamic table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/account/v1alpha1.Warehouse
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+
+	// Reference to a Warehouse in account to populate warehouse.
+	// +kubebuilder:validation:Optional
+	WarehouseRef *v1.Reference `json:"warehouseRef,omitempty" tf:"-"`
+
+	// Selector for a Warehouse in account to populate warehouse.
+	// +kubebuilder:validation:Optional
+	WarehouseSelector *v1.Selector `json:"warehouseSelector,omitempty" tf:"-"`
+}
+
+type TargetLagInitParameters struct {
+
+	// (Boolean) Specifies whether the target lag time is downstream.
+	// Specifies whether the target lag time is downstream.
+	Downstream *bool `json:"downstream,omitempty" tf:"downstream,omitempty"`
+
+	// (String) Specifies the maximum target lag time for the dynamic table.
+	// Specifies the maximum target lag time for the dynamic table.
+	MaximumDuration *string `json:"maximumDuration,omitempty" tf:"maximum_duration,omitempty"`
+}
+
+type TargetLagObservation struct {
+
+	// (Boolean) Specifies whether the target lag time is downstream.
+	// Specifies whether the target lag time is downstream.
+	Downstream *bool `json:"downstream,omitempty" tf:"downstream,omitempty"`
+
+	// (String) Specifies the maximum target lag time for the dynamic table.
+	// Specifies the maximum target lag time for the dynamic table.
+	MaximumDuration *string `json:"maximumDuration,omitempty" tf:"maximum_duration,omitempty"`
+}
+
+type TargetLagParameters struct {
+
+	// (Boolean) Specifies whether the target lag time is downstream.
+	// Specifies whether the target lag time is downstream.
+	// +kubebuilder:validation:Optional
+	Downstream *bool `json:"downstream,omitempty" tf:"downstream,omitempty"`
+
+	// (String) Specifies the maximum target lag time for the dynamic table.
+	// Specifies the maximum target lag time for the dynamic table.
+	// +kubebuilder:validation:Optional
+	MaximumDuration *string `json:"maximumDuration,omitempty" tf:"maximum_duration,omitempty"`
+}
+
+// DynamicTableSpec defines the desired state of DynamicTable
+type DynamicTableSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DynamicTableParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DynamicTableInitParameters `json:"initProvider,omitempty"`
+}
+
+// DynamicTableStatus defines the observed state of DynamicTable.
+type DynamicTableStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DynamicTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DynamicTable is the Schema for the DynamicTables API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type DynamicTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.query) || (has(self.initProvider) && has(self.initProvider.query))",message="spec.forProvider.query is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.targetLag) || (has(self.initProvider) && has(self.initProvider.targetLag))",message="spec.forProvider.targetLag is a required parameter"
+	Spec   DynamicTableSpec   `json:"spec"`
+	Status DynamicTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DynamicTableList contains a list of DynamicTables
+type DynamicTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamicTable `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DynamicTable_Kind             = "DynamicTable"
+	DynamicTable_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DynamicTable_Kind}.String()
+	DynamicTable_KindAPIVersion   = DynamicTable_Kind + "." + CRDGroupVersion.String()
+	DynamicTable_GroupVersionKind = CRDGroupVersion.WithKind(DynamicTable_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DynamicTable{}, &DynamicTableList{})
+}
@@ -0,0 +1,450 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type ArgInitParameters struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Argument name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Argument type, e.g. VARCHAR
+	// Argument type, e.g. VARCHAR
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ArgObservation struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Argument name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Argument type, e.g. VARCHAR
+	// Argument type, e.g. VARCHAR
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ArgParameters struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Argument name
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) Argument type, e.g. VARCHAR
+	// Argument type, e.g. VARCHAR
+	// +kubebuilder:validation:Optional
+	Type *string `json:"type" tf:"type,omitempty"`
+}
+
+type ExternalFunctionInitParameters struct {
+
+	// (String) The name of the API integration object that should be used to authenticate the call to the proxy service.
+	// The name of the API integration object that should be used to authenticate the call to the proxy service.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.ApiIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	APIIntegration *string `json:"apiIntegration,omitempty" tf:"api_integration,omitempty"`
+
+	// Reference to a ApiIntegration in integration to populate apiIntegration.
+	// +kubebuilder:validation:Optional
+	APIIntegrationRef *v1.Reference `json:"apiIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a ApiIntegration in integration to populate apiIntegration.
+	// +kubebuilder:validation:Optional
+	APIIntegrationSelector *v1.Selector `json:"apiIntegrationSelector,omitempty" tf:"-"`
+
+	// (Block List) Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects. (see below for nested schema)
+	// Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects.
+	Arg []ArgInitParameters `json:"arg,omitempty" tf:"arg,omitempty"`
+
+	// defined function) A description of the external function.
+	// (Default: `user-defined function`) A description of the external function.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) (Default: AUTO) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	// (Default: `AUTO`) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	Compression *string `json:"compression,omitempty" tf:"compression,omitempty"`
+
+	// (List of String) Binds Snowflake context function results to HTTP headers.
+	// Binds Snowflake context function results to HTTP headers.
+	ContextHeaders []*string `json:"contextHeaders,omitempty" tf:"context_headers,omitempty"`
+
+	// (String) The database in which to create the external function.
+	// The database in which to create the external function.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// value metadata that is sent with every request as HTTP headers. (see below for nested schema)
+	// Allows users to specify key-value metadata that is sent with every request as HTTP headers.
+	Header []HeaderInitParameters `json:"header,omitempty" tf:"header,omitempty"`
+
+	// (Number) This specifies the maximum number of rows in each batch sent to the proxy service.
+	// This specifies the maximum number of rows in each batch sent to the proxy service.
+	MaxBatchRows *float64 `json:"maxBatchRows,omitempty" tf:"max_batch_rows,omitempty"`
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) (Default: CALLED ON NULL INPUT) Specifies the behavior of the external function when called with null inputs.
+	// (Default: `CALLED ON NULL INPUT`) Specifies the behavior of the external function when called with null inputs.
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// (String) This specifies the name of the request translator function
+	// This specifies the name of the request translator function
+	RequestTranslator *string `json:"requestTranslator,omitempty" tf:"request_translator,omitempty"`
+
+	// (String) This specifies the name of the response translator function.
+	// This specifies the name of the response translator function.
+	ResponseTranslator *string `json:"responseTranslator,omitempty" tf:"response_translator,omitempty"`
+
+	// (String) Specifies the behavior of the function when returning results
+	// Specifies the behavior of the function when returning results
+	ReturnBehavior *string `json:"returnBehavior,omitempty" tf:"return_behavior,omitempty"`
+
+	// NULL values (false).
+	// (Default: `true`) Indicates whether the function can return NULL values (true) or must return only NON-NULL values (false).
+	ReturnNullAllowed *bool `json:"returnNullAllowed,omitempty" tf:"return_null_allowed,omitempty"`
+
+	// (String) Specifies the data type returned by the external function.
+	// Specifies the data type returned by the external function.
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the external function.
+	// The schema in which to create the external function.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	// This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	URLOfProxyAndResource *string `json:"urlOfProxyAndResource,omitempty" tf:"url_of_proxy_and_resource,omitempty"`
+}
+
+type ExternalFunctionObservation struct {
+
+	// (String) The name of the API integration object that should be used to authenticate the call to the proxy service.
+	// The name of the API integration object that should be used to authenticate the call to the proxy service.
+	APIIntegration *string `json:"apiIntegration,omitempty" tf:"api_integration,omitempty"`
+
+	// (Block List) Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects. (see below for nested schema)
+	// Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects.
+	Arg []ArgObservation `json:"arg,omitempty" tf:"arg,omitempty"`
+
+	// defined function) A description of the external function.
+	// (Default: `user-defined function`) A description of the external function.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) (Default: AUTO) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	// (Default: `AUTO`) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	Compression *string `json:"compression,omitempty" tf:"compression,omitempty"`
+
+	// (List of String) Binds Snowflake context function results to HTTP headers.
+	// Binds Snowflake context function results to HTTP headers.
+	ContextHeaders []*string `json:"contextHeaders,omitempty" tf:"context_headers,omitempty"`
+
+	// (String) Date and time when the external function was created.
+	// Date and time when the external function was created.
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (String) The database in which to create the external function.
+	// The database in which to create the external function.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// value metadata that is sent with every request as HTTP headers. (see below for nested schema)
+	// Allows users to specify key-value metadata that is sent with every request as HTTP headers.
+	Header []HeaderObservation `json:"header,omitempty" tf:"header,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Number) This specifies the maximum number of rows in each batch sent to the proxy service.
+	// This specifies the maximum number of rows in each batch sent to the proxy service.
+	MaxBatchRows *float64 `json:"maxBatchRows,omitempty" tf:"max_batch_rows,omitempty"`
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) (Default: CALLED ON NULL INPUT) Specifies the behavior of the external function when called with null inputs.
+	// (Default: `CALLED ON NULL INPUT`) Specifies the behavior of the external function when called with null inputs.
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// (String) This specifies the name of the request translator function
+	// This specifies the name of the request translator function
+	RequestTranslator *string `json:"requestTranslator,omitempty" tf:"request_translator,omitempty"`
+
+	// (String) This specifies the name of the response translator function.
+	// This specifies the name of the response translator function.
+	ResponseTranslator *string `json:"responseTranslator,omitempty" tf:"response_translator,omitempty"`
+
+	// (String) Specifies the behavior of the function when returning results
+	// Specifies the behavior of the function when returning results
+	ReturnBehavior *string `json:"returnBehavior,omitempty" tf:"return_behavior,omitempty"`
+
+	// NULL values (false).
+	// (Default: `true`) Indicates whether the function can return NULL values (true) or must return only NON-NULL values (false).
+	ReturnNullAllowed *bool `json:"returnNullAllowed,omitempty" tf:"return_null_allowed,omitempty"`
+
+	// (String) Specifies the data type returned by the external function.
+	// Specifies the data type returned by the external function.
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the external function.
+	// The schema in which to create the external function.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	// This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	URLOfProxyAndResource *string `json:"urlOfProxyAndResource,omitempty" tf:"url_of_proxy_and_resource,omitempty"`
+}
+
+type ExternalFunctionParameters struct {
+
+	// (String) The name of the API integration object that should be used to authenticate the call to the proxy service.
+	// The name of the API integration object that should be used to authenticate the call to the proxy service.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.ApiIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	APIIntegration *string `json:"apiIntegration,omitempty" tf:"api_integration,omitempty"`
+
+	// Reference to a ApiIntegration in integration to populate apiIntegration.
+	// +kubebuilder:validation:Optional
+	APIIntegrationRef *v1.Reference `json:"apiIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a ApiIntegration in integration to populate apiIntegration.
+	// +kubebuilder:validation:Optional
+	APIIntegrationSelector *v1.Selector `json:"apiIntegrationSelector,omitempty" tf:"-"`
+
+	// (Block List) Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects. (see below for nested schema)
+	// Specifies the arguments/inputs for the external function. These should correspond to the arguments that the remote service expects.
+	// +kubebuilder:validation:Optional
+	Arg []ArgParameters `json:"arg,omitempty" tf:"arg,omitempty"`
+
+	// defined function) A description of the external function.
+	// (Default: `user-defined function`) A description of the external function.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) (Default: AUTO) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	// (Default: `AUTO`) If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.
+	// +kubebuilder:validation:Optional
+	Compression *string `json:"compression,omitempty" tf:"compression,omitempty"`
+
+	// (List of String) Binds Snowflake context function results to HTTP headers.
+	// Binds Snowflake context function results to HTTP headers.
+	// +kubebuilder:validation:Optional
+	ContextHeaders []*string `json:"contextHeaders,omitempty" tf:"context_headers,omitempty"`
+
+	// (String) The database in which to create the external function.
+	// The database in which to create the external function.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// value metadata that is sent with every request as HTTP headers. (see below for nested schema)
+	// Allows users to specify key-value metadata that is sent with every request as HTTP headers.
+	// +kubebuilder:validation:Optional
+	Header []HeaderParameters `json:"header,omitempty" tf:"header,omitempty"`
+
+	// (Number) This specifies the maximum number of rows in each batch sent to the proxy service.
+	// This specifies the maximum number of rows in each batch sent to the proxy service.
+	// +kubebuilder:validation:Optional
+	MaxBatchRows *float64 `json:"maxBatchRows,omitempty" tf:"max_batch_rows,omitempty"`
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) (Default: CALLED ON NULL INPUT) Specifies the behavior of the external function when called with null inputs.
+	// (Default: `CALLED ON NULL INPUT`) Specifies the behavior of the external function when called with null inputs.
+	// +kubebuilder:validation:Optional
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// (String) This specifies the name of the request translator function
+	// This specifies the name of the request translator function
+	// +kubebuilder:validation:Optional
+	RequestTranslator *string `json:"requestTranslator,omitempty" tf:"request_translator,omitempty"`
+
+	// (String) This specifies the name of the response translator function.
+	// This specifies the name of the response translator function.
+	// +kubebuilder:validation:Optional
+	ResponseTranslator *string `json:"responseTranslator,omitempty" tf:"response_translator,omitempty"`
+
+	// (String) Specifies the behavior of the function when returning results
+	// Specifies the behavior of the function when returning results
+	// +kubebuilder:validation:Optional
+	ReturnBehavior *string `json:"returnBehavior,omitempty" tf:"return_behavior,omitempty"`
+
+	// NULL values (false).
+	// (Default: `true`) Indicates whether the function can return NULL values (true) or must return only NON-NULL values (false).
+	// +kubebuilder:validation:Optional
+	ReturnNullAllowed *bool `json:"returnNullAllowed,omitempty" tf:"return_null_allowed,omitempty"`
+
+	// (String) Specifies the data type returned by the external function.
+	// Specifies the data type returned by the external function.
+	// +kubebuilder:validation:Optional
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the external function.
+	// The schema in which to create the external function.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	// This is the invocation URL of the proxy service and resource through which Snowflake calls the remote service.
+	// +kubebuilder:validation:Optional
+	URLOfProxyAndResource *string `json:"urlOfProxyAndResource,omitempty" tf:"url_of_proxy_and_resource,omitempty"`
+}
+
+type HeaderInitParameters struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Header name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Header value
+	// Header value
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type HeaderObservation struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Header name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Header value
+	// Header value
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type HeaderParameters struct {
+
+	// (String) Specifies the identifier for the external function. The identifier can contain the schema name and database name, as well as the function name. The function's signature (name and argument data types) must be unique within the schema.
+	// Header name
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) Header value
+	// Header value
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value" tf:"value,omitempty"`
+}
+
+// ExternalFunctionSpec defines the desired state of ExternalFunction
+type ExternalFunctionSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ExternalFunctionParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider ExternalFunctionInitParameters `json:"initProvider,omitempty"`
+}
+
+// ExternalFunctionStatus defines the observed state of ExternalFunction.
+type ExternalFunctionStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ExternalFunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ExternalFunction is the Schema for the ExternalFunctions API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalFunction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.returnBehavior) || (has(self.initProvider) && has(self.initProvider.returnBehavior))",message="spec.forProvider.returnBehavior is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.returnType) || (has(self.initProvider) && has(self.initProvider.returnType))",message="spec.forProvider.returnType is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.urlOfProxyAndResource) || (has(self.initProvider) && has(self.initProvider.urlOfProxyAndResource))",message="spec.forProvider.urlOfProxyAndResource is a required parameter"
+	Spec   ExternalFunctionSpec   `json:"spec"`
+	Status ExternalFunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalFunctionList contains a list of ExternalFunctions
+type ExternalFunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalFunction `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ExternalFunction_Kind             = "ExternalFunction"
+	ExternalFunction_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ExternalFunction_Kind}.String()
+	ExternalFunction_KindAPIVersion   = ExternalFunction_Kind + "." + CRDGroupVersion.String()
+	ExternalFunction_GroupVersionKind = CRDGroupVersion.WithKind(ExternalFunction_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalFunction{}, &ExternalFunctionList{})
+}
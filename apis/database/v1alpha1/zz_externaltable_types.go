@@ -0,0 +1,541 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type ExternalTableColumnInitParameters struct {
+
+	// (String) String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	// String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	As *string `json:"as,omitempty" tf:"as,omitempty"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Column name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Column type, e.g. VARIANT
+	// Column type, e.g. VARIANT
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ExternalTableColumnObservation struct {
+
+	// (String) String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	// String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	As *string `json:"as,omitempty" tf:"as,omitempty"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Column name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Column type, e.g. VARIANT
+	// Column type, e.g. VARIANT
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ExternalTableColumnParameters struct {
+
+	// (String) String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	// String that specifies the expression for the column. When queried, the column returns results derived from this expression.
+	// +kubebuilder:validation:Optional
+	As *string `json:"as" tf:"as,omitempty"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Column name
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (String) Column type, e.g. VARIANT
+	// Column type, e.g. VARIANT
+	// +kubebuilder:validation:Optional
+	Type *string `json:"type" tf:"type,omitempty"`
+}
+
+type ExternalTableInitParameters struct {
+
+	// (Boolean) (Default: true) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	// (Default: `true`) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	AutoRefresh *bool `json:"autoRefresh,omitempty" tf:"auto_refresh,omitempty"`
+
+	// (String) Specifies the aws sns topic for the external table.
+	// Specifies the aws sns topic for the external table.
+	AwsSnsTopic *string `json:"awsSnsTopic,omitempty" tf:"aws_sns_topic,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the external table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the external table. Minimum one required.
+	Column []ExternalTableColumnInitParameters `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the external table.
+	// Specifies a comment for the external table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	// (Default: `false`) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the external table.
+	// The database in which to create the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the file format for the external table.
+	// Specifies the file format for the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.FileFormat
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/config/common.FileFormatName()
+	FileFormat *string `json:"fileFormat,omitempty" tf:"file_format,omitempty"`
+
+	// Reference to a FileFormat in database to populate fileFormat.
+	// +kubebuilder:validation:Optional
+	FileFormatRef *v1.Reference `json:"fileFormatRef,omitempty" tf:"-"`
+
+	// Selector for a FileFormat in database to populate fileFormat.
+	// +kubebuilder:validation:Optional
+	FileFormatSelector *v1.Selector `json:"fileFormatSelector,omitempty" tf:"-"`
+
+	// (String) Specifies a location for the external table, using its FQDN. You can hardcode it ("@MYDB.MYSCHEMA.MYSTAGE"), or populate dynamically ("@${snowflake_stage.mystage.fully_qualified_name}")
+	// Specifies a location for the external table, using its FQDN. You can hardcode it (`"@MYDB.MYSCHEMA.MYSTAGE"`), or populate dynamically (`"@${snowflake_stage.mystage.fully_qualified_name}"`)
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Stage
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/config/common.StageLocation()
+	Location *string `json:"location,omitempty" tf:"location,omitempty"`
+
+	// Reference to a Stage in database to populate location.
+	// +kubebuilder:validation:Optional
+	LocationRef *v1.Reference `json:"locationRef,omitempty" tf:"-"`
+
+	// Selector for a Stage in database to populate location.
+	// +kubebuilder:validation:Optional
+	LocationSelector *v1.Selector `json:"locationSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (List of String) Specifies any partition columns to evaluate for the external table.
+	// Specifies any partition columns to evaluate for the external table.
+	PartitionBy []*string `json:"partitionBy,omitempty" tf:"partition_by,omitempty"`
+
+	// (String) Specifies the file names and/or paths on the external stage to match.
+	// Specifies the file names and/or paths on the external stage to match.
+	Pattern *string `json:"pattern,omitempty" tf:"pattern,omitempty"`
+
+	// (Boolean) (Default: true) Specifies weather to refresh when an external table is created.
+	// (Default: `true`) Specifies weather to refresh when an external table is created.
+	RefreshOnCreate *bool `json:"refreshOnCreate,omitempty" tf:"refresh_on_create,omitempty"`
+
+	// (String) The schema in which to create the external table.
+	// The schema in which to create the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	// Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	TableFormat *string `json:"tableFormat,omitempty" tf:"table_format,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []ExternalTableTagInitParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type ExternalTableObservation struct {
+
+	// (Boolean) (Default: true) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	// (Default: `true`) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	AutoRefresh *bool `json:"autoRefresh,omitempty" tf:"auto_refresh,omitempty"`
+
+	// (String) Specifies the aws sns topic for the external table.
+	// Specifies the aws sns topic for the external table.
+	AwsSnsTopic *string `json:"awsSnsTopic,omitempty" tf:"aws_sns_topic,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the external table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the external table. Minimum one required.
+	Column []ExternalTableColumnObservation `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the external table.
+	// Specifies a comment for the external table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	// (Default: `false`) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the external table.
+	// The database in which to create the external table.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Specifies the file format for the external table.
+	// Specifies the file format for the external table.
+	FileFormat *string `json:"fileFormat,omitempty" tf:"file_format,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String) Specifies a location for the external table, using its FQDN. You can hardcode it ("@MYDB.MYSCHEMA.MYSTAGE"), or populate dynamically ("@${snowflake_stage.mystage.fully_qualified_name}")
+	// Specifies a location for the external table, using its FQDN. You can hardcode it (`"@MYDB.MYSCHEMA.MYSTAGE"`), or populate dynamically (`"@${snowflake_stage.mystage.fully_qualified_name}"`)
+	Location *string `json:"location,omitempty" tf:"location,omitempty"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Name of the role that owns the external table.
+	// Name of the role that owns the external table.
+	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
+
+	// (List of String) Specifies any partition columns to evaluate for the external table.
+	// Specifies any partition columns to evaluate for the external table.
+	PartitionBy []*string `json:"partitionBy,omitempty" tf:"partition_by,omitempty"`
+
+	// (String) Specifies the file names and/or paths on the external stage to match.
+	// Specifies the file names and/or paths on the external stage to match.
+	Pattern *string `json:"pattern,omitempty" tf:"pattern,omitempty"`
+
+	// (Boolean) (Default: true) Specifies weather to refresh when an external table is created.
+	// (Default: `true`) Specifies weather to refresh when an external table is created.
+	RefreshOnCreate *bool `json:"refreshOnCreate,omitempty" tf:"refresh_on_create,omitempty"`
+
+	// (String) The schema in which to create the external table.
+	// The schema in which to create the external table.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	// Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	TableFormat *string `json:"tableFormat,omitempty" tf:"table_format,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []ExternalTableTagObservation `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type ExternalTableParameters struct {
+
+	// (Boolean) (Default: true) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	// (Default: `true`) Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.
+	// +kubebuilder:validation:Optional
+	AutoRefresh *bool `json:"autoRefresh,omitempty" tf:"auto_refresh,omitempty"`
+
+	// (String) Specifies the aws sns topic for the external table.
+	// Specifies the aws sns topic for the external table.
+	// +kubebuilder:validation:Optional
+	AwsSnsTopic *string `json:"awsSnsTopic,omitempty" tf:"aws_sns_topic,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the external table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the external table. Minimum one required.
+	// +kubebuilder:validation:Optional
+	Column []ExternalTableColumnParameters `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the external table.
+	// Specifies a comment for the external table.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	// (Default: `false`) Specifies to retain the access permissions from the original table when an external table is recreated using the CREATE OR REPLACE TABLE variant
+	// +kubebuilder:validation:Optional
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the external table.
+	// The database in which to create the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the file format for the external table.
+	// Specifies the file format for the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.FileFormat
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/config/common.FileFormatName()
+	// +kubebuilder:validation:Optional
+	FileFormat *string `json:"fileFormat,omitempty" tf:"file_format,omitempty"`
+
+	// Reference to a FileFormat in database to populate fileFormat.
+	// +kubebuilder:validation:Optional
+	FileFormatRef *v1.Reference `json:"fileFormatRef,omitempty" tf:"-"`
+
+	// Selector for a FileFormat in database to populate fileFormat.
+	// +kubebuilder:validation:Optional
+	FileFormatSelector *v1.Selector `json:"fileFormatSelector,omitempty" tf:"-"`
+
+	// (String) Specifies a location for the external table, using its FQDN. You can hardcode it ("@MYDB.MYSCHEMA.MYSTAGE"), or populate dynamically ("@${snowflake_stage.mystage.fully_qualified_name}")
+	// Specifies a location for the external table, using its FQDN. You can hardcode it (`"@MYDB.MYSCHEMA.MYSTAGE"`), or populate dynamically (`"@${snowflake_stage.mystage.fully_qualified_name}"`)
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Stage
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/config/common.StageLocation()
+	// +kubebuilder:validation:Optional
+	Location *string `json:"location,omitempty" tf:"location,omitempty"`
+
+	// Reference to a Stage in database to populate location.
+	// +kubebuilder:validation:Optional
+	LocationRef *v1.Reference `json:"locationRef,omitempty" tf:"-"`
+
+	// Selector for a Stage in database to populate location.
+	// +kubebuilder:validation:Optional
+	LocationSelector *v1.Selector `json:"locationSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (List of String) Specifies any partition columns to evaluate for the external table.
+	// Specifies any partition columns to evaluate for the external table.
+	// +kubebuilder:validation:Optional
+	PartitionBy []*string `json:"partitionBy,omitempty" tf:"partition_by,omitempty"`
+
+	// (String) Specifies the file names and/or paths on the external stage to match.
+	// Specifies the file names and/or paths on the external stage to match.
+	// +kubebuilder:validation:Optional
+	Pattern *string `json:"pattern,omitempty" tf:"pattern,omitempty"`
+
+	// (Boolean) (Default: true) Specifies weather to refresh when an external table is created.
+	// (Default: `true`) Specifies weather to refresh when an external table is created.
+	// +kubebuilder:validation:Optional
+	RefreshOnCreate *bool `json:"refreshOnCreate,omitempty" tf:"refresh_on_create,omitempty"`
+
+	// (String) The schema in which to create the external table.
+	// The schema in which to create the external table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	// Identifies the external table table type. For now, only "delta" for Delta Lake table format is supported.
+	// +kubebuilder:validation:Optional
+	TableFormat *string `json:"tableFormat,omitempty" tf:"table_format,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	// +kubebuilder:validation:Optional
+	Tag []ExternalTableTagParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type ExternalTableTagInitParameters struct {
+
+	// (String) The database in which to create the external table.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the external table.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type ExternalTableTagObservation struct {
+
+	// (String) The database in which to create the external table.
+	// Name of the database that the tag was created in.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Tag name, e.g. department.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the external table.
+	// Name of the schema that the tag was created in.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type ExternalTableTagParameters struct {
+
+	// (String) The database in which to create the external table.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the external table; must be unique for the database and schema in which the externalTable is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the external table.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value" tf:"value,omitempty"`
+}
+
+// ExternalTableSpec defines the desired state of ExternalTable
+type ExternalTableSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ExternalTableParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider ExternalTableInitParameters `json:"initProvider,omitempty"`
+}
+
+// ExternalTableStatus defines the observed state of ExternalTable.
+type ExternalTableStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ExternalTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ExternalTable is the Schema for the ExternalTables API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.column) || (has(self.initProvider) && has(self.initProvider.column))",message="spec.forProvider.column is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	Spec   ExternalTableSpec   `json:"spec"`
+	Status ExternalTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalTableList contains a list of ExternalTables
+type ExternalTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalTable `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ExternalTable_Kind             = "ExternalTable"
+	ExternalTable_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ExternalTable_Kind}.String()
+	ExternalTable_KindAPIVersion   = ExternalTable_Kind + "." + CRDGroupVersion.String()
+	ExternalTable_GroupVersionKind = CRDGroupVersion.WithKind(ExternalTable_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalTable{}, &ExternalTableList{})
+}
@@ -0,0 +1,613 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type FunctionJavaScriptArgumentsInitParameters struct {
+
+	// (String) The argument type.
+	// The argument type.
+	ArgDataType *string `json:"argDataType,omitempty" tf:"arg_data_type,omitempty"`
+
+	// Optional default value for the argument. For text values use single quotes. Numeric values can be unquoted. External changes for this field won't be detected.
+	ArgDefaultValue *string `json:"argDefaultValue,omitempty" tf:"arg_default_value,omitempty"`
+
+	// (String) The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	// The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	ArgName *string `json:"argName,omitempty" tf:"arg_name,omitempty"`
+}
+
+type FunctionJavaScriptArgumentsObservation struct {
+
+	// (String) The argument type.
+	// The argument type.
+	ArgDataType *string `json:"argDataType,omitempty" tf:"arg_data_type,omitempty"`
+
+	// Optional default value for the argument. For text values use single quotes. Numeric values can be unquoted. External changes for this field won't be detected.
+	ArgDefaultValue *string `json:"argDefaultValue,omitempty" tf:"arg_default_value,omitempty"`
+
+	// (String) The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	// The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	ArgName *string `json:"argName,omitempty" tf:"arg_name,omitempty"`
+}
+
+type FunctionJavaScriptArgumentsParameters struct {
+
+	// (String) The argument type.
+	// The argument type.
+	// +kubebuilder:validation:Optional
+	ArgDataType *string `json:"argDataType" tf:"arg_data_type,omitempty"`
+
+	// Optional default value for the argument. For text values use single quotes. Numeric values can be unquoted. External changes for this field won't be detected.
+	// +kubebuilder:validation:Optional
+	ArgDefaultValue *string `json:"argDefaultValue,omitempty" tf:"arg_default_value,omitempty"`
+
+	// (String) The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	// The argument name. The provider wraps it in double quotes by default, so be aware of that while referencing the argument in the function definition.
+	// +kubebuilder:validation:Optional
+	ArgName *string `json:"argName" tf:"arg_name,omitempty"`
+}
+
+type FunctionJavaScriptFunctionDefinitionConfigMapRefInitParameters struct {
+
+	// (String)
+	// Key of the definition in the ConfigMap.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Name of the ConfigMap.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Namespace of the ConfigMap.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type FunctionJavaScriptFunctionDefinitionConfigMapRefObservation struct {
+
+	// (String)
+	// Key of the definition in the ConfigMap.
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Name of the ConfigMap.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Namespace of the ConfigMap.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type FunctionJavaScriptFunctionDefinitionConfigMapRefParameters struct {
+
+	// (String)
+	// Key of the definition in the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Key *string `json:"key" tf:"key,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Name of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// Namespace of the ConfigMap.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
+type FunctionJavaScriptInitParameters struct {
+
+	// (Block List) List of the arguments for the function. Consult the docs for more details. (see below for nested schema)
+	// List of the arguments for the function. Consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages) for more details.
+	Arguments []FunctionJavaScriptArgumentsInitParameters `json:"arguments,omitempty" tf:"arguments,omitempty"`
+
+	// defined function) Specifies a comment for the function.
+	// (Default: `user-defined function`) Specifies a comment for the function.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Boolean) Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check ENABLE_CONSOLE_OUTPUT docs.
+	// Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check [ENABLE_CONSOLE_OUTPUT docs](https://docs.snowflake.com/en/sql-reference/parameters#enable-console-output).
+	EnableConsoleOutput *bool `json:"enableConsoleOutput,omitempty" tf:"enable_console_output,omitempty"`
+
+	// (String) Defines the handler code executed when the UDF is called. Wrapping $$ signs are added by the provider automatically; do not include them. The function_definition value must be JavaScript source code. For more information, see Introduction to JavaScript UDFs. To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	// Defines the handler code executed when the UDF is called. Wrapping `$$` signs are added by the provider automatically; do not include them. The `function_definition` value must be JavaScript source code. For more information, see [Introduction to JavaScript UDFs](https://docs.snowflake.com/en/developer-guide/udf/javascript/udf-javascript-introduction). To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	FunctionDefinition *string `json:"functionDefinition,omitempty" tf:"function_definition,omitempty"`
+
+	// Reference to the ConfigMap key to load the definition from, instead of setting it inline.
+	FunctionDefinitionConfigMapRef *FunctionJavaScriptFunctionDefinitionConfigMapRefInitParameters `json:"functionDefinitionConfigMapRef,omitempty" tf:"function_definition_config_map_ref,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies that the function is secure. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies that the function is secure. By design, the Snowflake's `SHOW FUNCTIONS` command does not provide information about secure functions (consult [function docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#id1) and [Protecting Sensitive Information with Secure UDFs and Stored Procedures](https://docs.snowflake. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	IsSecure *string `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) LOG_LEVEL to use when filtering events For more information, check LOG_LEVEL docs.
+	// LOG_LEVEL to use when filtering events For more information, check [LOG_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#log-level).
+	LogLevel *string `json:"logLevel,omitempty" tf:"log_level,omitempty"`
+
+	// (String) METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check METRIC_LEVEL docs.
+	// METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check [METRIC_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#metric-level).
+	MetricLevel *string `json:"metricLevel,omitempty" tf:"metric_level,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages). Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// insensitive): CALLED ON NULL INPUT | RETURNS NULL ON NULL INPUT.
+	// Specifies the behavior of the function when called with null inputs. Valid values are (case-insensitive): `CALLED ON NULL INPUT` | `RETURNS NULL ON NULL INPUT`.
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// insensitive): VOLATILE | IMMUTABLE.
+	// Specifies the behavior of the function when returning results. Valid values are (case-insensitive): `VOLATILE` | `IMMUTABLE`.
+	ReturnResultsBehavior *string `json:"returnResultsBehavior,omitempty" tf:"return_results_behavior,omitempty"`
+
+	// (String) Specifies the results returned by the UDF, which determines the UDF type. Use <result_data_type> to create a scalar UDF that returns a single value with the specified data type. Use TABLE (col_name col_data_type, ...) to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the docs.
+	// Specifies the results returned by the UDF, which determines the UDF type. Use `<result_data_type>` to create a scalar UDF that returns a single value with the specified data type. Use `TABLE (col_name col_data_type, ...)` to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages).
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Trace level value to use when generating/filtering trace events For more information, check TRACE_LEVEL docs.
+	// Trace level value to use when generating/filtering trace events For more information, check [TRACE_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#trace-level).
+	TraceLevel *string `json:"traceLevel,omitempty" tf:"trace_level,omitempty"`
+}
+
+type FunctionJavaScriptObservation struct {
+
+	// (Block List) List of the arguments for the function. Consult the docs for more details. (see below for nested schema)
+	// List of the arguments for the function. Consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages) for more details.
+	Arguments []FunctionJavaScriptArgumentsObservation `json:"arguments,omitempty" tf:"arguments,omitempty"`
+
+	// defined function) Specifies a comment for the function.
+	// (Default: `user-defined function`) Specifies a comment for the function.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (Boolean) Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check ENABLE_CONSOLE_OUTPUT docs.
+	// Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check [ENABLE_CONSOLE_OUTPUT docs](https://docs.snowflake.com/en/sql-reference/parameters#enable-console-output).
+	EnableConsoleOutput *bool `json:"enableConsoleOutput,omitempty" tf:"enable_console_output,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) Defines the handler code executed when the UDF is called. Wrapping $$ signs are added by the provider automatically; do not include them. The function_definition value must be JavaScript source code. For more information, see Introduction to JavaScript UDFs. To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	// Defines the handler code executed when the UDF is called. Wrapping `$$` signs are added by the provider automatically; do not include them. The `function_definition` value must be JavaScript source code. For more information, see [Introduction to JavaScript UDFs](https://docs.snowflake.com/en/developer-guide/udf/javascript/udf-javascript-introduction). To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	FunctionDefinition *string `json:"functionDefinition,omitempty" tf:"function_definition,omitempty"`
+
+	// Reference to the ConfigMap key to load the definition from, instead of setting it inline.
+	FunctionDefinitionConfigMapRef *FunctionJavaScriptFunctionDefinitionConfigMapRefObservation `json:"functionDefinitionConfigMapRef,omitempty" tf:"function_definition_config_map_ref,omitempty"`
+
+	// (String) Specifies language for the user. Used to detect external changes.
+	// Specifies language for the user. Used to detect external changes.
+	FunctionLanguage *string `json:"functionLanguage,omitempty" tf:"function_language,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies that the function is secure. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies that the function is secure. By design, the Snowflake's `SHOW FUNCTIONS` command does not provide information about secure functions (consult [function docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#id1) and [Protecting Sensitive Information with Secure UDFs and Stored Procedures](https://docs.snowflake. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	IsSecure *string `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) LOG_LEVEL to use when filtering events For more information, check LOG_LEVEL docs.
+	// LOG_LEVEL to use when filtering events For more information, check [LOG_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#log-level).
+	LogLevel *string `json:"logLevel,omitempty" tf:"log_level,omitempty"`
+
+	// (String) METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check METRIC_LEVEL docs.
+	// METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check [METRIC_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#metric-level).
+	MetricLevel *string `json:"metricLevel,omitempty" tf:"metric_level,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages). Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// insensitive): CALLED ON NULL INPUT | RETURNS NULL ON NULL INPUT.
+	// Specifies the behavior of the function when called with null inputs. Valid values are (case-insensitive): `CALLED ON NULL INPUT` | `RETURNS NULL ON NULL INPUT`.
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// (List of Object) Outputs the result of SHOW PARAMETERS IN FUNCTION for the given function. (see below for nested schema)
+	// Outputs the result of `SHOW PARAMETERS IN FUNCTION` for the given function.
+	Parameters []FunctionJavaScriptParametersObservation `json:"parameters,omitempty" tf:"parameters,omitempty"`
+
+	// insensitive): VOLATILE | IMMUTABLE.
+	// Specifies the behavior of the function when returning results. Valid values are (case-insensitive): `VOLATILE` | `IMMUTABLE`.
+	ReturnResultsBehavior *string `json:"returnResultsBehavior,omitempty" tf:"return_results_behavior,omitempty"`
+
+	// (String) Specifies the results returned by the UDF, which determines the UDF type. Use <result_data_type> to create a scalar UDF that returns a single value with the specified data type. Use TABLE (col_name col_data_type, ...) to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the docs.
+	// Specifies the results returned by the UDF, which determines the UDF type. Use `<result_data_type>` to create a scalar UDF that returns a single value with the specified data type. Use `TABLE (col_name col_data_type, ...)` to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages).
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (List of Object) Outputs the result of SHOW FUNCTION for the given function. (see below for nested schema)
+	// Outputs the result of `SHOW FUNCTION` for the given function.
+	ShowOutput []FunctionJavaScriptShowOutputObservation `json:"showOutput,omitempty" tf:"show_output,omitempty"`
+
+	// (String) Trace level value to use when generating/filtering trace events For more information, check TRACE_LEVEL docs.
+	// Trace level value to use when generating/filtering trace events For more information, check [TRACE_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#trace-level).
+	TraceLevel *string `json:"traceLevel,omitempty" tf:"trace_level,omitempty"`
+}
+
+type FunctionJavaScriptParameters struct {
+
+	// (Block List) List of the arguments for the function. Consult the docs for more details. (see below for nested schema)
+	// List of the arguments for the function. Consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages) for more details.
+	// +kubebuilder:validation:Optional
+	Arguments []FunctionJavaScriptArgumentsParameters `json:"arguments,omitempty" tf:"arguments,omitempty"`
+
+	// defined function) Specifies a comment for the function.
+	// (Default: `user-defined function`) Specifies a comment for the function.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Boolean) Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check ENABLE_CONSOLE_OUTPUT docs.
+	// Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check [ENABLE_CONSOLE_OUTPUT docs](https://docs.snowflake.com/en/sql-reference/parameters#enable-console-output).
+	// +kubebuilder:validation:Optional
+	EnableConsoleOutput *bool `json:"enableConsoleOutput,omitempty" tf:"enable_console_output,omitempty"`
+
+	// (String) Defines the handler code executed when the UDF is called. Wrapping $$ signs are added by the provider automatically; do not include them. The function_definition value must be JavaScript source code. For more information, see Introduction to JavaScript UDFs. To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	// Defines the handler code executed when the UDF is called. Wrapping `$$` signs are added by the provider automatically; do not include them. The `function_definition` value must be JavaScript source code. For more information, see [Introduction to JavaScript UDFs](https://docs.snowflake.com/en/developer-guide/udf/javascript/udf-javascript-introduction). To mitigate permadiff on this field, the provider replaces blank characters with a space. This can lead to false positives in cases where a change in case or run of whitespace is semantically significant.
+	// +kubebuilder:validation:Optional
+	FunctionDefinition *string `json:"functionDefinition,omitempty" tf:"function_definition,omitempty"`
+
+	// Reference to the ConfigMap key to load the definition from, instead of setting it inline.
+	// +kubebuilder:validation:Optional
+	FunctionDefinitionConfigMapRef *FunctionJavaScriptFunctionDefinitionConfigMapRefParameters `json:"functionDefinitionConfigMapRef,omitempty" tf:"function_definition_config_map_ref,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies that the function is secure. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies that the function is secure. By design, the Snowflake's `SHOW FUNCTIONS` command does not provide information about secure functions (consult [function docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#id1) and [Protecting Sensitive Information with Secure UDFs and Stored Procedures](https://docs.snowflake. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// +kubebuilder:validation:Optional
+	IsSecure *string `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) LOG_LEVEL to use when filtering events For more information, check LOG_LEVEL docs.
+	// LOG_LEVEL to use when filtering events For more information, check [LOG_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#log-level).
+	// +kubebuilder:validation:Optional
+	LogLevel *string `json:"logLevel,omitempty" tf:"log_level,omitempty"`
+
+	// (String) METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check METRIC_LEVEL docs.
+	// METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check [METRIC_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#metric-level).
+	// +kubebuilder:validation:Optional
+	MetricLevel *string `json:"metricLevel,omitempty" tf:"metric_level,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages). Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// insensitive): CALLED ON NULL INPUT | RETURNS NULL ON NULL INPUT.
+	// Specifies the behavior of the function when called with null inputs. Valid values are (case-insensitive): `CALLED ON NULL INPUT` | `RETURNS NULL ON NULL INPUT`.
+	// +kubebuilder:validation:Optional
+	NullInputBehavior *string `json:"nullInputBehavior,omitempty" tf:"null_input_behavior,omitempty"`
+
+	// insensitive): VOLATILE | IMMUTABLE.
+	// Specifies the behavior of the function when returning results. Valid values are (case-insensitive): `VOLATILE` | `IMMUTABLE`.
+	// +kubebuilder:validation:Optional
+	ReturnResultsBehavior *string `json:"returnResultsBehavior,omitempty" tf:"return_results_behavior,omitempty"`
+
+	// (String) Specifies the results returned by the UDF, which determines the UDF type. Use <result_data_type> to create a scalar UDF that returns a single value with the specified data type. Use TABLE (col_name col_data_type, ...) to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the docs.
+	// Specifies the results returned by the UDF, which determines the UDF type. Use `<result_data_type>` to create a scalar UDF that returns a single value with the specified data type. Use `TABLE (col_name col_data_type, ...)` to creates a table UDF that returns tabular results with the specified table column(s) and column type(s). For the details, consult the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-function#all-languages).
+	// +kubebuilder:validation:Optional
+	ReturnType *string `json:"returnType,omitempty" tf:"return_type,omitempty"`
+
+	// (String) The schema in which to create the function. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the function. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Trace level value to use when generating/filtering trace events For more information, check TRACE_LEVEL docs.
+	// Trace level value to use when generating/filtering trace events For more information, check [TRACE_LEVEL docs](https://docs.snowflake.com/en/sql-reference/parameters#trace-level).
+	// +kubebuilder:validation:Optional
+	TraceLevel *string `json:"traceLevel,omitempty" tf:"trace_level,omitempty"`
+}
+
+type FunctionJavaScriptParametersEnableConsoleOutputInitParameters struct {
+}
+
+type FunctionJavaScriptParametersEnableConsoleOutputObservation struct {
+
+	// (String)
+	Default *string `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (String)
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String)
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String)
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// (String)
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type FunctionJavaScriptParametersEnableConsoleOutputParameters struct {
+}
+
+type FunctionJavaScriptParametersInitParameters struct {
+}
+
+type FunctionJavaScriptParametersLogLevelInitParameters struct {
+}
+
+type FunctionJavaScriptParametersLogLevelObservation struct {
+
+	// (String)
+	Default *string `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (String)
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String)
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String)
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// (String)
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type FunctionJavaScriptParametersLogLevelParameters struct {
+}
+
+type FunctionJavaScriptParametersMetricLevelInitParameters struct {
+}
+
+type FunctionJavaScriptParametersMetricLevelObservation struct {
+
+	// (String)
+	Default *string `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (String)
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String)
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String)
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// (String)
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type FunctionJavaScriptParametersMetricLevelParameters struct {
+}
+
+type FunctionJavaScriptParametersObservation struct {
+
+	// (Boolean) Enable stdout/stderr fast path logging for anonymous stored procs. This is a public parameter (similar to LOG_LEVEL). For more information, check ENABLE_CONSOLE_OUTPUT docs.
+	EnableConsoleOutput []FunctionJavaScriptParametersEnableConsoleOutputObservation `json:"enableConsoleOutput,omitempty" tf:"enable_console_output,omitempty"`
+
+	// (String) LOG_LEVEL to use when filtering events For more information, check LOG_LEVEL docs.
+	LogLevel []FunctionJavaScriptParametersLogLevelObservation `json:"logLevel,omitempty" tf:"log_level,omitempty"`
+
+	// (String) METRIC_LEVEL value to control whether to emit metrics to Event Table For more information, check METRIC_LEVEL docs.
+	MetricLevel []FunctionJavaScriptParametersMetricLevelObservation `json:"metricLevel,omitempty" tf:"metric_level,omitempty"`
+
+	// (String) Trace level value to use when generating/filtering trace events For more information, check TRACE_LEVEL docs.
+	TraceLevel []FunctionJavaScriptParametersTraceLevelObservation `json:"traceLevel,omitempty" tf:"trace_level,omitempty"`
+}
+
+type FunctionJavaScriptParametersParameters struct {
+}
+
+type FunctionJavaScriptParametersTraceLevelInitParameters struct {
+}
+
+type FunctionJavaScriptParametersTraceLevelObservation struct {
+
+	// (String)
+	Default *string `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (String)
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String)
+	Key *string `json:"key,omitempty" tf:"key,omitempty"`
+
+	// (String)
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// (String)
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type FunctionJavaScriptParametersTraceLevelParameters struct {
+}
+
+type FunctionJavaScriptShowOutputInitParameters struct {
+}
+
+type FunctionJavaScriptShowOutputObservation struct {
+
+	// (String)
+	ArgumentsRaw *string `json:"argumentsRaw,omitempty" tf:"arguments_raw,omitempty"`
+
+	// (String)
+	CatalogName *string `json:"catalogName,omitempty" tf:"catalog_name,omitempty"`
+
+	// (String)
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (String)
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String)
+	ExternalAccessIntegrations *string `json:"externalAccessIntegrations,omitempty" tf:"external_access_integrations,omitempty"`
+
+	// (Boolean)
+	IsAggregate *bool `json:"isAggregate,omitempty" tf:"is_aggregate,omitempty"`
+
+	// (Boolean)
+	IsAnsi *bool `json:"isAnsi,omitempty" tf:"is_ansi,omitempty"`
+
+	// (Boolean)
+	IsBuiltin *bool `json:"isBuiltin,omitempty" tf:"is_builtin,omitempty"`
+
+	// (Boolean)
+	IsDataMetric *bool `json:"isDataMetric,omitempty" tf:"is_data_metric,omitempty"`
+
+	// (Boolean)
+	IsExternalFunction *bool `json:"isExternalFunction,omitempty" tf:"is_external_function,omitempty"`
+
+	// (Boolean)
+	IsMemoizable *bool `json:"isMemoizable,omitempty" tf:"is_memoizable,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies that the function is secure. Use the role owning the function while managing secure functions. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	IsSecure *bool `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (Boolean)
+	IsTableFunction *bool `json:"isTableFunction,omitempty" tf:"is_table_function,omitempty"`
+
+	// (String)
+	Language *string `json:"language,omitempty" tf:"language,omitempty"`
+
+	// (Number)
+	MaxNumArguments *float64 `json:"maxNumArguments,omitempty" tf:"max_num_arguments,omitempty"`
+
+	// (Number)
+	MinNumArguments *float64 `json:"minNumArguments,omitempty" tf:"min_num_arguments,omitempty"`
+
+	// (String) The name of the function; the identifier does not need to be unique for the schema in which the function is created because UDFs are identified and resolved by the combination of the name and argument types. Check the docs. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String)
+	SchemaName *string `json:"schemaName,omitempty" tf:"schema_name,omitempty"`
+
+	// (String)
+	Secrets *string `json:"secrets,omitempty" tf:"secrets,omitempty"`
+
+	// (Boolean)
+	ValidForClustering *bool `json:"validForClustering,omitempty" tf:"valid_for_clustering,omitempty"`
+}
+
+type FunctionJavaScriptShowOutputParameters struct {
+}
+
+// FunctionJavaScriptSpec defines the desired state of FunctionJavaScript
+type FunctionJavaScriptSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     FunctionJavaScriptParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider FunctionJavaScriptInitParameters `json:"initProvider,omitempty"`
+}
+
+// FunctionJavaScriptStatus defines the observed state of FunctionJavaScript.
+type FunctionJavaScriptStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        FunctionJavaScriptObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// FunctionJavaScript is the Schema for the FunctionJavaScripts API. Resource used to manage javascript function objects. For more information, check function documentation https://docs.snowflake.com/en/sql-reference/sql/create-function.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type FunctionJavaScript struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.returnType) || (has(self.initProvider) && has(self.initProvider.returnType))",message="spec.forProvider.returnType is a required parameter"
+	Spec   FunctionJavaScriptSpec   `json:"spec"`
+	Status FunctionJavaScriptStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionJavaScriptList contains a list of FunctionJavaScripts
+type FunctionJavaScriptList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FunctionJavaScript `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	FunctionJavaScript_Kind             = "FunctionJavaScript"
+	FunctionJavaScript_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: FunctionJavaScript_Kind}.String()
+	FunctionJavaScript_KindAPIVersion   = FunctionJavaScript_Kind + "." + CRDGroupVersion.String()
+	FunctionJavaScript_GroupVersionKind = CRDGroupVersion.WithKind(FunctionJavaScript_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FunctionJavaScript{}, &FunctionJavaScriptList{})
+}
@@ -6,17 +6,104 @@
 
 package v1alpha1
 
+// Hub marks this type as a conversion hub.
+func (tr *Alert) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *Database) Hub() {}
 
 // Hub marks this type as a conversion hub.
 func (tr *DatabaseRole) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *DynamicTable) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ExternalFunction) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ExternalTable) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *FileFormat) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *FunctionJava) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *FunctionJavaScript) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *FunctionPython) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *FunctionScala) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *FunctionSQL) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *MaskingPolicy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *MaterializedView) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *Pipe) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *ProcedureJava) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ProcedureJavaScript) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ProcedurePython) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ProcedureScala) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *ProcedureSQL) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *RowAccessPolicy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Schema) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Sequence) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *Stage) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *StreamOnDirectoryTable) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *StreamOnExternalTable) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *StreamOnTable) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *StreamOnView) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Table) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *TableColumnMaskingPolicyApplication) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Tag) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *TagAssociation) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *Task) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *View) Hub() {}
@@ -0,0 +1,3508 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	v1alpha11 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	common "github.com/allenkallz/provider-snowflake/config/common"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this Alert.
+func (mg *Alert) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.WarehouseRef,
+		Selector:     mg.Spec.ForProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Warehouse")
+	}
+	mg.Spec.ForProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WarehouseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.WarehouseRef,
+		Selector:     mg.Spec.InitProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Warehouse")
+	}
+	mg.Spec.InitProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.WarehouseRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Database.
+func (mg *Database) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ExternalVolume),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.ExternalVolumeRef,
+		Selector:     mg.Spec.ForProvider.ExternalVolumeSelector,
+		To: reference.To{
+			List:    &v1alpha1.ExternalVolumeList{},
+			Managed: &v1alpha1.ExternalVolume{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ExternalVolume")
+	}
+	mg.Spec.ForProvider.ExternalVolume = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ExternalVolumeRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.ExternalVolume),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.ExternalVolumeRef,
+		Selector:     mg.Spec.InitProvider.ExternalVolumeSelector,
+		To: reference.To{
+			List:    &v1alpha1.ExternalVolumeList{},
+			Managed: &v1alpha1.ExternalVolume{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.ExternalVolume")
+	}
+	mg.Spec.InitProvider.ExternalVolume = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ExternalVolumeRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DynamicTable.
+func (mg *DynamicTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.WarehouseRef,
+		Selector:     mg.Spec.ForProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Warehouse")
+	}
+	mg.Spec.ForProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WarehouseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.WarehouseRef,
+		Selector:     mg.Spec.InitProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Warehouse")
+	}
+	mg.Spec.InitProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.WarehouseRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ExternalFunction.
+func (mg *ExternalFunction) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.APIIntegrationRef,
+		Selector:     mg.Spec.ForProvider.APIIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.ApiIntegrationList{},
+			Managed: &v1alpha11.ApiIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.APIIntegration")
+	}
+	mg.Spec.ForProvider.APIIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.APIIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.APIIntegrationRef,
+		Selector:     mg.Spec.InitProvider.APIIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.ApiIntegrationList{},
+			Managed: &v1alpha11.ApiIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.APIIntegration")
+	}
+	mg.Spec.InitProvider.APIIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.APIIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ExternalTable.
+func (mg *ExternalTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FileFormat),
+		Extract:      common.FileFormatName(),
+		Reference:    mg.Spec.ForProvider.FileFormatRef,
+		Selector:     mg.Spec.ForProvider.FileFormatSelector,
+		To: reference.To{
+			List:    &FileFormatList{},
+			Managed: &FileFormat{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.FileFormat")
+	}
+	mg.Spec.ForProvider.FileFormat = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FileFormatRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Location),
+		Extract:      common.StageLocation(),
+		Reference:    mg.Spec.ForProvider.LocationRef,
+		Selector:     mg.Spec.ForProvider.LocationSelector,
+		To: reference.To{
+			List:    &StageList{},
+			Managed: &Stage{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Location")
+	}
+	mg.Spec.ForProvider.Location = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LocationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Database")
+		}
+		mg.Spec.ForProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Name")
+		}
+		mg.Spec.ForProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Schema")
+		}
+		mg.Spec.ForProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.FileFormat),
+		Extract:      common.FileFormatName(),
+		Reference:    mg.Spec.InitProvider.FileFormatRef,
+		Selector:     mg.Spec.InitProvider.FileFormatSelector,
+		To: reference.To{
+			List:    &FileFormatList{},
+			Managed: &FileFormat{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.FileFormat")
+	}
+	mg.Spec.InitProvider.FileFormat = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.FileFormatRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Location),
+		Extract:      common.StageLocation(),
+		Reference:    mg.Spec.InitProvider.LocationRef,
+		Selector:     mg.Spec.InitProvider.LocationSelector,
+		To: reference.To{
+			List:    &StageList{},
+			Managed: &Stage{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Location")
+	}
+	mg.Spec.InitProvider.Location = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.LocationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Database")
+		}
+		mg.Spec.InitProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Name")
+		}
+		mg.Spec.InitProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Schema")
+		}
+		mg.Spec.InitProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this FunctionJava.
+func (mg *FunctionJava) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this FunctionJavaScript.
+func (mg *FunctionJavaScript) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this FunctionPython.
+func (mg *FunctionPython) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this FunctionSQL.
+func (mg *FunctionSQL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this FunctionScala.
+func (mg *FunctionScala) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this MaskingPolicy.
+func (mg *MaskingPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this MaterializedView.
+func (mg *MaterializedView) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Database")
+		}
+		mg.Spec.ForProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Name")
+		}
+		mg.Spec.ForProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Schema")
+		}
+		mg.Spec.ForProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Database")
+		}
+		mg.Spec.InitProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Name")
+		}
+		mg.Spec.InitProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Schema")
+		}
+		mg.Spec.InitProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Pipe.
+func (mg *Pipe) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ErrorIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.ErrorIntegrationRef,
+		Selector:     mg.Spec.ForProvider.ErrorIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ErrorIntegration")
+	}
+	mg.Spec.ForProvider.ErrorIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ErrorIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Integration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.IntegrationRef,
+		Selector:     mg.Spec.ForProvider.IntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Integration")
+	}
+	mg.Spec.ForProvider.Integration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.ErrorIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.ErrorIntegrationRef,
+		Selector:     mg.Spec.InitProvider.ErrorIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.ErrorIntegration")
+	}
+	mg.Spec.InitProvider.ErrorIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ErrorIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Integration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.IntegrationRef,
+		Selector:     mg.Spec.InitProvider.IntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Integration")
+	}
+	mg.Spec.InitProvider.Integration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.IntegrationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProcedureJava.
+func (mg *ProcedureJava) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ProcedureJavaScript.
+func (mg *ProcedureJavaScript) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProcedurePython.
+func (mg *ProcedurePython) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProcedureSQL.
+func (mg *ProcedureSQL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProcedureScala.
+func (mg *ProcedureScala) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.ForProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.ForProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.ForProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Imports); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Imports[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.Imports[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.Imports[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Imports[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.Imports[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Imports[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.TargetPath); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.TargetPath[i3].StageLocation),
+			Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+			Reference:    mg.Spec.InitProvider.TargetPath[i3].StageLocationRef,
+			Selector:     mg.Spec.InitProvider.TargetPath[i3].StageLocationSelector,
+			To: reference.To{
+				List:    &StageList{},
+				Managed: &Stage{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.TargetPath[i3].StageLocation")
+		}
+		mg.Spec.InitProvider.TargetPath[i3].StageLocation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.TargetPath[i3].StageLocationRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this RowAccessPolicy.
+func (mg *RowAccessPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Schema.
+func (mg *Schema) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Sequence.
+func (mg *Sequence) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Stage.
+func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.StorageIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.StorageIntegrationRef,
+		Selector:     mg.Spec.ForProvider.StorageIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.StorageIntegrationList{},
+			Managed: &v1alpha11.StorageIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.StorageIntegration")
+	}
+	mg.Spec.ForProvider.StorageIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StorageIntegrationRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Database")
+		}
+		mg.Spec.ForProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Name")
+		}
+		mg.Spec.ForProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Schema")
+		}
+		mg.Spec.ForProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.StorageIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.StorageIntegrationRef,
+		Selector:     mg.Spec.InitProvider.StorageIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.StorageIntegrationList{},
+			Managed: &v1alpha11.StorageIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.StorageIntegration")
+	}
+	mg.Spec.InitProvider.StorageIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.StorageIntegrationRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Database")
+		}
+		mg.Spec.InitProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Name")
+		}
+		mg.Spec.InitProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Schema")
+		}
+		mg.Spec.InitProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this StreamOnDirectoryTable.
+func (mg *StreamOnDirectoryTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Stage),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.StageRef,
+		Selector:     mg.Spec.ForProvider.StageSelector,
+		To: reference.To{
+			List:    &StageList{},
+			Managed: &Stage{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Stage")
+	}
+	mg.Spec.ForProvider.Stage = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StageRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Stage),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.StageRef,
+		Selector:     mg.Spec.InitProvider.StageSelector,
+		To: reference.To{
+			List:    &StageList{},
+			Managed: &Stage{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Stage")
+	}
+	mg.Spec.InitProvider.Stage = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.StageRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this StreamOnExternalTable.
+func (mg *StreamOnExternalTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ExternalTable),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.ExternalTableRef,
+		Selector:     mg.Spec.ForProvider.ExternalTableSelector,
+		To: reference.To{
+			List:    &ExternalTableList{},
+			Managed: &ExternalTable{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ExternalTable")
+	}
+	mg.Spec.ForProvider.ExternalTable = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ExternalTableRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.ExternalTable),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.ExternalTableRef,
+		Selector:     mg.Spec.InitProvider.ExternalTableSelector,
+		To: reference.To{
+			List:    &ExternalTableList{},
+			Managed: &ExternalTable{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.ExternalTable")
+	}
+	mg.Spec.InitProvider.ExternalTable = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ExternalTableRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this StreamOnTable.
+func (mg *StreamOnTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Table),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.TableRef,
+		Selector:     mg.Spec.ForProvider.TableSelector,
+		To: reference.To{
+			List:    &TableList{},
+			Managed: &Table{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Table")
+	}
+	mg.Spec.ForProvider.Table = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TableRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Table),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.TableRef,
+		Selector:     mg.Spec.InitProvider.TableSelector,
+		To: reference.To{
+			List:    &TableList{},
+			Managed: &Table{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Table")
+	}
+	mg.Spec.InitProvider.Table = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.TableRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this StreamOnView.
+func (mg *StreamOnView) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.View),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.ViewRef,
+		Selector:     mg.Spec.ForProvider.ViewSelector,
+		To: reference.To{
+			List:    &ViewList{},
+			Managed: &View{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.View")
+	}
+	mg.Spec.ForProvider.View = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ViewRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.View),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.ViewRef,
+		Selector:     mg.Spec.InitProvider.ViewSelector,
+		To: reference.To{
+			List:    &ViewList{},
+			Managed: &View{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.View")
+	}
+	mg.Spec.InitProvider.View = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ViewRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Table.
+func (mg *Table) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Database")
+		}
+		mg.Spec.ForProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Name")
+		}
+		mg.Spec.ForProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.ForProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.ForProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Tag[i3].Schema")
+		}
+		mg.Spec.ForProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Database),
+			Extract:      resource.ExtractParamPath("database", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].DatabaseRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].DatabaseSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Database")
+		}
+		mg.Spec.InitProvider.Tag[i3].Database = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].DatabaseRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Name),
+			Extract:      resource.ExtractParamPath("name", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].NameRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].NameSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Name")
+		}
+		mg.Spec.InitProvider.Tag[i3].Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].NameRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.InitProvider.Tag); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Tag[i3].Schema),
+			Extract:      resource.ExtractParamPath("schema", false),
+			Reference:    mg.Spec.InitProvider.Tag[i3].SchemaRef,
+			Selector:     mg.Spec.InitProvider.Tag[i3].SchemaSelector,
+			To: reference.To{
+				List:    &TagList{},
+				Managed: &Tag{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.InitProvider.Tag[i3].Schema")
+		}
+		mg.Spec.InitProvider.Tag[i3].Schema = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.InitProvider.Tag[i3].SchemaRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this TableColumnMaskingPolicyApplication.
+func (mg *TableColumnMaskingPolicyApplication) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MaskingPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.MaskingPolicyRef,
+		Selector:     mg.Spec.ForProvider.MaskingPolicySelector,
+		To: reference.To{
+			List:    &MaskingPolicyList{},
+			Managed: &MaskingPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.MaskingPolicy")
+	}
+	mg.Spec.ForProvider.MaskingPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MaskingPolicyRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Table),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.TableRef,
+		Selector:     mg.Spec.ForProvider.TableSelector,
+		To: reference.To{
+			List:    &TableList{},
+			Managed: &Table{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Table")
+	}
+	mg.Spec.ForProvider.Table = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TableRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.MaskingPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.MaskingPolicyRef,
+		Selector:     mg.Spec.InitProvider.MaskingPolicySelector,
+		To: reference.To{
+			List:    &MaskingPolicyList{},
+			Managed: &MaskingPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.MaskingPolicy")
+	}
+	mg.Spec.InitProvider.MaskingPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.MaskingPolicyRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Table),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.TableRef,
+		Selector:     mg.Spec.InitProvider.TableSelector,
+		To: reference.To{
+			List:    &TableList{},
+			Managed: &Table{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Table")
+	}
+	mg.Spec.InitProvider.Table = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.TableRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Tag.
+func (mg *Tag) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.MaskingPolicies),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.ForProvider.MaskingPoliciesRefs,
+		Selector:      mg.Spec.ForProvider.MaskingPoliciesSelector,
+		To: reference.To{
+			List:    &MaskingPolicyList{},
+			Managed: &MaskingPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.MaskingPolicies")
+	}
+	mg.Spec.ForProvider.MaskingPolicies = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.MaskingPoliciesRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.InitProvider.MaskingPolicies),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.InitProvider.MaskingPoliciesRefs,
+		Selector:      mg.Spec.InitProvider.MaskingPoliciesSelector,
+		To: reference.To{
+			List:    &MaskingPolicyList{},
+			Managed: &MaskingPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.MaskingPolicies")
+	}
+	mg.Spec.InitProvider.MaskingPolicies = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.InitProvider.MaskingPoliciesRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TagAssociation.
+func (mg *TagAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TagID),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.TagIDRef,
+		Selector:     mg.Spec.ForProvider.TagIDSelector,
+		To: reference.To{
+			List:    &TagList{},
+			Managed: &Tag{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TagID")
+	}
+	mg.Spec.ForProvider.TagID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TagIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.TagID),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.TagIDRef,
+		Selector:     mg.Spec.InitProvider.TagIDSelector,
+		To: reference.To{
+			List:    &TagList{},
+			Managed: &Tag{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.TagID")
+	}
+	mg.Spec.InitProvider.TagID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.TagIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Task.
+func (mg *Task) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.After),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.ForProvider.AfterRefs,
+		Selector:      mg.Spec.ForProvider.AfterSelector,
+		To: reference.To{
+			List:    &TaskList{},
+			Managed: &Task{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.After")
+	}
+	mg.Spec.ForProvider.After = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.AfterRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ErrorIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.ErrorIntegrationRef,
+		Selector:     mg.Spec.ForProvider.ErrorIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ErrorIntegration")
+	}
+	mg.Spec.ForProvider.ErrorIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ErrorIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Finalize),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.FinalizeRef,
+		Selector:     mg.Spec.ForProvider.FinalizeSelector,
+		To: reference.To{
+			List:    &TaskList{},
+			Managed: &Task{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Finalize")
+	}
+	mg.Spec.ForProvider.Finalize = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FinalizeRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.WarehouseRef,
+		Selector:     mg.Spec.ForProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Warehouse")
+	}
+	mg.Spec.ForProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WarehouseRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.InitProvider.After),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.InitProvider.AfterRefs,
+		Selector:      mg.Spec.InitProvider.AfterSelector,
+		To: reference.To{
+			List:    &TaskList{},
+			Managed: &Task{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.After")
+	}
+	mg.Spec.InitProvider.After = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.InitProvider.AfterRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.ErrorIntegration),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.ErrorIntegrationRef,
+		Selector:     mg.Spec.InitProvider.ErrorIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.NotificationIntegrationList{},
+			Managed: &v1alpha11.NotificationIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.ErrorIntegration")
+	}
+	mg.Spec.InitProvider.ErrorIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.ErrorIntegrationRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Finalize),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.FinalizeRef,
+		Selector:     mg.Spec.InitProvider.FinalizeSelector,
+		To: reference.To{
+			List:    &TaskList{},
+			Managed: &Task{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Finalize")
+	}
+	mg.Spec.InitProvider.Finalize = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.FinalizeRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Warehouse),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.WarehouseRef,
+		Selector:     mg.Spec.InitProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Warehouse")
+	}
+	mg.Spec.InitProvider.Warehouse = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.WarehouseRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this View.
+func (mg *View) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &DatabaseList{},
+			Managed: &Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &SchemaList{},
+			Managed: &Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type MaterializedViewInitParameters struct {
+
+	// (String) Specifies a comment for the view.
+	// Specifies a comment for the view.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// The database in which to create the view. Don't use the | character.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Boolean) (Default: false) Specifies that the view is secure.
+	// (Default: `false`) Specifies that the view is secure.
+	IsSecure *bool `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Overwrites the View if it exists.
+	// (Default: `false`) Overwrites the View if it exists.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// The schema in which to create the view. Don't use the | character.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the query used to create the view.
+	// Specifies the query used to create the view.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []MaterializedViewTagInitParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+
+	// (String) The warehouse name.
+	// The warehouse name.
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+}
+
+type MaterializedViewObservation struct {
+
+	// (String) Specifies a comment for the view.
+	// Specifies a comment for the view.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// The database in which to create the view. Don't use the | character.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Boolean) (Default: false) Specifies that the view is secure.
+	// (Default: `false`) Specifies that the view is secure.
+	IsSecure *bool `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Overwrites the View if it exists.
+	// (Default: `false`) Overwrites the View if it exists.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// The schema in which to create the view. Don't use the | character.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) Specifies the query used to create the view.
+	// Specifies the query used to create the view.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []MaterializedViewTagObservation `json:"tag,omitempty" tf:"tag,omitempty"`
+
+	// (String) The warehouse name.
+	// The warehouse name.
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+}
+
+type MaterializedViewParameters struct {
+
+	// (String) Specifies a comment for the view.
+	// Specifies a comment for the view.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// The database in which to create the view. Don't use the | character.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Boolean) (Default: false) Specifies that the view is secure.
+	// (Default: `false`) Specifies that the view is secure.
+	// +kubebuilder:validation:Optional
+	IsSecure *bool `json:"isSecure,omitempty" tf:"is_secure,omitempty"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: false) Overwrites the View if it exists.
+	// (Default: `false`) Overwrites the View if it exists.
+	// +kubebuilder:validation:Optional
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// The schema in which to create the view. Don't use the | character.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the query used to create the view.
+	// Specifies the query used to create the view.
+	// +kubebuilder:validation:Optional
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	// +kubebuilder:validation:Optional
+	Tag []MaterializedViewTagParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+
+	// (String) The warehouse name.
+	// The warehouse name.
+	// +kubebuilder:validation:Optional
+	Warehouse *string `json:"warehouse,omitempty" tf:"warehouse,omitempty"`
+}
+
+type MaterializedViewTagInitParameters struct {
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type MaterializedViewTagObservation struct {
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// Name of the database that the tag was created in.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Tag name, e.g. department.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// Name of the schema that the tag was created in.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type MaterializedViewTagParameters struct {
+
+	// (String) The database in which to create the view. Don't use the | character.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the view; must be unique for the schema in which the view is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the view. Don't use the | character.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value" tf:"value,omitempty"`
+}
+
+// MaterializedViewSpec defines the desired state of MaterializedView
+type MaterializedViewSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     MaterializedViewParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider MaterializedViewInitParameters `json:"initProvider,omitempty"`
+}
+
+// MaterializedViewStatus defines the observed state of MaterializedView.
+type MaterializedViewStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        MaterializedViewObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// MaterializedView is the Schema for the MaterializedViews API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type MaterializedView struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.statement) || (has(self.initProvider) && has(self.initProvider.statement))",message="spec.forProvider.statement is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.warehouse) || (has(self.initProvider) && has(self.initProvider.warehouse))",message="spec.forProvider.warehouse is a required parameter"
+	Spec   MaterializedViewSpec   `json:"spec"`
+	Status MaterializedViewStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaterializedViewList contains a list of MaterializedViews
+type MaterializedViewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaterializedView `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	MaterializedView_Kind             = "MaterializedView"
+	MaterializedView_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: MaterializedView_Kind}.String()
+	MaterializedView_KindAPIVersion   = MaterializedView_Kind + "." + CRDGroupVersion.String()
+	MaterializedView_GroupVersionKind = CRDGroupVersion.WithKind(MaterializedView_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MaterializedView{}, &MaterializedViewList{})
+}
@@ -37,12 +37,35 @@ type PipeInitParameters struct {
 
 	// (String) Specifies the name of the notification integration used for error notifications.
 	// Specifies the name of the notification integration used for error notifications.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.NotificationIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	ErrorIntegration *string `json:"errorIntegration,omitempty" tf:"error_integration,omitempty"`
 
+	// Reference to a NotificationIntegration in integration to populate errorIntegration.
+	// +kubebuilder:validation:Optional
+	ErrorIntegrationRef *v1.Reference `json:"errorIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a NotificationIntegration in integration to populate errorIntegration.
+	// +kubebuilder:validation:Optional
+	ErrorIntegrationSelector *v1.Selector `json:"errorIntegrationSelector,omitempty" tf:"-"`
+
+	// Desired execution state of the pipe: RUNNING or PAUSED. Left unmanaged if not set. A pipe that Snowflake stopped, for example because its stage was dropped, is not resumed.
+	ExecutionState *string `json:"executionState,omitempty" tf:"execution_state,omitempty"`
+
 	// (String) Specifies an integration for the pipe.
 	// Specifies an integration for the pipe.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.NotificationIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	Integration *string `json:"integration,omitempty" tf:"integration,omitempty"`
 
+	// Reference to a NotificationIntegration in integration to populate integration.
+	// +kubebuilder:validation:Optional
+	IntegrationRef *v1.Reference `json:"integrationRef,omitempty" tf:"-"`
+
+	// Selector for a NotificationIntegration in integration to populate integration.
+	// +kubebuilder:validation:Optional
+	IntegrationSelector *v1.Selector `json:"integrationSelector,omitempty" tf:"-"`
+
 	// (String) Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	// Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -78,6 +101,9 @@ type PipeObservation struct {
 	// Specifies the name of the notification integration used for error notifications.
 	ErrorIntegration *string `json:"errorIntegration,omitempty" tf:"error_integration,omitempty"`
 
+	// Desired execution state of the pipe: RUNNING or PAUSED. Left unmanaged if not set. A pipe that Snowflake stopped, for example because its stage was dropped, is not resumed.
+	ExecutionState *string `json:"executionState,omitempty" tf:"execution_state,omitempty"`
+
 	// (String) Fully qualified name of the resource. For more information, see object name resolution.
 	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
 	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
@@ -89,6 +115,24 @@ type PipeObservation struct {
 	// Specifies an integration for the pipe.
 	Integration *string `json:"integration,omitempty" tf:"integration,omitempty"`
 
+	// Latest error that keeps the pipe from loading files, such as a dropped or inaccessible table or stage.
+	LastError *string `json:"lastError,omitempty" tf:"last_error,omitempty"`
+
+	// Time the most recently loaded file was loaded.
+	LastIngestedTime *string `json:"lastIngestedTime,omitempty" tf:"last_ingested_time,omitempty"`
+
+	// Error of the latest refresh, if it failed.
+	LastRefreshError *string `json:"lastRefreshError,omitempty" tf:"last_refresh_error,omitempty"`
+
+	// Number of staged files the latest refresh queued for loading.
+	LastRefreshFiles *int64 `json:"lastRefreshFiles,omitempty" tf:"last_refresh_files,omitempty"`
+
+	// Value of the snowflake.com/refresh annotation the latest refresh was run for.
+	LastRefreshRequest *string `json:"lastRefreshRequest,omitempty" tf:"last_refresh_request,omitempty"`
+
+	// Time the latest refresh was run.
+	LastRefreshTime *string `json:"lastRefreshTime,omitempty" tf:"last_refresh_time,omitempty"`
+
 	// (String) Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	// Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
@@ -101,9 +145,15 @@ type PipeObservation struct {
 	// Name of the role that owns the pipe.
 	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
 
+	// Number of files queued for loading by the pipe.
+	PendingFileCount *int64 `json:"pendingFileCount,omitempty" tf:"pending_file_count,omitempty"`
+
 	// (String) The schema in which to create the pipe.
 	// The schema in which to create the pipe.
 	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// URL of the stage the pipe loads files from, empty for internal stages.
+	StageURL *string `json:"stageUrl,omitempty" tf:"stage_url,omitempty"`
 }
 
 type PipeParameters struct {
@@ -135,14 +185,38 @@ type PipeParameters struct {
 
 	// (String) Specifies the name of the notification integration used for error notifications.
 	// Specifies the name of the notification integration used for error notifications.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.NotificationIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	// +kubebuilder:validation:Optional
 	ErrorIntegration *string `json:"errorIntegration,omitempty" tf:"error_integration,omitempty"`
 
+	// Reference to a NotificationIntegration in integration to populate errorIntegration.
+	// +kubebuilder:validation:Optional
+	ErrorIntegrationRef *v1.Reference `json:"errorIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a NotificationIntegration in integration to populate errorIntegration.
+	// +kubebuilder:validation:Optional
+	ErrorIntegrationSelector *v1.Selector `json:"errorIntegrationSelector,omitempty" tf:"-"`
+
+	// Desired execution state of the pipe: RUNNING or PAUSED. Left unmanaged if not set. A pipe that Snowflake stopped, for example because its stage was dropped, is not resumed.
+	// +kubebuilder:validation:Optional
+	ExecutionState *string `json:"executionState,omitempty" tf:"execution_state,omitempty"`
+
 	// (String) Specifies an integration for the pipe.
 	// Specifies an integration for the pipe.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.NotificationIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	// +kubebuilder:validation:Optional
 	Integration *string `json:"integration,omitempty" tf:"integration,omitempty"`
 
+	// Reference to a NotificationIntegration in integration to populate integration.
+	// +kubebuilder:validation:Optional
+	IntegrationRef *v1.Reference `json:"integrationRef,omitempty" tf:"-"`
+
+	// Selector for a NotificationIntegration in integration to populate integration.
+	// +kubebuilder:validation:Optional
+	IntegrationSelector *v1.Selector `json:"integrationSelector,omitempty" tf:"-"`
+
 	// (String) Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	// Specifies the identifier for the pipe; must be unique for the database and schema in which the pipe is created.
 	// +kubebuilder:validation:Optional
@@ -21,7 +21,7 @@ func (mg *Stage) GetTerraformResourceType() string {
 
 // GetConnectionDetailsMapping for this Stage
 func (tr *Stage) GetConnectionDetailsMapping() map[string]string {
-	return map[string]string{"credentials": "credentialsSecretRef"}
+	return map[string]string{"aws_key_id": "awsKeyIdSecretRef", "aws_secret_key": "awsSecretKeySecretRef", "aws_token": "awsTokenSecretRef", "azure_sas_token": "azureSasTokenSecretRef", "credentials": "credentialsSecretRef", "encryption_master_key": "encryptionMasterKeySecretRef"}
 }
 
 // GetObservation of this Stage
@@ -19,6 +19,22 @@ type StageInitParameters struct {
 	// A unique ID assigned to the specific stage. The ID has the following format: &lt;snowflakeAccount&gt;_SFCRole=&lt;snowflakeRoleId&gt;_&lt;randomId&gt;
 	AwsExternalID *string `json:"awsExternalId,omitempty" tf:"aws_external_id,omitempty"`
 
+	// (String, Sensitive) AWS access key ID of an external stage on S3, for accessing it without a storage integration.
+	// AWS access key ID of an external stage on S3, for accessing it without a storage integration.
+	AwsKeyIDSecretRef *v1.SecretKeySelector `json:"awsKeyIdSecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) AWS secret access key of an external stage on S3.
+	// AWS secret access key of an external stage on S3.
+	AwsSecretKeySecretRef *v1.SecretKeySelector `json:"awsSecretKeySecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) AWS session token of an external stage on S3, for temporary credentials.
+	// AWS session token of an external stage on S3, for temporary credentials.
+	AwsTokenSecretRef *v1.SecretKeySelector `json:"awsTokenSecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) Shared access signature token of an external stage on Azure, for accessing it without a storage integration.
+	// Shared access signature token of an external stage on Azure, for accessing it without a storage integration.
+	AzureSasTokenSecretRef *v1.SecretKeySelector `json:"azureSasTokenSecretRef,omitempty" tf:"-"`
+
 	// (String) Specifies a comment for the stage.
 	// Specifies a comment for the stage.
 	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
@@ -27,8 +43,8 @@ type StageInitParameters struct {
 	// Specifies the copy options for the stage.
 	CopyOptions *string `json:"copyOptions,omitempty" tf:"copy_options,omitempty"`
 
-	// (String, Sensitive) Specifies the credentials for the stage.
-	// Specifies the credentials for the stage.
+	// (String, Sensitive) Deprecated: set awsKeyIdSecretRef, awsSecretKeySecretRef and awsTokenSecretRef, or azureSasTokenSecretRef, instead. Credentials of the stage, e.g. AWS_KEY_ID = '...' AWS_SECRET_KEY = '...'. Ignored if any of the fields that replace it is set.
+	// Deprecated: set awsKeyIdSecretRef, awsSecretKeySecretRef and awsTokenSecretRef, or azureSasTokenSecretRef, instead. Credentials of the stage, e.g. AWS_KEY_ID = '...' AWS_SECRET_KEY = '...'. Ignored if any of the fields that replace it is set.
 	CredentialsSecretRef *v1.SecretKeySelector `json:"credentialsSecretRef,omitempty" tf:"-"`
 
 	// (String) The database in which to create the stage.
@@ -39,10 +55,22 @@ type StageInitParameters struct {
 	// Specifies the directory settings for the stage.
 	Directory *string `json:"directory,omitempty" tf:"directory,omitempty"`
 
-	// (String) Specifies the encryption settings for the stage.
-	// Specifies the encryption settings for the stage.
+	// (String) Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
+	// Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
 	Encryption *string `json:"encryption,omitempty" tf:"encryption,omitempty"`
 
+	// (String) ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	// ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// (String, Sensitive) Master key, encoded in Base64, that encrypts the files in the stage, for AWS_CSE and AZURE_CSE encryption.
+	// Master key, encoded in Base64, that encrypts the files in the stage, for AWS_CSE and AZURE_CSE encryption.
+	EncryptionMasterKeySecretRef *v1.SecretKeySelector `json:"encryptionMasterKeySecretRef,omitempty" tf:"-"`
+
+	// (String) Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	// Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
 	// (String) Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check #2679). For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: 1. with hardcoding value: file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME" 2. from dynamic value: file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}" 3. from expression: file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name). Reference: #265
 	// Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check [#2679](https://github. For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: <b>1. with hardcoding value:</b> `file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME"` <b>2. from dynamic value:</b> `file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}"` <b>3. from expression:</b> `file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name)`. Reference: [#265](https://github
 	FileFormat *string `json:"fileFormat,omitempty" tf:"file_format,omitempty"`
@@ -61,8 +89,18 @@ type StageInitParameters struct {
 
 	// (String) Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.
 	// Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.StorageIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	StorageIntegration *string `json:"storageIntegration,omitempty" tf:"storage_integration,omitempty"`
 
+	// Reference to a StorageIntegration in integration to populate storageIntegration.
+	// +kubebuilder:validation:Optional
+	StorageIntegrationRef *v1.Reference `json:"storageIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a StorageIntegration in integration to populate storageIntegration.
+	// +kubebuilder:validation:Optional
+	StorageIntegrationSelector *v1.Selector `json:"storageIntegrationSelector,omitempty" tf:"-"`
+
 	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
 	// Definitions of a tag to associate with the resource.
 	Tag []TagInitParameters `json:"tag,omitempty" tf:"tag,omitempty"`
@@ -94,10 +132,18 @@ type StageObservation struct {
 	// Specifies the directory settings for the stage.
 	Directory *string `json:"directory,omitempty" tf:"directory,omitempty"`
 
-	// (String) Specifies the encryption settings for the stage.
-	// Specifies the encryption settings for the stage.
+	// (String) Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
+	// Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
 	Encryption *string `json:"encryption,omitempty" tf:"encryption,omitempty"`
 
+	// (String) ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	// ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// (String) Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	// Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
 	// (String) Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check #2679). For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: 1. with hardcoding value: file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME" 2. from dynamic value: file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}" 3. from expression: file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name). Reference: #265
 	// Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check [#2679](https://github. For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: <b>1. with hardcoding value:</b> `file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME"` <b>2. from dynamic value:</b> `file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}"` <b>3. from expression:</b> `file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name)`. Reference: [#265](https://github
 	FileFormat *string `json:"fileFormat,omitempty" tf:"file_format,omitempty"`
@@ -141,6 +187,26 @@ type StageParameters struct {
 	// +kubebuilder:validation:Optional
 	AwsExternalID *string `json:"awsExternalId,omitempty" tf:"aws_external_id,omitempty"`
 
+	// (String, Sensitive) AWS access key ID of an external stage on S3, for accessing it without a storage integration.
+	// AWS access key ID of an external stage on S3, for accessing it without a storage integration.
+	// +kubebuilder:validation:Optional
+	AwsKeyIDSecretRef *v1.SecretKeySelector `json:"awsKeyIdSecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) AWS secret access key of an external stage on S3.
+	// AWS secret access key of an external stage on S3.
+	// +kubebuilder:validation:Optional
+	AwsSecretKeySecretRef *v1.SecretKeySelector `json:"awsSecretKeySecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) AWS session token of an external stage on S3, for temporary credentials.
+	// AWS session token of an external stage on S3, for temporary credentials.
+	// +kubebuilder:validation:Optional
+	AwsTokenSecretRef *v1.SecretKeySelector `json:"awsTokenSecretRef,omitempty" tf:"-"`
+
+	// (String, Sensitive) Shared access signature token of an external stage on Azure, for accessing it without a storage integration.
+	// Shared access signature token of an external stage on Azure, for accessing it without a storage integration.
+	// +kubebuilder:validation:Optional
+	AzureSasTokenSecretRef *v1.SecretKeySelector `json:"azureSasTokenSecretRef,omitempty" tf:"-"`
+
 	// (String) Specifies a comment for the stage.
 	// Specifies a comment for the stage.
 	// +kubebuilder:validation:Optional
@@ -151,8 +217,8 @@ type StageParameters struct {
 	// +kubebuilder:validation:Optional
 	CopyOptions *string `json:"copyOptions,omitempty" tf:"copy_options,omitempty"`
 
-	// (String, Sensitive) Specifies the credentials for the stage.
-	// Specifies the credentials for the stage.
+	// (String, Sensitive) Deprecated: set awsKeyIdSecretRef, awsSecretKeySecretRef and awsTokenSecretRef, or azureSasTokenSecretRef, instead. Credentials of the stage, e.g. AWS_KEY_ID = '...' AWS_SECRET_KEY = '...'. Ignored if any of the fields that replace it is set.
+	// Deprecated: set awsKeyIdSecretRef, awsSecretKeySecretRef and awsTokenSecretRef, or azureSasTokenSecretRef, instead. Credentials of the stage, e.g. AWS_KEY_ID = '...' AWS_SECRET_KEY = '...'. Ignored if any of the fields that replace it is set.
 	// +kubebuilder:validation:Optional
 	CredentialsSecretRef *v1.SecretKeySelector `json:"credentialsSecretRef,omitempty" tf:"-"`
 
@@ -166,11 +232,26 @@ type StageParameters struct {
 	// +kubebuilder:validation:Optional
 	Directory *string `json:"directory,omitempty" tf:"directory,omitempty"`
 
-	// (String) Specifies the encryption settings for the stage.
-	// Specifies the encryption settings for the stage.
+	// (String) Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
+	// Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.
 	// +kubebuilder:validation:Optional
 	Encryption *string `json:"encryption,omitempty" tf:"encryption,omitempty"`
 
+	// (String) ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	// ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.
+	// +kubebuilder:validation:Optional
+	EncryptionKMSKeyID *string `json:"encryptionKmsKeyId,omitempty" tf:"encryption_kms_key_id,omitempty"`
+
+	// (String, Sensitive) Master key, encoded in Base64, that encrypts the files in the stage, for AWS_CSE and AZURE_CSE encryption.
+	// Master key, encoded in Base64, that encrypts the files in the stage, for AWS_CSE and AZURE_CSE encryption.
+	// +kubebuilder:validation:Optional
+	EncryptionMasterKeySecretRef *v1.SecretKeySelector `json:"encryptionMasterKeySecretRef,omitempty" tf:"-"`
+
+	// (String) Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	// Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.
+	// +kubebuilder:validation:Optional
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
 	// (String) Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check #2679). For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: 1. with hardcoding value: file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME" 2. from dynamic value: file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}" 3. from expression: file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name). Reference: #265
 	// Specifies the file format for the stage. Specifying the default Snowflake value (e.g. TYPE = CSV) will currently result in a permadiff (check [#2679](https://github. For now, omit the default values; it will be fixed in the upcoming provider versions. Examples of usage: <b>1. with hardcoding value:</b> `file_format="FORMAT_NAME = DB.SCHEMA.FORMATNAME"` <b>2. from dynamic value:</b> `file_format = "FORMAT_NAME = ${snowflake_file_format.myfileformat.fully_qualified_name}"` <b>3. from expression:</b> `file_format = format("FORMAT_NAME =%s.%s.MYFILEFORMAT", var.db_name, each.value.schema_name)`. Reference: [#265](https://github
 	// +kubebuilder:validation:Optional
@@ -193,9 +274,19 @@ type StageParameters struct {
 
 	// (String) Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.
 	// Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.StorageIntegration
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	// +kubebuilder:validation:Optional
 	StorageIntegration *string `json:"storageIntegration,omitempty" tf:"storage_integration,omitempty"`
 
+	// Reference to a StorageIntegration in integration to populate storageIntegration.
+	// +kubebuilder:validation:Optional
+	StorageIntegrationRef *v1.Reference `json:"storageIntegrationRef,omitempty" tf:"-"`
+
+	// Selector for a StorageIntegration in integration to populate storageIntegration.
+	// +kubebuilder:validation:Optional
+	StorageIntegrationSelector *v1.Selector `json:"storageIntegrationSelector,omitempty" tf:"-"`
+
 	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
 	// Definitions of a tag to associate with the resource.
 	// +kubebuilder:validation:Optional
@@ -211,16 +302,46 @@ type TagInitParameters struct {
 
 	// (String) The database in which to create the stage.
 	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
 	Database *string `json:"database,omitempty" tf:"database,omitempty"`
 
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
 	// (String) Specifies the identifier for the stage; must be unique for the database and schema in which the stage is created.
 	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
 	Name *string `json:"name,omitempty" tf:"name,omitempty"`
 
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
 	// (String) The schema in which to create the stage.
 	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
 	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
 
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
 	// (String) Tag value, e.g. marketing_info.
 	// Tag value, e.g. marketing_info.
 	Value *string `json:"value,omitempty" tf:"value,omitempty"`
@@ -249,19 +370,49 @@ type TagParameters struct {
 
 	// (String) The database in which to create the stage.
 	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
 	// +kubebuilder:validation:Optional
 	Database *string `json:"database,omitempty" tf:"database,omitempty"`
 
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
 	// (String) Specifies the identifier for the stage; must be unique for the database and schema in which the stage is created.
 	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
 	// +kubebuilder:validation:Optional
-	Name *string `json:"name" tf:"name,omitempty"`
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
 
 	// (String) The schema in which to create the stage.
 	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
 	// +kubebuilder:validation:Optional
 	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
 
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
 	// (String) Tag value, e.g. marketing_info.
 	// Tag value, e.g. marketing_info.
 	// +kubebuilder:validation:Optional
@@ -0,0 +1,515 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type AtInitParameters struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type AtObservation struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type AtParameters struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// +kubebuilder:validation:Optional
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// +kubebuilder:validation:Optional
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// +kubebuilder:validation:Optional
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// +kubebuilder:validation:Optional
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type BeforeInitParameters struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type BeforeObservation struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type BeforeParameters struct {
+
+	// N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N can be an integer or arithmetic expression (e.g. -120 is 120 seconds, -30*60 is 1800 seconds or 30 minutes).
+	// +kubebuilder:validation:Optional
+	Offset *string `json:"offset,omitempty" tf:"offset,omitempty"`
+
+	// (String) Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// Specifies the query ID of a statement to use as the reference point for Time Travel. This parameter supports any statement of one of the following types: DML (e.g. INSERT, UPDATE, DELETE), TCL (BEGIN, COMMIT transaction), SELECT.
+	// +kubebuilder:validation:Optional
+	Statement *string `json:"statement,omitempty" tf:"statement,omitempty"`
+
+	// (String) Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// Specifies the identifier (i.e. name) for an existing stream on the queried table or view. The current offset in the stream is used as the AT point in time for returning change data for the source object.
+	// +kubebuilder:validation:Optional
+	Stream *string `json:"stream,omitempty" tf:"stream,omitempty"`
+
+	// (String) Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// Specifies an exact date and time to use for Time Travel. The value must be explicitly cast to a TIMESTAMP, TIMESTAMP_LTZ, TIMESTAMP_NTZ, or TIMESTAMP_TZ data type.
+	// +kubebuilder:validation:Optional
+	Timestamp *string `json:"timestamp,omitempty" tf:"timestamp,omitempty"`
+}
+
+type StreamOnExternalTableDescribeOutputInitParameters struct {
+}
+
+type StreamOnExternalTableDescribeOutputObservation struct {
+
+	// (List of String)
+	BaseTables []*string `json:"baseTables,omitempty" tf:"base_tables,omitempty"`
+
+	// (String) Specifies a comment for the stream.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String)
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (String)
+	DatabaseName *string `json:"databaseName,omitempty" tf:"database_name,omitempty"`
+
+	// (String)
+	InvalidReason *string `json:"invalidReason,omitempty" tf:"invalid_reason,omitempty"`
+
+	// (String)
+	Mode *string `json:"mode,omitempty" tf:"mode,omitempty"`
+
+	// (String) Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String)
+	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
+
+	// (String)
+	OwnerRoleType *string `json:"ownerRoleType,omitempty" tf:"owner_role_type,omitempty"`
+
+	// (String)
+	SchemaName *string `json:"schemaName,omitempty" tf:"schema_name,omitempty"`
+
+	// (String)
+	SourceType *string `json:"sourceType,omitempty" tf:"source_type,omitempty"`
+
+	// (Boolean) Indicated if the stream is stale. Read more on stream staleness in Snowflake docs.
+	Stale *bool `json:"stale,omitempty" tf:"stale,omitempty"`
+
+	// (String)
+	StaleAfter *string `json:"staleAfter,omitempty" tf:"stale_after,omitempty"`
+
+	// (String)
+	TableName *string `json:"tableName,omitempty" tf:"table_name,omitempty"`
+
+	// (String)
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type StreamOnExternalTableDescribeOutputParameters struct {
+}
+
+type StreamOnExternalTableInitParameters struct {
+
+	// (see below for nested schema)
+	// This field specifies that the request is inclusive of any changes made by a statement or transaction with a timestamp equal to the specified parameter. Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	At []AtInitParameters `json:"at,omitempty" tf:"at,omitempty"`
+
+	// (see below for nested schema)
+	// This field specifies that the request refers to a point immediately preceding the specified parameter. This point in time is just before the statement, identified by its query ID, is completed.  Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	Before []BeforeInitParameters `json:"before,omitempty" tf:"before,omitempty"`
+
+	// (String) Specifies a comment for the stream.
+	// Specifies a comment for the stream.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	// (Default: `false`) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more here), avoid using the following characters: |, ., ". For more information about this resource, see docs.
+	// Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`. For more information about this resource, see [docs](./external_table).
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.ExternalTable
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	ExternalTable *string `json:"externalTable,omitempty" tf:"external_table,omitempty"`
+
+	// Reference to a ExternalTable in database to populate externalTable.
+	// +kubebuilder:validation:Optional
+	ExternalTableRef *v1.Reference `json:"externalTableRef,omitempty" tf:"-"`
+
+	// Selector for a ExternalTable in database to populate externalTable.
+	// +kubebuilder:validation:Optional
+	ExternalTableSelector *v1.Selector `json:"externalTableSelector,omitempty" tf:"-"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	InsertOnly *string `json:"insertOnly,omitempty" tf:"insert_only,omitempty"`
+
+	// (String) Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+}
+
+type StreamOnExternalTableObservation struct {
+
+	// (see below for nested schema)
+	// This field specifies that the request is inclusive of any changes made by a statement or transaction with a timestamp equal to the specified parameter. Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	At []AtObservation `json:"at,omitempty" tf:"at,omitempty"`
+
+	// (see below for nested schema)
+	// This field specifies that the request refers to a point immediately preceding the specified parameter. This point in time is just before the statement, identified by its query ID, is completed.  Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	Before []BeforeObservation `json:"before,omitempty" tf:"before,omitempty"`
+
+	// (String) Specifies a comment for the stream.
+	// Specifies a comment for the stream.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	// (Default: `false`) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (List of Object) Outputs the result of DESCRIBE STREAM for the given stream. (see below for nested schema)
+	// Outputs the result of `DESCRIBE STREAM` for the given stream.
+	DescribeOutput []StreamOnExternalTableDescribeOutputObservation `json:"describeOutput,omitempty" tf:"describe_output,omitempty"`
+
+	// (String) Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more here), avoid using the following characters: |, ., ". For more information about this resource, see docs.
+	// Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`. For more information about this resource, see [docs](./external_table).
+	ExternalTable *string `json:"externalTable,omitempty" tf:"external_table,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	InsertOnly *string `json:"insertOnly,omitempty" tf:"insert_only,omitempty"`
+
+	// (String) Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (List of Object) Outputs the result of SHOW STREAMS for the given stream. (see below for nested schema)
+	// Outputs the result of `SHOW STREAMS` for the given stream.
+	ShowOutput []StreamOnExternalTableShowOutputObservation `json:"showOutput,omitempty" tf:"show_output,omitempty"`
+
+	// (Boolean) Indicated if the stream is stale. Read more on stream staleness in Snowflake docs.
+	// Indicated if the stream is stale. Read more on stream staleness in Snowflake [docs](https://docs.snowflake.com/en/user-guide/streams-intro#data-retention-period-and-staleness).
+	Stale *bool `json:"stale,omitempty" tf:"stale,omitempty"`
+
+	// (String) Specifies a type for the stream. This field is used for checking external changes and recreating the resources if needed.
+	// Specifies a type for the stream. This field is used for checking external changes and recreating the resources if needed.
+	StreamType *string `json:"streamType,omitempty" tf:"stream_type,omitempty"`
+}
+
+type StreamOnExternalTableParameters struct {
+
+	// (see below for nested schema)
+	// This field specifies that the request is inclusive of any changes made by a statement or transaction with a timestamp equal to the specified parameter. Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	// +kubebuilder:validation:Optional
+	At []AtParameters `json:"at,omitempty" tf:"at,omitempty"`
+
+	// (see below for nested schema)
+	// This field specifies that the request refers to a point immediately preceding the specified parameter. This point in time is just before the statement, identified by its query ID, is completed.  Due to Snowflake limitations, the provider does not detect external changes on this field. External changes for this field won't be detected.
+	// +kubebuilder:validation:Optional
+	Before []BeforeParameters `json:"before,omitempty" tf:"before,omitempty"`
+
+	// (String) Specifies a comment for the stream.
+	// Specifies a comment for the stream.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: false) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	// (Default: `false`) Retains the access permissions from the original stream when a stream is recreated using the OR REPLACE clause. This is used when the provider detects changes for fields that can not be changed by ALTER.
+	// +kubebuilder:validation:Optional
+	CopyGrants *bool `json:"copyGrants,omitempty" tf:"copy_grants,omitempty"`
+
+	// (String) The database in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The database in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more here), avoid using the following characters: |, ., ". For more information about this resource, see docs.
+	// Specifies an identifier for the external table the stream will monitor. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`. For more information about this resource, see [docs](./external_table).
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.ExternalTable
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	// +kubebuilder:validation:Optional
+	ExternalTable *string `json:"externalTable,omitempty" tf:"external_table,omitempty"`
+
+	// Reference to a ExternalTable in database to populate externalTable.
+	// +kubebuilder:validation:Optional
+	ExternalTableRef *v1.Reference `json:"externalTableRef,omitempty" tf:"-"`
+
+	// Selector for a ExternalTable in database to populate externalTable.
+	// +kubebuilder:validation:Optional
+	ExternalTableSelector *v1.Selector `json:"externalTableSelector,omitempty" tf:"-"`
+
+	// uses special value that cannot be set in the configuration manually (default)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`default`)) Specifies whether this is an insert-only stream. Available options are: "true" or "false". When the value is not set in the configuration the provider will put "default" there which means to use the Snowflake default for this value.
+	// +kubebuilder:validation:Optional
+	InsertOnly *string `json:"insertOnly,omitempty" tf:"insert_only,omitempty"`
+
+	// (String) Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the stream. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	// The schema in which to create the stream. Due to technical limitations (read more [here](../guides/identifiers_rework_design_decisions#known-limitations-and-identifier-recommendations)), avoid using the following characters: `|`, `.`, `"`.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+}
+
+type StreamOnExternalTableShowOutputInitParameters struct {
+}
+
+type StreamOnExternalTableShowOutputObservation struct {
+
+	// (List of String)
+	BaseTables []*string `json:"baseTables,omitempty" tf:"base_tables,omitempty"`
+
+	// (String) Specifies a comment for the stream.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String)
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (String)
+	DatabaseName *string `json:"databaseName,omitempty" tf:"database_name,omitempty"`
+
+	// (String)
+	InvalidReason *string `json:"invalidReason,omitempty" tf:"invalid_reason,omitempty"`
+
+	// (String)
+	Mode *string `json:"mode,omitempty" tf:"mode,omitempty"`
+
+	// (String) Specifies the identifier for the stream; must be unique for the database and schema in which the stream is created. Due to technical limitations (read more here), avoid using the following characters: |, ., ".
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String)
+	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
+
+	// (String)
+	OwnerRoleType *string `json:"ownerRoleType,omitempty" tf:"owner_role_type,omitempty"`
+
+	// (String)
+	SchemaName *string `json:"schemaName,omitempty" tf:"schema_name,omitempty"`
+
+	// (String)
+	SourceType *string `json:"sourceType,omitempty" tf:"source_type,omitempty"`
+
+	// (Boolean) Indicated if the stream is stale. Read more on stream staleness in Snowflake docs.
+	Stale *bool `json:"stale,omitempty" tf:"stale,omitempty"`
+
+	// (String)
+	StaleAfter *string `json:"staleAfter,omitempty" tf:"stale_after,omitempty"`
+
+	// (String)
+	TableName *string `json:"tableName,omitempty" tf:"table_name,omitempty"`
+
+	// (String)
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type StreamOnExternalTableShowOutputParameters struct {
+}
+
+// StreamOnExternalTableSpec defines the desired state of StreamOnExternalTable
+type StreamOnExternalTableSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     StreamOnExternalTableParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider StreamOnExternalTableInitParameters `json:"initProvider,omitempty"`
+}
+
+// StreamOnExternalTableStatus defines the observed state of StreamOnExternalTable.
+type StreamOnExternalTableStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        StreamOnExternalTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// StreamOnExternalTable is the Schema for the StreamOnExternalTables API. Resource used to manage streams on external tables. For more information, check stream documentation https://docs.snowflake.com/en/sql-reference/sql/create-stream.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type StreamOnExternalTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	Spec   StreamOnExternalTableSpec   `json:"spec"`
+	Status StreamOnExternalTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamOnExternalTableList contains a list of StreamOnExternalTables
+type StreamOnExternalTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StreamOnExternalTable `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	StreamOnExternalTable_Kind             = "StreamOnExternalTable"
+	StreamOnExternalTable_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: StreamOnExternalTable_Kind}.String()
+	StreamOnExternalTable_KindAPIVersion   = StreamOnExternalTable_Kind + "." + CRDGroupVersion.String()
+	StreamOnExternalTable_GroupVersionKind = CRDGroupVersion.WithKind(StreamOnExternalTable_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StreamOnExternalTable{}, &StreamOnExternalTableList{})
+}
@@ -0,0 +1,623 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type ColumnInitParameters struct {
+
+	// (String) (Default: “) Column collation, e.g. utf8
+	// (Default: “) Column collation, e.g. utf8
+	Collate *string `json:"collate,omitempty" tf:"collate,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// (Default: “) Column comment
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Block List, Max: 1) Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied (see below for nested schema)
+	// Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied
+	Default []DefaultInitParameters `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (Block List, Max: 1) Defines the identity start/step values for a column. Note Identity/default are mutually exclusive. (see below for nested schema)
+	// Defines the identity start/step values for a column. **Note** Identity/default are mutually exclusive.
+	Identity []IdentityInitParameters `json:"identity,omitempty" tf:"identity,omitempty"`
+
+	// (String) (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	// (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	MaskingPolicy *string `json:"maskingPolicy,omitempty" tf:"masking_policy,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Column name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: true) Whether this column can contain null values. Note: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	// (Default: `true`) Whether this column can contain null values. **Note**: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	Nullable *bool `json:"nullable,omitempty" tf:"nullable,omitempty"`
+
+	// (String) Column type, e.g. VARIANT. For a full list of column types, see Summary of Data Types.
+	// Column type, e.g. VARIANT. For a full list of column types, see [Summary of Data Types](https://docs.snowflake.com/en/sql-reference/intro-summary-data-types).
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ColumnObservation struct {
+
+	// (String) (Default: “) Column collation, e.g. utf8
+	// (Default: “) Column collation, e.g. utf8
+	Collate *string `json:"collate,omitempty" tf:"collate,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// (Default: “) Column comment
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Block List, Max: 1) Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied (see below for nested schema)
+	// Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied
+	Default []DefaultObservation `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (Block List, Max: 1) Defines the identity start/step values for a column. Note Identity/default are mutually exclusive. (see below for nested schema)
+	// Defines the identity start/step values for a column. **Note** Identity/default are mutually exclusive.
+	Identity []IdentityObservation `json:"identity,omitempty" tf:"identity,omitempty"`
+
+	// (String) (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	// (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	MaskingPolicy *string `json:"maskingPolicy,omitempty" tf:"masking_policy,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Column name
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Boolean) (Default: true) Whether this column can contain null values. Note: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	// (Default: `true`) Whether this column can contain null values. **Note**: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	Nullable *bool `json:"nullable,omitempty" tf:"nullable,omitempty"`
+
+	// (String) Record of schema evolution.
+	// Record of schema evolution.
+	SchemaEvolutionRecord *string `json:"schemaEvolutionRecord,omitempty" tf:"schema_evolution_record,omitempty"`
+
+	// (String) Column type, e.g. VARIANT. For a full list of column types, see Summary of Data Types.
+	// Column type, e.g. VARIANT. For a full list of column types, see [Summary of Data Types](https://docs.snowflake.com/en/sql-reference/intro-summary-data-types).
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type ColumnParameters struct {
+
+	// (String) (Default: “) Column collation, e.g. utf8
+	// (Default: “) Column collation, e.g. utf8
+	// +kubebuilder:validation:Optional
+	Collate *string `json:"collate,omitempty" tf:"collate,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// (Default: “) Column comment
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Block List, Max: 1) Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied (see below for nested schema)
+	// Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied
+	// +kubebuilder:validation:Optional
+	Default []DefaultParameters `json:"default,omitempty" tf:"default,omitempty"`
+
+	// (Block List, Max: 1) Defines the identity start/step values for a column. Note Identity/default are mutually exclusive. (see below for nested schema)
+	// Defines the identity start/step values for a column. **Note** Identity/default are mutually exclusive.
+	// +kubebuilder:validation:Optional
+	Identity []IdentityParameters `json:"identity,omitempty" tf:"identity,omitempty"`
+
+	// (String) (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	// (Default: “) Masking policy to apply on column. It has to be a fully qualified name.
+	// +kubebuilder:validation:Optional
+	MaskingPolicy *string `json:"maskingPolicy,omitempty" tf:"masking_policy,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Column name
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// (Boolean) (Default: true) Whether this column can contain null values. Note: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	// (Default: `true`) Whether this column can contain null values. **Note**: Depending on your Snowflake version, the default value will not suffice if this column is used in a primary key constraint.
+	// +kubebuilder:validation:Optional
+	Nullable *bool `json:"nullable,omitempty" tf:"nullable,omitempty"`
+
+	// (String) Column type, e.g. VARIANT. For a full list of column types, see Summary of Data Types.
+	// Column type, e.g. VARIANT. For a full list of column types, see [Summary of Data Types](https://docs.snowflake.com/en/sql-reference/intro-summary-data-types).
+	// +kubebuilder:validation:Optional
+	Type *string `json:"type" tf:"type,omitempty"`
+}
+
+type DefaultInitParameters struct {
+
+	// (String) The default constant value for the column
+	// The default constant value for the column
+	Constant *string `json:"constant,omitempty" tf:"constant,omitempty"`
+
+	// (String) The default expression value for the column
+	// The default expression value for the column
+	Expression *string `json:"expression,omitempty" tf:"expression,omitempty"`
+
+	// (String) The default sequence to use for the column
+	// The default sequence to use for the column
+	Sequence *string `json:"sequence,omitempty" tf:"sequence,omitempty"`
+}
+
+type DefaultObservation struct {
+
+	// (String) The default constant value for the column
+	// The default constant value for the column
+	Constant *string `json:"constant,omitempty" tf:"constant,omitempty"`
+
+	// (String) The default expression value for the column
+	// The default expression value for the column
+	Expression *string `json:"expression,omitempty" tf:"expression,omitempty"`
+
+	// (String) The default sequence to use for the column
+	// The default sequence to use for the column
+	Sequence *string `json:"sequence,omitempty" tf:"sequence,omitempty"`
+}
+
+type DefaultParameters struct {
+
+	// (String) The default constant value for the column
+	// The default constant value for the column
+	// +kubebuilder:validation:Optional
+	Constant *string `json:"constant,omitempty" tf:"constant,omitempty"`
+
+	// (String) The default expression value for the column
+	// The default expression value for the column
+	// +kubebuilder:validation:Optional
+	Expression *string `json:"expression,omitempty" tf:"expression,omitempty"`
+
+	// (String) The default sequence to use for the column
+	// The default sequence to use for the column
+	// +kubebuilder:validation:Optional
+	Sequence *string `json:"sequence,omitempty" tf:"sequence,omitempty"`
+}
+
+type IdentityInitParameters struct {
+
+	// (Number) (Default: 1) The number to start incrementing at.
+	// (Default: `1`) The number to start incrementing at.
+	StartNum *float64 `json:"startNum,omitempty" tf:"start_num,omitempty"`
+
+	// (Number) (Default: 1) Step size to increment by.
+	// (Default: `1`) Step size to increment by.
+	StepNum *float64 `json:"stepNum,omitempty" tf:"step_num,omitempty"`
+}
+
+type IdentityObservation struct {
+
+	// (Number) (Default: 1) The number to start incrementing at.
+	// (Default: `1`) The number to start incrementing at.
+	StartNum *float64 `json:"startNum,omitempty" tf:"start_num,omitempty"`
+
+	// (Number) (Default: 1) Step size to increment by.
+	// (Default: `1`) Step size to increment by.
+	StepNum *float64 `json:"stepNum,omitempty" tf:"step_num,omitempty"`
+}
+
+type IdentityParameters struct {
+
+	// (Number) (Default: 1) The number to start incrementing at.
+	// (Default: `1`) The number to start incrementing at.
+	// +kubebuilder:validation:Optional
+	StartNum *float64 `json:"startNum,omitempty" tf:"start_num,omitempty"`
+
+	// (Number) (Default: 1) Step size to increment by.
+	// (Default: `1`) Step size to increment by.
+	// +kubebuilder:validation:Optional
+	StepNum *float64 `json:"stepNum,omitempty" tf:"step_num,omitempty"`
+}
+
+type PrimaryKeyInitParameters struct {
+
+	// (List of String) Columns to use in primary key
+	// Columns to use in primary key
+	Keys []*string `json:"keys,omitempty" tf:"keys,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Name of constraint
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+}
+
+type PrimaryKeyObservation struct {
+
+	// (List of String) Columns to use in primary key
+	// Columns to use in primary key
+	Keys []*string `json:"keys,omitempty" tf:"keys,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Name of constraint
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+}
+
+type PrimaryKeyParameters struct {
+
+	// (List of String) Columns to use in primary key
+	// Columns to use in primary key
+	// +kubebuilder:validation:Optional
+	Keys []*string `json:"keys" tf:"keys,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Name of constraint
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+}
+
+type TableInitParameters struct {
+
+	// (Boolean) (Default: false) Specifies whether to enable change tracking on the table. Default false.
+	// (Default: `false`) Specifies whether to enable change tracking on the table. Default false.
+	ChangeTracking *bool `json:"changeTracking,omitempty" tf:"change_tracking,omitempty"`
+
+	// (List of String) A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	// A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	ClusterBy []*string `json:"clusterBy,omitempty" tf:"cluster_by,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the table. Minimum one required.
+	Column []ColumnInitParameters `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// Specifies a comment for the table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (-1)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`-1`)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	DataRetentionTimeInDays *float64 `json:"dataRetentionTimeInDays,omitempty" tf:"data_retention_time_in_days,omitempty"`
+
+	// (String) The database in which to create the table.
+	// The database in which to create the table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Block List, Max: 1, Deprecated) Definitions of primary key constraint to create on table (see below for nested schema)
+	// Definitions of primary key constraint to create on table
+	PrimaryKey []PrimaryKeyInitParameters `json:"primaryKey,omitempty" tf:"primary_key,omitempty"`
+
+	// (String) The schema in which to create the table.
+	// The schema in which to create the table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []TableTagInitParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type TableObservation struct {
+
+	// (Boolean) (Default: false) Specifies whether to enable change tracking on the table. Default false.
+	// (Default: `false`) Specifies whether to enable change tracking on the table. Default false.
+	ChangeTracking *bool `json:"changeTracking,omitempty" tf:"change_tracking,omitempty"`
+
+	// (List of String) A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	// A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	ClusterBy []*string `json:"clusterBy,omitempty" tf:"cluster_by,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the table. Minimum one required.
+	Column []ColumnObservation `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// Specifies a comment for the table.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (-1)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`-1`)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	DataRetentionTimeInDays *float64 `json:"dataRetentionTimeInDays,omitempty" tf:"data_retention_time_in_days,omitempty"`
+
+	// (String) The database in which to create the table.
+	// The database in which to create the table.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) Name of the role that owns the table.
+	// Name of the role that owns the table.
+	Owner *string `json:"owner,omitempty" tf:"owner,omitempty"`
+
+	// (Block List, Max: 1, Deprecated) Definitions of primary key constraint to create on table (see below for nested schema)
+	// Definitions of primary key constraint to create on table
+	PrimaryKey []PrimaryKeyObservation `json:"primaryKey,omitempty" tf:"primary_key,omitempty"`
+
+	// (String) The schema in which to create the table.
+	// The schema in which to create the table.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	Tag []TableTagObservation `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type TableParameters struct {
+
+	// (Boolean) (Default: false) Specifies whether to enable change tracking on the table. Default false.
+	// (Default: `false`) Specifies whether to enable change tracking on the table. Default false.
+	// +kubebuilder:validation:Optional
+	ChangeTracking *bool `json:"changeTracking,omitempty" tf:"change_tracking,omitempty"`
+
+	// (List of String) A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	// A list of one or more table columns/expressions to be used as clustering key(s) for the table
+	// +kubebuilder:validation:Optional
+	ClusterBy []*string `json:"clusterBy,omitempty" tf:"cluster_by,omitempty"`
+
+	// (Block List, Min: 1) Definitions of a column to create in the table. Minimum one required. (see below for nested schema)
+	// Definitions of a column to create in the table. Minimum one required.
+	// +kubebuilder:validation:Optional
+	Column []ColumnParameters `json:"column,omitempty" tf:"column,omitempty"`
+
+	// (String) Specifies a comment for the table.
+	// Specifies a comment for the table.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// uses special value that cannot be set in the configuration manually (-1)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	// (Default: fallback to Snowflake default - uses special value that cannot be set in the configuration manually (`-1`)) Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. If you wish to inherit the parent schema setting then pass in the schema attribute to this argument or do not fill this parameter at all; the default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value
+	// +kubebuilder:validation:Optional
+	DataRetentionTimeInDays *float64 `json:"dataRetentionTimeInDays,omitempty" tf:"data_retention_time_in_days,omitempty"`
+
+	// (String) The database in which to create the table.
+	// The database in which to create the table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Block List, Max: 1, Deprecated) Definitions of primary key constraint to create on table (see below for nested schema)
+	// Definitions of primary key constraint to create on table
+	// +kubebuilder:validation:Optional
+	PrimaryKey []PrimaryKeyParameters `json:"primaryKey,omitempty" tf:"primary_key,omitempty"`
+
+	// (String) The schema in which to create the table.
+	// The schema in which to create the table.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (Block List, Deprecated) Definitions of a tag to associate with the resource. (see below for nested schema)
+	// Definitions of a tag to associate with the resource.
+	// +kubebuilder:validation:Optional
+	Tag []TableTagParameters `json:"tag,omitempty" tf:"tag,omitempty"`
+}
+
+type TableTagInitParameters struct {
+
+	// (String) The database in which to create the table.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the table.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type TableTagObservation struct {
+
+	// (String) The database in which to create the table.
+	// Name of the database that the tag was created in.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Tag name, e.g. department.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (String) The schema in which to create the table.
+	// Name of the schema that the tag was created in.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	Value *string `json:"value,omitempty" tf:"value,omitempty"`
+}
+
+type TableTagParameters struct {
+
+	// (String) The database in which to create the table.
+	// Name of the database that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (String) Specifies the identifier for the table; must be unique for the database and schema in which the table is created.
+	// Tag name, e.g. department.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// Reference to a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameRef *v1.Reference `json:"nameRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate name.
+	// +kubebuilder:validation:Optional
+	NameSelector *v1.Selector `json:"nameSelector,omitempty" tf:"-"`
+
+	// (String) The schema in which to create the table.
+	// Name of the schema that the tag was created in.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Tag
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Tag in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+
+	// (String) Tag value, e.g. marketing_info.
+	// Tag value, e.g. marketing_info.
+	// +kubebuilder:validation:Optional
+	Value *string `json:"value" tf:"value,omitempty"`
+}
+
+// TableSpec defines the desired state of Table
+type TableSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     TableParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider TableInitParameters `json:"initProvider,omitempty"`
+}
+
+// TableStatus defines the observed state of Table.
+type TableStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        TableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Table is the Schema for the Tables API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Table struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.column) || (has(self.initProvider) && has(self.initProvider.column))",message="spec.forProvider.column is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	Spec   TableSpec   `json:"spec"`
+	Status TableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TableList contains a list of Tables
+type TableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Table `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Table_Kind             = "Table"
+	Table_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Table_Kind}.String()
+	Table_KindAPIVersion   = Table_Kind + "." + CRDGroupVersion.String()
+	Table_GroupVersionKind = CRDGroupVersion.WithKind(Table_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Table{}, &TableList{})
+}
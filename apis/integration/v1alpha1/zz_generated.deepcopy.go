@@ -0,0 +1,1375 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegration) DeepCopyInto(out *ApiIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegration.
+func (in *ApiIntegration) DeepCopy() *ApiIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationInitParameters) DeepCopyInto(out *ApiIntegrationInitParameters) {
+	*out = *in
+	if in.APIAllowedPrefixes != nil {
+		in, out := &in.APIAllowedPrefixes, &out.APIAllowedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIAwsRoleArn != nil {
+		in, out := &in.APIAwsRoleArn, &out.APIAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIBlockedPrefixes != nil {
+		in, out := &in.APIBlockedPrefixes, &out.APIBlockedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIGCPServiceAccount != nil {
+		in, out := &in.APIGCPServiceAccount, &out.APIGCPServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.APIProvider != nil {
+		in, out := &in.APIProvider, &out.APIProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureAdApplicationID != nil {
+		in, out := &in.AzureAdApplicationID, &out.AzureAdApplicationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GoogleAudience != nil {
+		in, out := &in.GoogleAudience, &out.GoogleAudience
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationInitParameters.
+func (in *ApiIntegrationInitParameters) DeepCopy() *ApiIntegrationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationList) DeepCopyInto(out *ApiIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApiIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationList.
+func (in *ApiIntegrationList) DeepCopy() *ApiIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationObservation) DeepCopyInto(out *ApiIntegrationObservation) {
+	*out = *in
+	if in.APIAllowedPrefixes != nil {
+		in, out := &in.APIAllowedPrefixes, &out.APIAllowedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIAwsExternalID != nil {
+		in, out := &in.APIAwsExternalID, &out.APIAwsExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIAwsIAMUserArn != nil {
+		in, out := &in.APIAwsIAMUserArn, &out.APIAwsIAMUserArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIAwsRoleArn != nil {
+		in, out := &in.APIAwsRoleArn, &out.APIAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIBlockedPrefixes != nil {
+		in, out := &in.APIBlockedPrefixes, &out.APIBlockedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIGCPServiceAccount != nil {
+		in, out := &in.APIGCPServiceAccount, &out.APIGCPServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIProvider != nil {
+		in, out := &in.APIProvider, &out.APIProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureAdApplicationID != nil {
+		in, out := &in.AzureAdApplicationID, &out.AzureAdApplicationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureConsentURL != nil {
+		in, out := &in.AzureConsentURL, &out.AzureConsentURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureMultiTenantAppName != nil {
+		in, out := &in.AzureMultiTenantAppName, &out.AzureMultiTenantAppName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GoogleAudience != nil {
+		in, out := &in.GoogleAudience, &out.GoogleAudience
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationObservation.
+func (in *ApiIntegrationObservation) DeepCopy() *ApiIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationParameters) DeepCopyInto(out *ApiIntegrationParameters) {
+	*out = *in
+	if in.APIAllowedPrefixes != nil {
+		in, out := &in.APIAllowedPrefixes, &out.APIAllowedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIAwsRoleArn != nil {
+		in, out := &in.APIAwsRoleArn, &out.APIAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIBlockedPrefixes != nil {
+		in, out := &in.APIBlockedPrefixes, &out.APIBlockedPrefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.APIGCPServiceAccount != nil {
+		in, out := &in.APIGCPServiceAccount, &out.APIGCPServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.APIProvider != nil {
+		in, out := &in.APIProvider, &out.APIProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureAdApplicationID != nil {
+		in, out := &in.AzureAdApplicationID, &out.AzureAdApplicationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GoogleAudience != nil {
+		in, out := &in.GoogleAudience, &out.GoogleAudience
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationParameters.
+func (in *ApiIntegrationParameters) DeepCopy() *ApiIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationSpec) DeepCopyInto(out *ApiIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationSpec.
+func (in *ApiIntegrationSpec) DeepCopy() *ApiIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationStatus) DeepCopyInto(out *ApiIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationStatus.
+func (in *ApiIntegrationStatus) DeepCopy() *ApiIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegration) DeepCopyInto(out *EmailNotificationIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegration.
+func (in *EmailNotificationIntegration) DeepCopy() *EmailNotificationIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EmailNotificationIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationInitParameters) DeepCopyInto(out *EmailNotificationIntegrationInitParameters) {
+	*out = *in
+	if in.AllowedRecipients != nil {
+		in, out := &in.AllowedRecipients, &out.AllowedRecipients
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationInitParameters.
+func (in *EmailNotificationIntegrationInitParameters) DeepCopy() *EmailNotificationIntegrationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationList) DeepCopyInto(out *EmailNotificationIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EmailNotificationIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationList.
+func (in *EmailNotificationIntegrationList) DeepCopy() *EmailNotificationIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EmailNotificationIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationObservation) DeepCopyInto(out *EmailNotificationIntegrationObservation) {
+	*out = *in
+	if in.AllowedRecipients != nil {
+		in, out := &in.AllowedRecipients, &out.AllowedRecipients
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationObservation.
+func (in *EmailNotificationIntegrationObservation) DeepCopy() *EmailNotificationIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationParameters) DeepCopyInto(out *EmailNotificationIntegrationParameters) {
+	*out = *in
+	if in.AllowedRecipients != nil {
+		in, out := &in.AllowedRecipients, &out.AllowedRecipients
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationParameters.
+func (in *EmailNotificationIntegrationParameters) DeepCopy() *EmailNotificationIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationSpec) DeepCopyInto(out *EmailNotificationIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationSpec.
+func (in *EmailNotificationIntegrationSpec) DeepCopy() *EmailNotificationIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotificationIntegrationStatus) DeepCopyInto(out *EmailNotificationIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotificationIntegrationStatus.
+func (in *EmailNotificationIntegrationStatus) DeepCopy() *EmailNotificationIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotificationIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegration) DeepCopyInto(out *NotificationIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegration.
+func (in *NotificationIntegration) DeepCopy() *NotificationIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationInitParameters) DeepCopyInto(out *NotificationIntegrationInitParameters) {
+	*out = *in
+	if in.AwsSnsRoleArn != nil {
+		in, out := &in.AwsSnsRoleArn, &out.AwsSnsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSnsTopicArn != nil {
+		in, out := &in.AwsSnsTopicArn, &out.AwsSnsTopicArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsArn != nil {
+		in, out := &in.AwsSqsArn, &out.AwsSqsArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsRoleArn != nil {
+		in, out := &in.AwsSqsRoleArn, &out.AwsSqsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureStorageQueuePrimaryURI != nil {
+		in, out := &in.AzureStorageQueuePrimaryURI, &out.AzureStorageQueuePrimaryURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCPPubsubSubscriptionName != nil {
+		in, out := &in.GCPPubsubSubscriptionName, &out.GCPPubsubSubscriptionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubTopicName != nil {
+		in, out := &in.GCPPubsubTopicName, &out.GCPPubsubTopicName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotificationProvider != nil {
+		in, out := &in.NotificationProvider, &out.NotificationProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationInitParameters.
+func (in *NotificationIntegrationInitParameters) DeepCopy() *NotificationIntegrationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationList) DeepCopyInto(out *NotificationIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationList.
+func (in *NotificationIntegrationList) DeepCopy() *NotificationIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationObservation) DeepCopyInto(out *NotificationIntegrationObservation) {
+	*out = *in
+	if in.AwsSnsExternalID != nil {
+		in, out := &in.AwsSnsExternalID, &out.AwsSnsExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSnsIAMUserArn != nil {
+		in, out := &in.AwsSnsIAMUserArn, &out.AwsSnsIAMUserArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSnsRoleArn != nil {
+		in, out := &in.AwsSnsRoleArn, &out.AwsSnsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSnsTopicArn != nil {
+		in, out := &in.AwsSnsTopicArn, &out.AwsSnsTopicArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsArn != nil {
+		in, out := &in.AwsSqsArn, &out.AwsSqsArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsExternalID != nil {
+		in, out := &in.AwsSqsExternalID, &out.AwsSqsExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsIAMUserArn != nil {
+		in, out := &in.AwsSqsIAMUserArn, &out.AwsSqsIAMUserArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsRoleArn != nil {
+		in, out := &in.AwsSqsRoleArn, &out.AwsSqsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureStorageQueuePrimaryURI != nil {
+		in, out := &in.AzureStorageQueuePrimaryURI, &out.AzureStorageQueuePrimaryURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubServiceAccount != nil {
+		in, out := &in.GCPPubsubServiceAccount, &out.GCPPubsubServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubSubscriptionName != nil {
+		in, out := &in.GCPPubsubSubscriptionName, &out.GCPPubsubSubscriptionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubTopicName != nil {
+		in, out := &in.GCPPubsubTopicName, &out.GCPPubsubTopicName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotificationProvider != nil {
+		in, out := &in.NotificationProvider, &out.NotificationProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationObservation.
+func (in *NotificationIntegrationObservation) DeepCopy() *NotificationIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationParameters) DeepCopyInto(out *NotificationIntegrationParameters) {
+	*out = *in
+	if in.AwsSnsRoleArn != nil {
+		in, out := &in.AwsSnsRoleArn, &out.AwsSnsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSnsTopicArn != nil {
+		in, out := &in.AwsSnsTopicArn, &out.AwsSnsTopicArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsArn != nil {
+		in, out := &in.AwsSqsArn, &out.AwsSqsArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSqsRoleArn != nil {
+		in, out := &in.AwsSqsRoleArn, &out.AwsSqsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureStorageQueuePrimaryURI != nil {
+		in, out := &in.AzureStorageQueuePrimaryURI, &out.AzureStorageQueuePrimaryURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCPPubsubSubscriptionName != nil {
+		in, out := &in.GCPPubsubSubscriptionName, &out.GCPPubsubSubscriptionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubTopicName != nil {
+		in, out := &in.GCPPubsubTopicName, &out.GCPPubsubTopicName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotificationProvider != nil {
+		in, out := &in.NotificationProvider, &out.NotificationProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationParameters.
+func (in *NotificationIntegrationParameters) DeepCopy() *NotificationIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationSpec) DeepCopyInto(out *NotificationIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationSpec.
+func (in *NotificationIntegrationSpec) DeepCopy() *NotificationIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationStatus) DeepCopyInto(out *NotificationIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationStatus.
+func (in *NotificationIntegrationStatus) DeepCopy() *NotificationIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegration) DeepCopyInto(out *StorageIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegration.
+func (in *StorageIntegration) DeepCopy() *StorageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationInitParameters) DeepCopyInto(out *StorageIntegrationInitParameters) {
+	*out = *in
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAllowedLocations != nil {
+		in, out := &in.StorageAllowedLocations, &out.StorageAllowedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageAwsObjectACL != nil {
+		in, out := &in.StorageAwsObjectACL, &out.StorageAwsObjectACL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBlockedLocations != nil {
+		in, out := &in.StorageBlockedLocations, &out.StorageBlockedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationInitParameters.
+func (in *StorageIntegrationInitParameters) DeepCopy() *StorageIntegrationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationList) DeepCopyInto(out *StorageIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationList.
+func (in *StorageIntegrationList) DeepCopy() *StorageIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationObservation) DeepCopyInto(out *StorageIntegrationObservation) {
+	*out = *in
+	if in.AzureMultiTenantAppName != nil {
+		in, out := &in.AzureMultiTenantAppName, &out.AzureMultiTenantAppName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAllowedLocations != nil {
+		in, out := &in.StorageAllowedLocations, &out.StorageAllowedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageAwsExternalID != nil {
+		in, out := &in.StorageAwsExternalID, &out.StorageAwsExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsIAMUserArn != nil {
+		in, out := &in.StorageAwsIAMUserArn, &out.StorageAwsIAMUserArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsObjectACL != nil {
+		in, out := &in.StorageAwsObjectACL, &out.StorageAwsObjectACL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBlockedLocations != nil {
+		in, out := &in.StorageBlockedLocations, &out.StorageBlockedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageGCPServiceAccount != nil {
+		in, out := &in.StorageGCPServiceAccount, &out.StorageGCPServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationObservation.
+func (in *StorageIntegrationObservation) DeepCopy() *StorageIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationParameters) DeepCopyInto(out *StorageIntegrationParameters) {
+	*out = *in
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAllowedLocations != nil {
+		in, out := &in.StorageAllowedLocations, &out.StorageAllowedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageAwsObjectACL != nil {
+		in, out := &in.StorageAwsObjectACL, &out.StorageAwsObjectACL
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAwsRoleArn != nil {
+		in, out := &in.StorageAwsRoleArn, &out.StorageAwsRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageBlockedLocations != nil {
+		in, out := &in.StorageBlockedLocations, &out.StorageBlockedLocations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StorageProvider != nil {
+		in, out := &in.StorageProvider, &out.StorageProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationParameters.
+func (in *StorageIntegrationParameters) DeepCopy() *StorageIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationSpec) DeepCopyInto(out *StorageIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationSpec.
+func (in *StorageIntegrationSpec) DeepCopy() *StorageIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationStatus) DeepCopyInto(out *StorageIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationStatus.
+func (in *StorageIntegrationStatus) DeepCopy() *StorageIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type StorageIntegrationInitParameters struct {
+
+	// (String) (Default: “)
+	// (Default: “)
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: true)
+	// (Default: `true`)
+	Enabled *bool `json:"enabled,omitempty" tf:"enabled,omitempty"`
+
+	// (String)
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (List of String) Explicitly limits external stages that use the integration to reference one or more storage locations.
+	// Explicitly limits external stages that use the integration to reference one or more storage locations.
+	StorageAllowedLocations []*string `json:"storageAllowedLocations,omitempty" tf:"storage_allowed_locations,omitempty"`
+
+	// owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	// "bucket-owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	StorageAwsObjectACL *string `json:"storageAwsObjectAcl,omitempty" tf:"storage_aws_object_acl,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// (List of String) Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	// Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	StorageBlockedLocations []*string `json:"storageBlockedLocations,omitempty" tf:"storage_blocked_locations,omitempty"`
+
+	// (String) Specifies the storage provider for the integration. Valid options are: S3 | S3GOV | S3CHINA | GCS | AZURE
+	// Specifies the storage provider for the integration. Valid options are: `S3` | `S3GOV` | `S3CHINA` | `GCS` | `AZURE`
+	StorageProvider *string `json:"storageProvider,omitempty" tf:"storage_provider,omitempty"`
+
+	// (String) (Default: EXTERNAL_STAGE)
+	// (Default: `EXTERNAL_STAGE`)
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type StorageIntegrationObservation struct {
+
+	// (String) This is the name of the Snowflake client application created for your account.
+	// This is the name of the Snowflake client application created for your account.
+	AzureMultiTenantAppName *string `json:"azureMultiTenantAppName,omitempty" tf:"azure_multi_tenant_app_name,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (String) Date and time when the storage integration was created.
+	// Date and time when the storage integration was created.
+	CreatedOn *string `json:"createdOn,omitempty" tf:"created_on,omitempty"`
+
+	// (Boolean) (Default: true)
+	// (Default: `true`)
+	Enabled *bool `json:"enabled,omitempty" tf:"enabled,omitempty"`
+
+	// (String) Fully qualified name of the resource. For more information, see object name resolution.
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String)
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (List of String) Explicitly limits external stages that use the integration to reference one or more storage locations.
+	// Explicitly limits external stages that use the integration to reference one or more storage locations.
+	StorageAllowedLocations []*string `json:"storageAllowedLocations,omitempty" tf:"storage_allowed_locations,omitempty"`
+
+	// (String) The external ID that Snowflake will use when assuming the AWS role.
+	// The external ID that Snowflake will use when assuming the AWS role.
+	StorageAwsExternalID *string `json:"storageAwsExternalId,omitempty" tf:"storage_aws_external_id,omitempty"`
+
+	// (String) The Snowflake user that will attempt to assume the AWS role.
+	// The Snowflake user that will attempt to assume the AWS role.
+	StorageAwsIAMUserArn *string `json:"storageAwsIamUserArn,omitempty" tf:"storage_aws_iam_user_arn,omitempty"`
+
+	// owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	// "bucket-owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	StorageAwsObjectACL *string `json:"storageAwsObjectAcl,omitempty" tf:"storage_aws_object_acl,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// (List of String) Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	// Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	StorageBlockedLocations []*string `json:"storageBlockedLocations,omitempty" tf:"storage_blocked_locations,omitempty"`
+
+	// (String) This is the name of the Snowflake Google Service Account created for your account.
+	// This is the name of the Snowflake Google Service Account created for your account.
+	StorageGCPServiceAccount *string `json:"storageGcpServiceAccount,omitempty" tf:"storage_gcp_service_account,omitempty"`
+
+	// (String) Specifies the storage provider for the integration. Valid options are: S3 | S3GOV | S3CHINA | GCS | AZURE
+	// Specifies the storage provider for the integration. Valid options are: `S3` | `S3GOV` | `S3CHINA` | `GCS` | `AZURE`
+	StorageProvider *string `json:"storageProvider,omitempty" tf:"storage_provider,omitempty"`
+
+	// (String) (Default: EXTERNAL_STAGE)
+	// (Default: `EXTERNAL_STAGE`)
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type StorageIntegrationParameters struct {
+
+	// (String) (Default: “)
+	// (Default: “)
+	// +kubebuilder:validation:Optional
+	AzureTenantID *string `json:"azureTenantId,omitempty" tf:"azure_tenant_id,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// (Boolean) (Default: true)
+	// (Default: `true`)
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty" tf:"enabled,omitempty"`
+
+	// (String)
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (List of String) Explicitly limits external stages that use the integration to reference one or more storage locations.
+	// Explicitly limits external stages that use the integration to reference one or more storage locations.
+	// +kubebuilder:validation:Optional
+	StorageAllowedLocations []*string `json:"storageAllowedLocations,omitempty" tf:"storage_allowed_locations,omitempty"`
+
+	// owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	// "bucket-owner-full-control" Enables support for AWS access control lists (ACLs) to grant the bucket owner full control.
+	// +kubebuilder:validation:Optional
+	StorageAwsObjectACL *string `json:"storageAwsObjectAcl,omitempty" tf:"storage_aws_object_acl,omitempty"`
+
+	// (String) (Default: “)
+	// (Default: “)
+	// +kubebuilder:validation:Optional
+	StorageAwsRoleArn *string `json:"storageAwsRoleArn,omitempty" tf:"storage_aws_role_arn,omitempty"`
+
+	// (List of String) Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	// Explicitly prohibits external stages that use the integration from referencing one or more storage locations.
+	// +kubebuilder:validation:Optional
+	StorageBlockedLocations []*string `json:"storageBlockedLocations,omitempty" tf:"storage_blocked_locations,omitempty"`
+
+	// (String) Specifies the storage provider for the integration. Valid options are: S3 | S3GOV | S3CHINA | GCS | AZURE
+	// Specifies the storage provider for the integration. Valid options are: `S3` | `S3GOV` | `S3CHINA` | `GCS` | `AZURE`
+	// +kubebuilder:validation:Optional
+	StorageProvider *string `json:"storageProvider,omitempty" tf:"storage_provider,omitempty"`
+
+	// (String) (Default: EXTERNAL_STAGE)
+	// (Default: `EXTERNAL_STAGE`)
+	// +kubebuilder:validation:Optional
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+// StorageIntegrationSpec defines the desired state of StorageIntegration
+type StorageIntegrationSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     StorageIntegrationParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider StorageIntegrationInitParameters `json:"initProvider,omitempty"`
+}
+
+// StorageIntegrationStatus defines the observed state of StorageIntegration.
+type StorageIntegrationStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        StorageIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// StorageIntegration is the Schema for the StorageIntegrations API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type StorageIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.storageAllowedLocations) || (has(self.initProvider) && has(self.initProvider.storageAllowedLocations))",message="spec.forProvider.storageAllowedLocations is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.storageProvider) || (has(self.initProvider) && has(self.initProvider.storageProvider))",message="spec.forProvider.storageProvider is a required parameter"
+	Spec   StorageIntegrationSpec   `json:"spec"`
+	Status StorageIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageIntegrationList contains a list of StorageIntegrations
+type StorageIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageIntegration `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	StorageIntegration_Kind             = "StorageIntegration"
+	StorageIntegration_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: StorageIntegration_Kind}.String()
+	StorageIntegration_KindAPIVersion   = StorageIntegration_Kind + "." + CRDGroupVersion.String()
+	StorageIntegration_GroupVersionKind = CRDGroupVersion.WithKind(StorageIntegration_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StorageIntegration{}, &StorageIntegrationList{})
+}
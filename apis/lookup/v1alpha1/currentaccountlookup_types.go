@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CurrentAccountLookupParameters are empty; the account is the one the
+// referenced ProviderConfig connects to.
+type CurrentAccountLookupParameters struct{}
+
+// CurrentAccountLookupObservation describes the current account, like the
+// snowflake_current_account data source.
+type CurrentAccountLookupObservation struct {
+	Account          string `json:"account,omitempty"`
+	AccountName      string `json:"accountName,omitempty"`
+	OrganizationName string `json:"organizationName,omitempty"`
+	Region           string `json:"region,omitempty"`
+	URL              string `json:"url,omitempty"`
+}
+
+// CurrentAccountLookupSpec defines the desired state of CurrentAccountLookup
+type CurrentAccountLookupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     CurrentAccountLookupParameters `json:"forProvider,omitempty"`
+}
+
+// CurrentAccountLookupStatus defines the observed state of CurrentAccountLookup.
+type CurrentAccountLookupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        CurrentAccountLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// CurrentAccountLookup periodically describes the account the provider is
+// connected to, like the snowflake_current_account data source. It never
+// modifies Snowflake.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.account"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type CurrentAccountLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CurrentAccountLookupSpec   `json:"spec"`
+	Status            CurrentAccountLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CurrentAccountLookupList contains a list of CurrentAccountLookups
+type CurrentAccountLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CurrentAccountLookup `json:"items"`
+}
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DatabaseLookupParameters filter the databases that are looked up. They
+// mirror the arguments of the snowflake_databases data source.
+type DatabaseLookupParameters struct {
+
+	// Filters the output with a case-insensitive pattern, with support for SQL
+	// wildcard characters (% and _).
+	// +kubebuilder:validation:Optional
+	Like *string `json:"like,omitempty"`
+
+	// Filters the output with a case-sensitive prefix.
+	// +kubebuilder:validation:Optional
+	StartsWith *string `json:"startsWith,omitempty"`
+
+	// Limits the maximum number of rows returned.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// DatabaseObservation is a database returned by SHOW DATABASES.
+type DatabaseObservation struct {
+	Name          string `json:"name"`
+	Kind          string `json:"kind,omitempty"`
+	Owner         string `json:"owner,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	Origin        string `json:"origin,omitempty"`
+	RetentionTime string `json:"retentionTime,omitempty"`
+	CreatedOn     string `json:"createdOn,omitempty"`
+}
+
+// DatabaseLookupObservation holds the databases found by the lookup.
+type DatabaseLookupObservation struct {
+	Databases []DatabaseObservation `json:"databases,omitempty"`
+}
+
+// DatabaseLookupSpec defines the desired state of DatabaseLookup
+type DatabaseLookupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DatabaseLookupParameters `json:"forProvider,omitempty"`
+}
+
+// DatabaseLookupStatus defines the observed state of DatabaseLookup.
+type DatabaseLookupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DatabaseLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DatabaseLookup periodically lists existing databases, like the
+// snowflake_databases data source. It never modifies Snowflake.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type DatabaseLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DatabaseLookupSpec   `json:"spec"`
+	Status            DatabaseLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseLookupList contains a list of DatabaseLookups
+type DatabaseLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatabaseLookup `json:"items"`
+}
@@ -0,0 +1,8 @@
+// Package v1alpha1 contains the observe-only lookup resources of the
+// snowflake provider. Lookups periodically query existing Snowflake objects
+// and report them in their status. They never create, update or delete
+// anything in Snowflake.
+// +kubebuilder:object:generate=true
+// +groupName=lookup.snowflake.com
+// +versionName=v1alpha1
+package v1alpha1
@@ -0,0 +1,140 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GrantsOnParameters lists the privileges granted on an object.
+// +kubebuilder:validation:XValidation:rule="has(self.account) != has(self.objectName)",message="exactly one of account or objectName must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.objectName) == has(self.objectType)",message="objectName and objectType must be set together"
+type GrantsOnParameters struct {
+
+	// Lists the privileges granted on the account.
+	// +kubebuilder:validation:Optional
+	Account *bool `json:"account,omitempty"`
+
+	// Type of the object, such as DATABASE, WAREHOUSE or EXTERNAL TABLE.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z ]+$`
+	ObjectType *string `json:"objectType,omitempty"`
+
+	// Fully qualified identifier of the object, e.g. MYDB.MYSCHEMA.MYTABLE.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)(\.("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)){0,2}$`
+	ObjectName *string `json:"objectName,omitempty"`
+}
+
+// GrantsToParameters lists the privileges and roles granted to a grantee.
+// +kubebuilder:validation:XValidation:rule="[has(self.accountRole), has(self.databaseRole), has(self.user)].filter(x, x).size() == 1",message="exactly one of accountRole, databaseRole or user must be set"
+type GrantsToParameters struct {
+
+	// Name of the account role.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)$`
+	AccountRole *string `json:"accountRole,omitempty"`
+
+	// Fully qualified name of the database role, e.g. MYDB.MYROLE.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)\.("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)$`
+	DatabaseRole *string `json:"databaseRole,omitempty"`
+
+	// Name of the user.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)$`
+	User *string `json:"user,omitempty"`
+}
+
+// GrantsOfParameters lists the grantees a role is granted to.
+// +kubebuilder:validation:XValidation:rule="has(self.accountRole) != has(self.databaseRole)",message="exactly one of accountRole or databaseRole must be set"
+type GrantsOfParameters struct {
+
+	// Name of the account role.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)$`
+	AccountRole *string `json:"accountRole,omitempty"`
+
+	// Fully qualified name of the database role, e.g. MYDB.MYROLE.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)\.("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)$`
+	DatabaseRole *string `json:"databaseRole,omitempty"`
+}
+
+// GrantLookupParameters select the grants that are looked up. They mirror the
+// arguments of the snowflake_grants data source. Without any of them the
+// roles granted to the provider's user are listed.
+// +kubebuilder:validation:XValidation:rule="[has(self.grantsOn), has(self.grantsTo), has(self.grantsOf)].filter(x, x).size() <= 1",message="only one of grantsOn, grantsTo or grantsOf can be set"
+type GrantLookupParameters struct {
+
+	// Lists all privileges granted on the object.
+	// +kubebuilder:validation:Optional
+	GrantsOn *GrantsOnParameters `json:"grantsOn,omitempty"`
+
+	// Lists all privileges and roles granted to the grantee.
+	// +kubebuilder:validation:Optional
+	GrantsTo *GrantsToParameters `json:"grantsTo,omitempty"`
+
+	// Lists all users and roles to which the role has been granted.
+	// +kubebuilder:validation:Optional
+	GrantsOf *GrantsOfParameters `json:"grantsOf,omitempty"`
+}
+
+// GrantObservation is a grant returned by SHOW GRANTS.
+type GrantObservation struct {
+	CreatedOn string `json:"createdOn,omitempty"`
+	Privilege string `json:"privilege,omitempty"`
+	GrantedOn string `json:"grantedOn,omitempty"`
+	Name      string `json:"name,omitempty"`
+
+	// The role that was granted. Only returned for grantsOf, which has no
+	// privilege or grantedOn.
+	Role string `json:"role,omitempty"`
+
+	GrantedTo   string `json:"grantedTo,omitempty"`
+	GranteeName string `json:"granteeName,omitempty"`
+	GrantOption string `json:"grantOption,omitempty"`
+	GrantedBy   string `json:"grantedBy,omitempty"`
+}
+
+// GrantLookupObservation holds the grants found by the lookup.
+type GrantLookupObservation struct {
+	Grants []GrantObservation `json:"grants,omitempty"`
+}
+
+// GrantLookupSpec defines the desired state of GrantLookup
+type GrantLookupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     GrantLookupParameters `json:"forProvider,omitempty"`
+}
+
+// GrantLookupStatus defines the observed state of GrantLookup.
+type GrantLookupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        GrantLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GrantLookup periodically lists existing grants, like the snowflake_grants
+// data source. It never modifies Snowflake.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type GrantLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GrantLookupSpec   `json:"spec"`
+	Status            GrantLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrantLookupList contains a list of GrantLookups
+type GrantLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GrantLookup `json:"items"`
+}
@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "lookup.snowflake.com"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// DatabaseLookup type metadata.
+var (
+	DatabaseLookupKind             = reflect.TypeOf(DatabaseLookup{}).Name()
+	DatabaseLookupGroupKind        = schema.GroupKind{Group: Group, Kind: DatabaseLookupKind}.String()
+	DatabaseLookupKindAPIVersion   = DatabaseLookupKind + "." + SchemeGroupVersion.String()
+	DatabaseLookupGroupVersionKind = SchemeGroupVersion.WithKind(DatabaseLookupKind)
+)
+
+// WarehouseLookup type metadata.
+var (
+	WarehouseLookupKind             = reflect.TypeOf(WarehouseLookup{}).Name()
+	WarehouseLookupGroupKind        = schema.GroupKind{Group: Group, Kind: WarehouseLookupKind}.String()
+	WarehouseLookupKindAPIVersion   = WarehouseLookupKind + "." + SchemeGroupVersion.String()
+	WarehouseLookupGroupVersionKind = SchemeGroupVersion.WithKind(WarehouseLookupKind)
+)
+
+// UserLookup type metadata.
+var (
+	UserLookupKind             = reflect.TypeOf(UserLookup{}).Name()
+	UserLookupGroupKind        = schema.GroupKind{Group: Group, Kind: UserLookupKind}.String()
+	UserLookupKindAPIVersion   = UserLookupKind + "." + SchemeGroupVersion.String()
+	UserLookupGroupVersionKind = SchemeGroupVersion.WithKind(UserLookupKind)
+)
+
+// GrantLookup type metadata.
+var (
+	GrantLookupKind             = reflect.TypeOf(GrantLookup{}).Name()
+	GrantLookupGroupKind        = schema.GroupKind{Group: Group, Kind: GrantLookupKind}.String()
+	GrantLookupKindAPIVersion   = GrantLookupKind + "." + SchemeGroupVersion.String()
+	GrantLookupGroupVersionKind = SchemeGroupVersion.WithKind(GrantLookupKind)
+)
+
+// CurrentAccountLookup type metadata.
+var (
+	CurrentAccountLookupKind             = reflect.TypeOf(CurrentAccountLookup{}).Name()
+	CurrentAccountLookupGroupKind        = schema.GroupKind{Group: Group, Kind: CurrentAccountLookupKind}.String()
+	CurrentAccountLookupKindAPIVersion   = CurrentAccountLookupKind + "." + SchemeGroupVersion.String()
+	CurrentAccountLookupGroupVersionKind = SchemeGroupVersion.WithKind(CurrentAccountLookupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DatabaseLookup{}, &DatabaseLookupList{})
+	SchemeBuilder.Register(&WarehouseLookup{}, &WarehouseLookupList{})
+	SchemeBuilder.Register(&UserLookup{}, &UserLookupList{})
+	SchemeBuilder.Register(&GrantLookup{}, &GrantLookupList{})
+	SchemeBuilder.Register(&CurrentAccountLookup{}, &CurrentAccountLookupList{})
+}
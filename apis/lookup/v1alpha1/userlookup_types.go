@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserLookupParameters filter the users that are looked up. They mirror the
+// arguments of the snowflake_users data source.
+type UserLookupParameters struct {
+
+	// Filters the output with a case-insensitive pattern, with support for SQL
+	// wildcard characters (% and _).
+	// +kubebuilder:validation:Optional
+	Like *string `json:"like,omitempty"`
+
+	// Filters the output with a case-sensitive prefix.
+	// +kubebuilder:validation:Optional
+	StartsWith *string `json:"startsWith,omitempty"`
+
+	// Limits the maximum number of rows returned.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// UserObservation is a user returned by SHOW USERS.
+type UserObservation struct {
+	Name             string `json:"name"`
+	LoginName        string `json:"loginName,omitempty"`
+	DisplayName      string `json:"displayName,omitempty"`
+	Type             string `json:"type,omitempty"`
+	Disabled         string `json:"disabled,omitempty"`
+	DefaultRole      string `json:"defaultRole,omitempty"`
+	DefaultWarehouse string `json:"defaultWarehouse,omitempty"`
+	Owner            string `json:"owner,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	CreatedOn        string `json:"createdOn,omitempty"`
+}
+
+// UserLookupObservation holds the users found by the lookup.
+type UserLookupObservation struct {
+	Users []UserObservation `json:"users,omitempty"`
+}
+
+// UserLookupSpec defines the desired state of UserLookup
+type UserLookupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     UserLookupParameters `json:"forProvider,omitempty"`
+}
+
+// UserLookupStatus defines the observed state of UserLookup.
+type UserLookupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        UserLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// UserLookup periodically lists existing users, like the snowflake_users data
+// source. It never modifies Snowflake.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type UserLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              UserLookupSpec   `json:"spec"`
+	Status            UserLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserLookupList contains a list of UserLookups
+type UserLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserLookup `json:"items"`
+}
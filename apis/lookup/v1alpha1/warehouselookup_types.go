@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WarehouseLookupParameters filter the warehouses that are looked up. They
+// mirror the arguments of the snowflake_warehouses data source.
+type WarehouseLookupParameters struct {
+
+	// Filters the output with a case-insensitive pattern, with support for SQL
+	// wildcard characters (% and _).
+	// +kubebuilder:validation:Optional
+	Like *string `json:"like,omitempty"`
+}
+
+// WarehouseObservation is a warehouse returned by SHOW WAREHOUSES.
+type WarehouseObservation struct {
+	Name        string `json:"name"`
+	State       string `json:"state,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Size        string `json:"size,omitempty"`
+	AutoSuspend string `json:"autoSuspend,omitempty"`
+	AutoResume  string `json:"autoResume,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	CreatedOn   string `json:"createdOn,omitempty"`
+}
+
+// WarehouseLookupObservation holds the warehouses found by the lookup.
+type WarehouseLookupObservation struct {
+	Warehouses []WarehouseObservation `json:"warehouses,omitempty"`
+}
+
+// WarehouseLookupSpec defines the desired state of WarehouseLookup
+type WarehouseLookupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     WarehouseLookupParameters `json:"forProvider,omitempty"`
+}
+
+// WarehouseLookupStatus defines the observed state of WarehouseLookup.
+type WarehouseLookupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        WarehouseLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// WarehouseLookup periodically lists existing warehouses, like the
+// snowflake_warehouses data source. It never modifies Snowflake.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type WarehouseLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WarehouseLookupSpec   `json:"spec"`
+	Status            WarehouseLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WarehouseLookupList contains a list of WarehouseLookups
+type WarehouseLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WarehouseLookup `json:"items"`
+}
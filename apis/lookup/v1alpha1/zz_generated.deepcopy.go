@@ -0,0 +1,843 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookup) DeepCopyInto(out *CurrentAccountLookup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookup.
+func (in *CurrentAccountLookup) DeepCopy() *CurrentAccountLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CurrentAccountLookup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookupList) DeepCopyInto(out *CurrentAccountLookupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CurrentAccountLookup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookupList.
+func (in *CurrentAccountLookupList) DeepCopy() *CurrentAccountLookupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CurrentAccountLookupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookupObservation) DeepCopyInto(out *CurrentAccountLookupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookupObservation.
+func (in *CurrentAccountLookupObservation) DeepCopy() *CurrentAccountLookupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookupParameters) DeepCopyInto(out *CurrentAccountLookupParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookupParameters.
+func (in *CurrentAccountLookupParameters) DeepCopy() *CurrentAccountLookupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookupSpec) DeepCopyInto(out *CurrentAccountLookupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookupSpec.
+func (in *CurrentAccountLookupSpec) DeepCopy() *CurrentAccountLookupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CurrentAccountLookupStatus) DeepCopyInto(out *CurrentAccountLookupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CurrentAccountLookupStatus.
+func (in *CurrentAccountLookupStatus) DeepCopy() *CurrentAccountLookupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CurrentAccountLookupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookup) DeepCopyInto(out *DatabaseLookup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookup.
+func (in *DatabaseLookup) DeepCopy() *DatabaseLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseLookup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookupList) DeepCopyInto(out *DatabaseLookupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatabaseLookup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookupList.
+func (in *DatabaseLookupList) DeepCopy() *DatabaseLookupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseLookupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookupObservation) DeepCopyInto(out *DatabaseLookupObservation) {
+	*out = *in
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]DatabaseObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookupObservation.
+func (in *DatabaseLookupObservation) DeepCopy() *DatabaseLookupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookupParameters) DeepCopyInto(out *DatabaseLookupParameters) {
+	*out = *in
+	if in.Like != nil {
+		in, out := &in.Like, &out.Like
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartsWith != nil {
+		in, out := &in.StartsWith, &out.StartsWith
+		*out = new(string)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookupParameters.
+func (in *DatabaseLookupParameters) DeepCopy() *DatabaseLookupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookupSpec) DeepCopyInto(out *DatabaseLookupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookupSpec.
+func (in *DatabaseLookupSpec) DeepCopy() *DatabaseLookupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseLookupStatus) DeepCopyInto(out *DatabaseLookupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseLookupStatus.
+func (in *DatabaseLookupStatus) DeepCopy() *DatabaseLookupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseLookupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseObservation) DeepCopyInto(out *DatabaseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseObservation.
+func (in *DatabaseObservation) DeepCopy() *DatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookup) DeepCopyInto(out *GrantLookup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookup.
+func (in *GrantLookup) DeepCopy() *GrantLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrantLookup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookupList) DeepCopyInto(out *GrantLookupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GrantLookup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookupList.
+func (in *GrantLookupList) DeepCopy() *GrantLookupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrantLookupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookupObservation) DeepCopyInto(out *GrantLookupObservation) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]GrantObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookupObservation.
+func (in *GrantLookupObservation) DeepCopy() *GrantLookupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookupParameters) DeepCopyInto(out *GrantLookupParameters) {
+	*out = *in
+	if in.GrantsOn != nil {
+		in, out := &in.GrantsOn, &out.GrantsOn
+		*out = new(GrantsOnParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrantsTo != nil {
+		in, out := &in.GrantsTo, &out.GrantsTo
+		*out = new(GrantsToParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrantsOf != nil {
+		in, out := &in.GrantsOf, &out.GrantsOf
+		*out = new(GrantsOfParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookupParameters.
+func (in *GrantLookupParameters) DeepCopy() *GrantLookupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookupSpec) DeepCopyInto(out *GrantLookupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookupSpec.
+func (in *GrantLookupSpec) DeepCopy() *GrantLookupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantLookupStatus) DeepCopyInto(out *GrantLookupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantLookupStatus.
+func (in *GrantLookupStatus) DeepCopy() *GrantLookupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantLookupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantObservation) DeepCopyInto(out *GrantObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantObservation.
+func (in *GrantObservation) DeepCopy() *GrantObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantsOfParameters) DeepCopyInto(out *GrantsOfParameters) {
+	*out = *in
+	if in.AccountRole != nil {
+		in, out := &in.AccountRole, &out.AccountRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRole != nil {
+		in, out := &in.DatabaseRole, &out.DatabaseRole
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantsOfParameters.
+func (in *GrantsOfParameters) DeepCopy() *GrantsOfParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantsOfParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantsOnParameters) DeepCopyInto(out *GrantsOnParameters) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ObjectType != nil {
+		in, out := &in.ObjectType, &out.ObjectType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ObjectName != nil {
+		in, out := &in.ObjectName, &out.ObjectName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantsOnParameters.
+func (in *GrantsOnParameters) DeepCopy() *GrantsOnParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantsOnParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantsToParameters) DeepCopyInto(out *GrantsToParameters) {
+	*out = *in
+	if in.AccountRole != nil {
+		in, out := &in.AccountRole, &out.AccountRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRole != nil {
+		in, out := &in.DatabaseRole, &out.DatabaseRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantsToParameters.
+func (in *GrantsToParameters) DeepCopy() *GrantsToParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantsToParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookup) DeepCopyInto(out *UserLookup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookup.
+func (in *UserLookup) DeepCopy() *UserLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserLookup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookupList) DeepCopyInto(out *UserLookupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserLookup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookupList.
+func (in *UserLookupList) DeepCopy() *UserLookupList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserLookupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookupObservation) DeepCopyInto(out *UserLookupObservation) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookupObservation.
+func (in *UserLookupObservation) DeepCopy() *UserLookupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookupParameters) DeepCopyInto(out *UserLookupParameters) {
+	*out = *in
+	if in.Like != nil {
+		in, out := &in.Like, &out.Like
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartsWith != nil {
+		in, out := &in.StartsWith, &out.StartsWith
+		*out = new(string)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookupParameters.
+func (in *UserLookupParameters) DeepCopy() *UserLookupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookupSpec) DeepCopyInto(out *UserLookupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookupSpec.
+func (in *UserLookupSpec) DeepCopy() *UserLookupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLookupStatus) DeepCopyInto(out *UserLookupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLookupStatus.
+func (in *UserLookupStatus) DeepCopy() *UserLookupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLookupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookup) DeepCopyInto(out *WarehouseLookup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookup.
+func (in *WarehouseLookup) DeepCopy() *WarehouseLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WarehouseLookup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookupList) DeepCopyInto(out *WarehouseLookupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WarehouseLookup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookupList.
+func (in *WarehouseLookupList) DeepCopy() *WarehouseLookupList {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WarehouseLookupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookupObservation) DeepCopyInto(out *WarehouseLookupObservation) {
+	*out = *in
+	if in.Warehouses != nil {
+		in, out := &in.Warehouses, &out.Warehouses
+		*out = make([]WarehouseObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookupObservation.
+func (in *WarehouseLookupObservation) DeepCopy() *WarehouseLookupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookupParameters) DeepCopyInto(out *WarehouseLookupParameters) {
+	*out = *in
+	if in.Like != nil {
+		in, out := &in.Like, &out.Like
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookupParameters.
+func (in *WarehouseLookupParameters) DeepCopy() *WarehouseLookupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookupSpec) DeepCopyInto(out *WarehouseLookupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookupSpec.
+func (in *WarehouseLookupSpec) DeepCopy() *WarehouseLookupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseLookupStatus) DeepCopyInto(out *WarehouseLookupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseLookupStatus.
+func (in *WarehouseLookupStatus) DeepCopy() *WarehouseLookupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseLookupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseObservation) DeepCopyInto(out *WarehouseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseObservation.
+func (in *WarehouseObservation) DeepCopy() *WarehouseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	v1alpha11 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this NetworkPolicy.
+func (mg *NetworkPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.AllowedNetworkRuleList),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.ForProvider.AllowedNetworkRuleListRefs,
+		Selector:      mg.Spec.ForProvider.AllowedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AllowedNetworkRuleList")
+	}
+	mg.Spec.ForProvider.AllowedNetworkRuleList = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.AllowedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.BlockedNetworkRuleList),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.ForProvider.BlockedNetworkRuleListRefs,
+		Selector:      mg.Spec.ForProvider.BlockedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.BlockedNetworkRuleList")
+	}
+	mg.Spec.ForProvider.BlockedNetworkRuleList = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.BlockedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.InitProvider.AllowedNetworkRuleList),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.InitProvider.AllowedNetworkRuleListRefs,
+		Selector:      mg.Spec.InitProvider.AllowedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.AllowedNetworkRuleList")
+	}
+	mg.Spec.InitProvider.AllowedNetworkRuleList = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.InitProvider.AllowedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.InitProvider.BlockedNetworkRuleList),
+		Extract:       resource.ExtractParamPath("fully_qualified_name", true),
+		References:    mg.Spec.InitProvider.BlockedNetworkRuleListRefs,
+		Selector:      mg.Spec.InitProvider.BlockedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.BlockedNetworkRuleList")
+	}
+	mg.Spec.InitProvider.BlockedNetworkRuleList = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.InitProvider.BlockedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this NetworkPolicyAttachment.
+func (mg *NetworkPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkPolicyName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.NetworkPolicyNameRef,
+		Selector:     mg.Spec.ForProvider.NetworkPolicyNameSelector,
+		To: reference.To{
+			List:    &NetworkPolicyList{},
+			Managed: &NetworkPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.NetworkPolicyName")
+	}
+	mg.Spec.ForProvider.NetworkPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkPolicyNameRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.Users),
+		Extract:       resource.ExtractParamPath("name", false),
+		References:    mg.Spec.ForProvider.UsersRefs,
+		Selector:      mg.Spec.ForProvider.UsersSelector,
+		To: reference.To{
+			List:    &v1alpha1.UserList{},
+			Managed: &v1alpha1.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Users")
+	}
+	mg.Spec.ForProvider.Users = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.UsersRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.NetworkPolicyName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.NetworkPolicyNameRef,
+		Selector:     mg.Spec.InitProvider.NetworkPolicyNameSelector,
+		To: reference.To{
+			List:    &NetworkPolicyList{},
+			Managed: &NetworkPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.NetworkPolicyName")
+	}
+	mg.Spec.InitProvider.NetworkPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.NetworkPolicyNameRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.InitProvider.Users),
+		Extract:       resource.ExtractParamPath("name", false),
+		References:    mg.Spec.InitProvider.UsersRefs,
+		Selector:      mg.Spec.InitProvider.UsersSelector,
+		To: reference.To{
+			List:    &v1alpha1.UserList{},
+			Managed: &v1alpha1.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Users")
+	}
+	mg.Spec.InitProvider.Users = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.InitProvider.UsersRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this NetworkRule.
+func (mg *NetworkRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha11.DatabaseList{},
+			Managed: &v1alpha11.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha11.SchemaList{},
+			Managed: &v1alpha11.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha11.DatabaseList{},
+			Managed: &v1alpha11.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha11.SchemaList{},
+			Managed: &v1alpha11.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package apis
+
+import (
+	lookupv1alpha1 "github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+func init() {
+	// Register the hand-written API groups, which are not known to the
+	// Upjet generator that produces zz_register.go.
+	AddToSchemes = append(AddToSchemes,
+		lookupv1alpha1.SchemeBuilder.AddToScheme,
+	)
+}
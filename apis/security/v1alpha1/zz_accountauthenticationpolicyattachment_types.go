@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type AccountAuthenticationPolicyAttachmentInitParameters struct {
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the authentication policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the authentication policy to apply to the current account.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/security/v1alpha1.AuthenticationPolicy
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	AuthenticationPolicy *string `json:"authenticationPolicy,omitempty" tf:"authentication_policy,omitempty"`
+
+	// Reference to a AuthenticationPolicy in security to populate authenticationPolicy.
+	// +kubebuilder:validation:Optional
+	AuthenticationPolicyRef *v1.Reference `json:"authenticationPolicyRef,omitempty" tf:"-"`
+
+	// Selector for a AuthenticationPolicy in security to populate authenticationPolicy.
+	// +kubebuilder:validation:Optional
+	AuthenticationPolicySelector *v1.Selector `json:"authenticationPolicySelector,omitempty" tf:"-"`
+}
+
+type AccountAuthenticationPolicyAttachmentObservation struct {
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the authentication policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the authentication policy to apply to the current account.
+	AuthenticationPolicy *string `json:"authenticationPolicy,omitempty" tf:"authentication_policy,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+}
+
+type AccountAuthenticationPolicyAttachmentParameters struct {
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the authentication policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the authentication policy to apply to the current account.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/security/v1alpha1.AuthenticationPolicy
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	// +kubebuilder:validation:Optional
+	AuthenticationPolicy *string `json:"authenticationPolicy,omitempty" tf:"authentication_policy,omitempty"`
+
+	// Reference to a AuthenticationPolicy in security to populate authenticationPolicy.
+	// +kubebuilder:validation:Optional
+	AuthenticationPolicyRef *v1.Reference `json:"authenticationPolicyRef,omitempty" tf:"-"`
+
+	// Selector for a AuthenticationPolicy in security to populate authenticationPolicy.
+	// +kubebuilder:validation:Optional
+	AuthenticationPolicySelector *v1.Selector `json:"authenticationPolicySelector,omitempty" tf:"-"`
+}
+
+// AccountAuthenticationPolicyAttachmentSpec defines the desired state of AccountAuthenticationPolicyAttachment
+type AccountAuthenticationPolicyAttachmentSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     AccountAuthenticationPolicyAttachmentParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider AccountAuthenticationPolicyAttachmentInitParameters `json:"initProvider,omitempty"`
+}
+
+// AccountAuthenticationPolicyAttachmentStatus defines the observed state of AccountAuthenticationPolicyAttachment.
+type AccountAuthenticationPolicyAttachmentStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        AccountAuthenticationPolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// AccountAuthenticationPolicyAttachment is the Schema for the AccountAuthenticationPolicyAttachments API. Specifies the authentication policy to use for the current account. To set the authentication policy of a different account, use a provider alias.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type AccountAuthenticationPolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AccountAuthenticationPolicyAttachmentSpec   `json:"spec"`
+	Status            AccountAuthenticationPolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAuthenticationPolicyAttachmentList contains a list of AccountAuthenticationPolicyAttachments
+type AccountAuthenticationPolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAuthenticationPolicyAttachment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	AccountAuthenticationPolicyAttachment_Kind             = "AccountAuthenticationPolicyAttachment"
+	AccountAuthenticationPolicyAttachment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountAuthenticationPolicyAttachment_Kind}.String()
+	AccountAuthenticationPolicyAttachment_KindAPIVersion   = AccountAuthenticationPolicyAttachment_Kind + "." + CRDGroupVersion.String()
+	AccountAuthenticationPolicyAttachment_GroupVersionKind = CRDGroupVersion.WithKind(AccountAuthenticationPolicyAttachment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountAuthenticationPolicyAttachment{}, &AccountAuthenticationPolicyAttachmentList{})
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type AccountPasswordPolicyAttachmentInitParameters struct {
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the password policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the password policy to apply to the current account.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/security/v1alpha1.PasswordPolicy
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	PasswordPolicy *string `json:"passwordPolicy,omitempty" tf:"password_policy,omitempty"`
+
+	// Reference to a PasswordPolicy in security to populate passwordPolicy.
+	// +kubebuilder:validation:Optional
+	PasswordPolicyRef *v1.Reference `json:"passwordPolicyRef,omitempty" tf:"-"`
+
+	// Selector for a PasswordPolicy in security to populate passwordPolicy.
+	// +kubebuilder:validation:Optional
+	PasswordPolicySelector *v1.Selector `json:"passwordPolicySelector,omitempty" tf:"-"`
+}
+
+type AccountPasswordPolicyAttachmentObservation struct {
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the password policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the password policy to apply to the current account.
+	PasswordPolicy *string `json:"passwordPolicy,omitempty" tf:"password_policy,omitempty"`
+}
+
+type AccountPasswordPolicyAttachmentParameters struct {
+
+	// (String) Qualified name ("db"."schema"."policy_name") of the password policy to apply to the current account.
+	// Qualified name (`"db"."schema"."policy_name"`) of the password policy to apply to the current account.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/security/v1alpha1.PasswordPolicy
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)
+	// +kubebuilder:validation:Optional
+	PasswordPolicy *string `json:"passwordPolicy,omitempty" tf:"password_policy,omitempty"`
+
+	// Reference to a PasswordPolicy in security to populate passwordPolicy.
+	// +kubebuilder:validation:Optional
+	PasswordPolicyRef *v1.Reference `json:"passwordPolicyRef,omitempty" tf:"-"`
+
+	// Selector for a PasswordPolicy in security to populate passwordPolicy.
+	// +kubebuilder:validation:Optional
+	PasswordPolicySelector *v1.Selector `json:"passwordPolicySelector,omitempty" tf:"-"`
+}
+
+// AccountPasswordPolicyAttachmentSpec defines the desired state of AccountPasswordPolicyAttachment
+type AccountPasswordPolicyAttachmentSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     AccountPasswordPolicyAttachmentParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider AccountPasswordPolicyAttachmentInitParameters `json:"initProvider,omitempty"`
+}
+
+// AccountPasswordPolicyAttachmentStatus defines the observed state of AccountPasswordPolicyAttachment.
+type AccountPasswordPolicyAttachmentStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        AccountPasswordPolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// AccountPasswordPolicyAttachment is the Schema for the AccountPasswordPolicyAttachments API. Specifies the password policy to use for the current account. To set the password policy of a different account, use a provider alias.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type AccountPasswordPolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AccountPasswordPolicyAttachmentSpec   `json:"spec"`
+	Status            AccountPasswordPolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPasswordPolicyAttachmentList contains a list of AccountPasswordPolicyAttachments
+type AccountPasswordPolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPasswordPolicyAttachment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	AccountPasswordPolicyAttachment_Kind             = "AccountPasswordPolicyAttachment"
+	AccountPasswordPolicyAttachment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountPasswordPolicyAttachment_Kind}.String()
+	AccountPasswordPolicyAttachment_KindAPIVersion   = AccountPasswordPolicyAttachment_Kind + "." + CRDGroupVersion.String()
+	AccountPasswordPolicyAttachment_GroupVersionKind = CRDGroupVersion.WithKind(AccountPasswordPolicyAttachment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountPasswordPolicyAttachment{}, &AccountPasswordPolicyAttachmentList{})
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+// Hub marks this type as a conversion hub.
+func (tr *AccountAuthenticationPolicyAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *AccountPasswordPolicyAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *AuthenticationPolicy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *PasswordPolicy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *UserAuthenticationPolicyAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *UserPasswordPolicyAttachment) Hub() {}
@@ -0,0 +1,1824 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachment) DeepCopyInto(out *AccountAuthenticationPolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachment.
+func (in *AccountAuthenticationPolicyAttachment) DeepCopy() *AccountAuthenticationPolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAuthenticationPolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentInitParameters) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentInitParameters) {
+	*out = *in
+	if in.AuthenticationPolicy != nil {
+		in, out := &in.AuthenticationPolicy, &out.AuthenticationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationPolicyRef != nil {
+		in, out := &in.AuthenticationPolicyRef, &out.AuthenticationPolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationPolicySelector != nil {
+		in, out := &in.AuthenticationPolicySelector, &out.AuthenticationPolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentInitParameters.
+func (in *AccountAuthenticationPolicyAttachmentInitParameters) DeepCopy() *AccountAuthenticationPolicyAttachmentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentList) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountAuthenticationPolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentList.
+func (in *AccountAuthenticationPolicyAttachmentList) DeepCopy() *AccountAuthenticationPolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAuthenticationPolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentObservation) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentObservation) {
+	*out = *in
+	if in.AuthenticationPolicy != nil {
+		in, out := &in.AuthenticationPolicy, &out.AuthenticationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentObservation.
+func (in *AccountAuthenticationPolicyAttachmentObservation) DeepCopy() *AccountAuthenticationPolicyAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentParameters) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentParameters) {
+	*out = *in
+	if in.AuthenticationPolicy != nil {
+		in, out := &in.AuthenticationPolicy, &out.AuthenticationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationPolicyRef != nil {
+		in, out := &in.AuthenticationPolicyRef, &out.AuthenticationPolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationPolicySelector != nil {
+		in, out := &in.AuthenticationPolicySelector, &out.AuthenticationPolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentParameters.
+func (in *AccountAuthenticationPolicyAttachmentParameters) DeepCopy() *AccountAuthenticationPolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentSpec) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentSpec.
+func (in *AccountAuthenticationPolicyAttachmentSpec) DeepCopy() *AccountAuthenticationPolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAuthenticationPolicyAttachmentStatus) DeepCopyInto(out *AccountAuthenticationPolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAuthenticationPolicyAttachmentStatus.
+func (in *AccountAuthenticationPolicyAttachmentStatus) DeepCopy() *AccountAuthenticationPolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAuthenticationPolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachment) DeepCopyInto(out *AccountPasswordPolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachment.
+func (in *AccountPasswordPolicyAttachment) DeepCopy() *AccountPasswordPolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPasswordPolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentInitParameters) DeepCopyInto(out *AccountPasswordPolicyAttachmentInitParameters) {
+	*out = *in
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicyRef != nil {
+		in, out := &in.PasswordPolicyRef, &out.PasswordPolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicySelector != nil {
+		in, out := &in.PasswordPolicySelector, &out.PasswordPolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentInitParameters.
+func (in *AccountPasswordPolicyAttachmentInitParameters) DeepCopy() *AccountPasswordPolicyAttachmentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentList) DeepCopyInto(out *AccountPasswordPolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountPasswordPolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentList.
+func (in *AccountPasswordPolicyAttachmentList) DeepCopy() *AccountPasswordPolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPasswordPolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentObservation) DeepCopyInto(out *AccountPasswordPolicyAttachmentObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentObservation.
+func (in *AccountPasswordPolicyAttachmentObservation) DeepCopy() *AccountPasswordPolicyAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentParameters) DeepCopyInto(out *AccountPasswordPolicyAttachmentParameters) {
+	*out = *in
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicyRef != nil {
+		in, out := &in.PasswordPolicyRef, &out.PasswordPolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicySelector != nil {
+		in, out := &in.PasswordPolicySelector, &out.PasswordPolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentParameters.
+func (in *AccountPasswordPolicyAttachmentParameters) DeepCopy() *AccountPasswordPolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentSpec) DeepCopyInto(out *AccountPasswordPolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentSpec.
+func (in *AccountPasswordPolicyAttachmentSpec) DeepCopy() *AccountPasswordPolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyAttachmentStatus) DeepCopyInto(out *AccountPasswordPolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyAttachmentStatus.
+func (in *AccountPasswordPolicyAttachmentStatus) DeepCopy() *AccountPasswordPolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicy) DeepCopyInto(out *AuthenticationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicy.
+func (in *AuthenticationPolicy) DeepCopy() *AuthenticationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthenticationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicyInitParameters) DeepCopyInto(out *AuthenticationPolicyInitParameters) {
+	*out = *in
+	if in.AuthenticationMethods != nil {
+		in, out := &in.AuthenticationMethods, &out.AuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ClientTypes != nil {
+		in, out := &in.ClientTypes, &out.ClientTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MfaAuthenticationMethods != nil {
+		in, out := &in.MfaAuthenticationMethods, &out.MfaAuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.MfaEnrollment != nil {
+		in, out := &in.MfaEnrollment, &out.MfaEnrollment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaRef != nil {
+		in, out := &in.SchemaRef, &out.SchemaRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaSelector != nil {
+		in, out := &in.SchemaSelector, &out.SchemaSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityIntegrations != nil {
+		in, out := &in.SecurityIntegrations, &out.SecurityIntegrations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicyInitParameters.
+func (in *AuthenticationPolicyInitParameters) DeepCopy() *AuthenticationPolicyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicyList) DeepCopyInto(out *AuthenticationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthenticationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicyList.
+func (in *AuthenticationPolicyList) DeepCopy() *AuthenticationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthenticationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicyObservation) DeepCopyInto(out *AuthenticationPolicyObservation) {
+	*out = *in
+	if in.AuthenticationMethods != nil {
+		in, out := &in.AuthenticationMethods, &out.AuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ClientTypes != nil {
+		in, out := &in.ClientTypes, &out.ClientTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DescribeOutput != nil {
+		in, out := &in.DescribeOutput, &out.DescribeOutput
+		*out = make([]DescribeOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MfaAuthenticationMethods != nil {
+		in, out := &in.MfaAuthenticationMethods, &out.MfaAuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.MfaEnrollment != nil {
+		in, out := &in.MfaEnrollment, &out.MfaEnrollment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityIntegrations != nil {
+		in, out := &in.SecurityIntegrations, &out.SecurityIntegrations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ShowOutput != nil {
+		in, out := &in.ShowOutput, &out.ShowOutput
+		*out = make([]ShowOutputObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicyObservation.
+func (in *AuthenticationPolicyObservation) DeepCopy() *AuthenticationPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicyParameters) DeepCopyInto(out *AuthenticationPolicyParameters) {
+	*out = *in
+	if in.AuthenticationMethods != nil {
+		in, out := &in.AuthenticationMethods, &out.AuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ClientTypes != nil {
+		in, out := &in.ClientTypes, &out.ClientTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MfaAuthenticationMethods != nil {
+		in, out := &in.MfaAuthenticationMethods, &out.MfaAuthenticationMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.MfaEnrollment != nil {
+		in, out := &in.MfaEnrollment, &out.MfaEnrollment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaRef != nil {
+		in, out := &in.SchemaRef, &out.SchemaRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaSelector != nil {
+		in, out := &in.SchemaSelector, &out.SchemaSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityIntegrations != nil {
+		in, out := &in.SecurityIntegrations, &out.SecurityIntegrations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicyParameters.
+func (in *AuthenticationPolicyParameters) DeepCopy() *AuthenticationPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicySpec) DeepCopyInto(out *AuthenticationPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicySpec.
+func (in *AuthenticationPolicySpec) DeepCopy() *AuthenticationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationPolicyStatus) DeepCopyInto(out *AuthenticationPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationPolicyStatus.
+func (in *AuthenticationPolicyStatus) DeepCopy() *AuthenticationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputInitParameters) DeepCopyInto(out *DescribeOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputInitParameters.
+func (in *DescribeOutputInitParameters) DeepCopy() *DescribeOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputObservation) DeepCopyInto(out *DescribeOutputObservation) {
+	*out = *in
+	if in.AuthenticationMethods != nil {
+		in, out := &in.AuthenticationMethods, &out.AuthenticationMethods
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientTypes != nil {
+		in, out := &in.ClientTypes, &out.ClientTypes
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.MfaAuthenticationMethods != nil {
+		in, out := &in.MfaAuthenticationMethods, &out.MfaAuthenticationMethods
+		*out = new(string)
+		**out = **in
+	}
+	if in.MfaEnrollment != nil {
+		in, out := &in.MfaEnrollment, &out.MfaEnrollment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityIntegrations != nil {
+		in, out := &in.SecurityIntegrations, &out.SecurityIntegrations
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputObservation.
+func (in *DescribeOutputObservation) DeepCopy() *DescribeOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeOutputParameters) DeepCopyInto(out *DescribeOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeOutputParameters.
+func (in *DescribeOutputParameters) DeepCopy() *DescribeOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DescribeOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyInitParameters) DeepCopyInto(out *PasswordPolicyInitParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(float64)
+		**out = **in
+	}
+	if in.IfNotExists != nil {
+		in, out := &in.IfNotExists, &out.IfNotExists
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockoutTimeMins != nil {
+		in, out := &in.LockoutTimeMins, &out.LockoutTimeMins
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxAgeDays != nil {
+		in, out := &in.MaxAgeDays, &out.MaxAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinAgeDays != nil {
+		in, out := &in.MinAgeDays, &out.MinAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLowerCaseChars != nil {
+		in, out := &in.MinLowerCaseChars, &out.MinLowerCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinNumericChars != nil {
+		in, out := &in.MinNumericChars, &out.MinNumericChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinSpecialChars != nil {
+		in, out := &in.MinSpecialChars, &out.MinSpecialChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinUpperCaseChars != nil {
+		in, out := &in.MinUpperCaseChars, &out.MinUpperCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrReplace != nil {
+		in, out := &in.OrReplace, &out.OrReplace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaRef != nil {
+		in, out := &in.SchemaRef, &out.SchemaRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaSelector != nil {
+		in, out := &in.SchemaSelector, &out.SchemaSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyInitParameters.
+func (in *PasswordPolicyInitParameters) DeepCopy() *PasswordPolicyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyList) DeepCopyInto(out *PasswordPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PasswordPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyList.
+func (in *PasswordPolicyList) DeepCopy() *PasswordPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyObservation) DeepCopyInto(out *PasswordPolicyObservation) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedName != nil {
+		in, out := &in.FullyQualifiedName, &out.FullyQualifiedName
+		*out = new(string)
+		**out = **in
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IfNotExists != nil {
+		in, out := &in.IfNotExists, &out.IfNotExists
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockoutTimeMins != nil {
+		in, out := &in.LockoutTimeMins, &out.LockoutTimeMins
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxAgeDays != nil {
+		in, out := &in.MaxAgeDays, &out.MaxAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinAgeDays != nil {
+		in, out := &in.MinAgeDays, &out.MinAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLowerCaseChars != nil {
+		in, out := &in.MinLowerCaseChars, &out.MinLowerCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinNumericChars != nil {
+		in, out := &in.MinNumericChars, &out.MinNumericChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinSpecialChars != nil {
+		in, out := &in.MinSpecialChars, &out.MinSpecialChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinUpperCaseChars != nil {
+		in, out := &in.MinUpperCaseChars, &out.MinUpperCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrReplace != nil {
+		in, out := &in.OrReplace, &out.OrReplace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyObservation.
+func (in *PasswordPolicyObservation) DeepCopy() *PasswordPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyParameters) DeepCopyInto(out *PasswordPolicyParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(float64)
+		**out = **in
+	}
+	if in.IfNotExists != nil {
+		in, out := &in.IfNotExists, &out.IfNotExists
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockoutTimeMins != nil {
+		in, out := &in.LockoutTimeMins, &out.LockoutTimeMins
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxAgeDays != nil {
+		in, out := &in.MaxAgeDays, &out.MaxAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinAgeDays != nil {
+		in, out := &in.MinAgeDays, &out.MinAgeDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinLowerCaseChars != nil {
+		in, out := &in.MinLowerCaseChars, &out.MinLowerCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinNumericChars != nil {
+		in, out := &in.MinNumericChars, &out.MinNumericChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinSpecialChars != nil {
+		in, out := &in.MinSpecialChars, &out.MinSpecialChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinUpperCaseChars != nil {
+		in, out := &in.MinUpperCaseChars, &out.MinUpperCaseChars
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrReplace != nil {
+		in, out := &in.OrReplace, &out.OrReplace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaRef != nil {
+		in, out := &in.SchemaRef, &out.SchemaRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaSelector != nil {
+		in, out := &in.SchemaSelector, &out.SchemaSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyParameters.
+func (in *PasswordPolicyParameters) DeepCopy() *PasswordPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicySpec) DeepCopyInto(out *PasswordPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicySpec.
+func (in *PasswordPolicySpec) DeepCopy() *PasswordPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicyStatus) DeepCopyInto(out *PasswordPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicyStatus.
+func (in *PasswordPolicyStatus) DeepCopy() *PasswordPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputInitParameters) DeepCopyInto(out *ShowOutputInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputInitParameters.
+func (in *ShowOutputInitParameters) DeepCopy() *ShowOutputInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputObservation) DeepCopyInto(out *ShowOutputObservation) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerRoleType != nil {
+		in, out := &in.OwnerRoleType, &out.OwnerRoleType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaName != nil {
+		in, out := &in.SchemaName, &out.SchemaName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputObservation.
+func (in *ShowOutputObservation) DeepCopy() *ShowOutputObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShowOutputParameters) DeepCopyInto(out *ShowOutputParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShowOutputParameters.
+func (in *ShowOutputParameters) DeepCopy() *ShowOutputParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ShowOutputParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachment) DeepCopyInto(out *UserAuthenticationPolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachment.
+func (in *UserAuthenticationPolicyAttachment) DeepCopy() *UserAuthenticationPolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserAuthenticationPolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentInitParameters) DeepCopyInto(out *UserAuthenticationPolicyAttachmentInitParameters) {
+	*out = *in
+	if in.AuthenticationPolicyName != nil {
+		in, out := &in.AuthenticationPolicyName, &out.AuthenticationPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationPolicyNameRef != nil {
+		in, out := &in.AuthenticationPolicyNameRef, &out.AuthenticationPolicyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationPolicyNameSelector != nil {
+		in, out := &in.AuthenticationPolicyNameSelector, &out.AuthenticationPolicyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentInitParameters.
+func (in *UserAuthenticationPolicyAttachmentInitParameters) DeepCopy() *UserAuthenticationPolicyAttachmentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentList) DeepCopyInto(out *UserAuthenticationPolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserAuthenticationPolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentList.
+func (in *UserAuthenticationPolicyAttachmentList) DeepCopy() *UserAuthenticationPolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserAuthenticationPolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentObservation) DeepCopyInto(out *UserAuthenticationPolicyAttachmentObservation) {
+	*out = *in
+	if in.AuthenticationPolicyName != nil {
+		in, out := &in.AuthenticationPolicyName, &out.AuthenticationPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentObservation.
+func (in *UserAuthenticationPolicyAttachmentObservation) DeepCopy() *UserAuthenticationPolicyAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentParameters) DeepCopyInto(out *UserAuthenticationPolicyAttachmentParameters) {
+	*out = *in
+	if in.AuthenticationPolicyName != nil {
+		in, out := &in.AuthenticationPolicyName, &out.AuthenticationPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationPolicyNameRef != nil {
+		in, out := &in.AuthenticationPolicyNameRef, &out.AuthenticationPolicyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationPolicyNameSelector != nil {
+		in, out := &in.AuthenticationPolicyNameSelector, &out.AuthenticationPolicyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentParameters.
+func (in *UserAuthenticationPolicyAttachmentParameters) DeepCopy() *UserAuthenticationPolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentSpec) DeepCopyInto(out *UserAuthenticationPolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentSpec.
+func (in *UserAuthenticationPolicyAttachmentSpec) DeepCopy() *UserAuthenticationPolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthenticationPolicyAttachmentStatus) DeepCopyInto(out *UserAuthenticationPolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthenticationPolicyAttachmentStatus.
+func (in *UserAuthenticationPolicyAttachmentStatus) DeepCopy() *UserAuthenticationPolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthenticationPolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachment) DeepCopyInto(out *UserPasswordPolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachment.
+func (in *UserPasswordPolicyAttachment) DeepCopy() *UserPasswordPolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserPasswordPolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentInitParameters) DeepCopyInto(out *UserPasswordPolicyAttachmentInitParameters) {
+	*out = *in
+	if in.PasswordPolicyName != nil {
+		in, out := &in.PasswordPolicyName, &out.PasswordPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicyNameRef != nil {
+		in, out := &in.PasswordPolicyNameRef, &out.PasswordPolicyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicyNameSelector != nil {
+		in, out := &in.PasswordPolicyNameSelector, &out.PasswordPolicyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentInitParameters.
+func (in *UserPasswordPolicyAttachmentInitParameters) DeepCopy() *UserPasswordPolicyAttachmentInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentList) DeepCopyInto(out *UserPasswordPolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserPasswordPolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentList.
+func (in *UserPasswordPolicyAttachmentList) DeepCopy() *UserPasswordPolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserPasswordPolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentObservation) DeepCopyInto(out *UserPasswordPolicyAttachmentObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicyName != nil {
+		in, out := &in.PasswordPolicyName, &out.PasswordPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentObservation.
+func (in *UserPasswordPolicyAttachmentObservation) DeepCopy() *UserPasswordPolicyAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentParameters) DeepCopyInto(out *UserPasswordPolicyAttachmentParameters) {
+	*out = *in
+	if in.PasswordPolicyName != nil {
+		in, out := &in.PasswordPolicyName, &out.PasswordPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordPolicyNameRef != nil {
+		in, out := &in.PasswordPolicyNameRef, &out.PasswordPolicyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicyNameSelector != nil {
+		in, out := &in.PasswordPolicyNameSelector, &out.PasswordPolicyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentParameters.
+func (in *UserPasswordPolicyAttachmentParameters) DeepCopy() *UserPasswordPolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentSpec) DeepCopyInto(out *UserPasswordPolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentSpec.
+func (in *UserPasswordPolicyAttachmentSpec) DeepCopy() *UserPasswordPolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordPolicyAttachmentStatus) DeepCopyInto(out *UserPasswordPolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordPolicyAttachmentStatus.
+func (in *UserPasswordPolicyAttachmentStatus) DeepCopy() *UserPasswordPolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordPolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
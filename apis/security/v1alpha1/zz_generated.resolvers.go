@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha11 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this AccountAuthenticationPolicyAttachment.
+func (mg *AccountAuthenticationPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AuthenticationPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.AuthenticationPolicyRef,
+		Selector:     mg.Spec.ForProvider.AuthenticationPolicySelector,
+		To: reference.To{
+			List:    &AuthenticationPolicyList{},
+			Managed: &AuthenticationPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AuthenticationPolicy")
+	}
+	mg.Spec.ForProvider.AuthenticationPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AuthenticationPolicyRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.AuthenticationPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.AuthenticationPolicyRef,
+		Selector:     mg.Spec.InitProvider.AuthenticationPolicySelector,
+		To: reference.To{
+			List:    &AuthenticationPolicyList{},
+			Managed: &AuthenticationPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.AuthenticationPolicy")
+	}
+	mg.Spec.InitProvider.AuthenticationPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.AuthenticationPolicyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AccountPasswordPolicyAttachment.
+func (mg *AccountPasswordPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PasswordPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.PasswordPolicyRef,
+		Selector:     mg.Spec.ForProvider.PasswordPolicySelector,
+		To: reference.To{
+			List:    &PasswordPolicyList{},
+			Managed: &PasswordPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PasswordPolicy")
+	}
+	mg.Spec.ForProvider.PasswordPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PasswordPolicyRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.PasswordPolicy),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.PasswordPolicyRef,
+		Selector:     mg.Spec.InitProvider.PasswordPolicySelector,
+		To: reference.To{
+			List:    &PasswordPolicyList{},
+			Managed: &PasswordPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.PasswordPolicy")
+	}
+	mg.Spec.InitProvider.PasswordPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.PasswordPolicyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AuthenticationPolicy.
+func (mg *AuthenticationPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha1.SchemaList{},
+			Managed: &v1alpha1.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha1.SchemaList{},
+			Managed: &v1alpha1.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PasswordPolicy.
+func (mg *PasswordPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.SchemaRef,
+		Selector:     mg.Spec.ForProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha1.SchemaList{},
+			Managed: &v1alpha1.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Schema")
+	}
+	mg.Spec.ForProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SchemaRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Database),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.DatabaseRef,
+		Selector:     mg.Spec.InitProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Database")
+	}
+	mg.Spec.InitProvider.Database = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Schema),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.SchemaRef,
+		Selector:     mg.Spec.InitProvider.SchemaSelector,
+		To: reference.To{
+			List:    &v1alpha1.SchemaList{},
+			Managed: &v1alpha1.Schema{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.Schema")
+	}
+	mg.Spec.InitProvider.Schema = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.SchemaRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this UserAuthenticationPolicyAttachment.
+func (mg *UserAuthenticationPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AuthenticationPolicyName),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.AuthenticationPolicyNameRef,
+		Selector:     mg.Spec.ForProvider.AuthenticationPolicyNameSelector,
+		To: reference.To{
+			List:    &AuthenticationPolicyList{},
+			Managed: &AuthenticationPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AuthenticationPolicyName")
+	}
+	mg.Spec.ForProvider.AuthenticationPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AuthenticationPolicyNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.UserName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To: reference.To{
+			List:    &v1alpha11.UserList{},
+			Managed: &v1alpha11.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.UserName")
+	}
+	mg.Spec.ForProvider.UserName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.UserNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.AuthenticationPolicyName),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.AuthenticationPolicyNameRef,
+		Selector:     mg.Spec.InitProvider.AuthenticationPolicyNameSelector,
+		To: reference.To{
+			List:    &AuthenticationPolicyList{},
+			Managed: &AuthenticationPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.AuthenticationPolicyName")
+	}
+	mg.Spec.InitProvider.AuthenticationPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.AuthenticationPolicyNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.UserName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.UserNameRef,
+		Selector:     mg.Spec.InitProvider.UserNameSelector,
+		To: reference.To{
+			List:    &v1alpha11.UserList{},
+			Managed: &v1alpha11.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.UserName")
+	}
+	mg.Spec.InitProvider.UserName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.UserNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this UserPasswordPolicyAttachment.
+func (mg *UserPasswordPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PasswordPolicyName),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.ForProvider.PasswordPolicyNameRef,
+		Selector:     mg.Spec.ForProvider.PasswordPolicyNameSelector,
+		To: reference.To{
+			List:    &PasswordPolicyList{},
+			Managed: &PasswordPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PasswordPolicyName")
+	}
+	mg.Spec.ForProvider.PasswordPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PasswordPolicyNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.UserName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To: reference.To{
+			List:    &v1alpha11.UserList{},
+			Managed: &v1alpha11.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.UserName")
+	}
+	mg.Spec.ForProvider.UserName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.UserNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.PasswordPolicyName),
+		Extract:      resource.ExtractParamPath("fully_qualified_name", true),
+		Reference:    mg.Spec.InitProvider.PasswordPolicyNameRef,
+		Selector:     mg.Spec.InitProvider.PasswordPolicyNameSelector,
+		To: reference.To{
+			List:    &PasswordPolicyList{},
+			Managed: &PasswordPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.PasswordPolicyName")
+	}
+	mg.Spec.InitProvider.PasswordPolicyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.PasswordPolicyNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.UserName),
+		Extract:      resource.ExtractParamPath("name", false),
+		Reference:    mg.Spec.InitProvider.UserNameRef,
+		Selector:     mg.Spec.InitProvider.UserNameSelector,
+		To: reference.To{
+			List:    &v1alpha11.UserList{},
+			Managed: &v1alpha11.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.UserName")
+	}
+	mg.Spec.InitProvider.UserName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.UserNameRef = rsp.ResolvedReference
+
+	return nil
+}
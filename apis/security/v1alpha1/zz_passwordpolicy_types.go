@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type PasswordPolicyInitParameters struct {
+
+	// Adds a comment or overwrites an existing comment for the password policy.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// The database this password policy belongs to.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Default: `0`) Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. When you increase the history value, Snowflake saves the previous values. When you decrease the value, Snowflake saves the stored values up to that value that is set. For example, if the history value is 8 and you change the history value to 3, Snowflake stores the most recent 3 passwords and deletes the 5 older password values from the history. Default: 0 Max: 24
+	History *float64 `json:"history,omitempty" tf:"history,omitempty"`
+
+	// (Default: `false`) Prevent overwriting a previous password policy with the same name.
+	IfNotExists *bool `json:"ifNotExists,omitempty" tf:"if_not_exists,omitempty"`
+
+	// (Default: `15`) Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. PASSWORD_MAX_RETRIES). Supported range: 1 to 999, inclusive. Default: 15
+	LockoutTimeMins *float64 `json:"lockoutTimeMins,omitempty" tf:"lockout_time_mins,omitempty"`
+
+	// (Default: `90`) Specifies the maximum number of days before the password must be changed. Supported range: 0 to 999, inclusive. A value of zero (i.e. 0) indicates that the password does not need to be changed. Snowflake does not recommend choosing this value for a default account-level password policy or for any user-level policy. Instead, choose a value that meets your internal security guidelines. Default: 90, which means the password must be changed every 90 days.
+	MaxAgeDays *float64 `json:"maxAgeDays,omitempty" tf:"max_age_days,omitempty"`
+
+	// (Default: `256`) Specifies the maximum number of characters the password must contain. This number must be greater than or equal to the sum of PASSWORD_MIN_LENGTH, PASSWORD_MIN_UPPER_CASE_CHARS, and PASSWORD_MIN_LOWER_CASE_CHARS. Supported range: 8 to 256, inclusive. Default: 256
+	MaxLength *float64 `json:"maxLength,omitempty" tf:"max_length,omitempty"`
+
+	// (Default: `5`) Specifies the maximum number of attempts to enter a password before being locked out. Supported range: 1 to 10, inclusive. Default: 5
+	MaxRetries *float64 `json:"maxRetries,omitempty" tf:"max_retries,omitempty"`
+
+	// (Default: `0`) Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive. Default: 0
+	MinAgeDays *float64 `json:"minAgeDays,omitempty" tf:"min_age_days,omitempty"`
+
+	// (Default: `8`) Specifies the minimum number of characters the password must contain. Supported range: 8 to 256, inclusive. Default: 8
+	MinLength *float64 `json:"minLength,omitempty" tf:"min_length,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of lowercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinLowerCaseChars *float64 `json:"minLowerCaseChars,omitempty" tf:"min_lower_case_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of numeric characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinNumericChars *float64 `json:"minNumericChars,omitempty" tf:"min_numeric_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of special characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinSpecialChars *float64 `json:"minSpecialChars,omitempty" tf:"min_special_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of uppercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinUpperCaseChars *float64 `json:"minUpperCaseChars,omitempty" tf:"min_upper_case_chars,omitempty"`
+
+	// Identifier for the password policy; must be unique for your account.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Default: `false`) Whether to override a previous password policy with the same name.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// The schema this password policy belongs to.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+}
+
+type PasswordPolicyObservation struct {
+
+	// Adds a comment or overwrites an existing comment for the password policy.
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// The database this password policy belongs to.
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Fully qualified name of the resource. For more information, see [object name resolution](https://docs.snowflake.com/en/sql-reference/name-resolution).
+	FullyQualifiedName *string `json:"fullyQualifiedName,omitempty" tf:"fully_qualified_name,omitempty"`
+
+	// (Default: `0`) Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. When you increase the history value, Snowflake saves the previous values. When you decrease the value, Snowflake saves the stored values up to that value that is set. For example, if the history value is 8 and you change the history value to 3, Snowflake stores the most recent 3 passwords and deletes the 5 older password values from the history. Default: 0 Max: 24
+	History *float64 `json:"history,omitempty" tf:"history,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Default: `false`) Prevent overwriting a previous password policy with the same name.
+	IfNotExists *bool `json:"ifNotExists,omitempty" tf:"if_not_exists,omitempty"`
+
+	// (Default: `15`) Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. PASSWORD_MAX_RETRIES). Supported range: 1 to 999, inclusive. Default: 15
+	LockoutTimeMins *float64 `json:"lockoutTimeMins,omitempty" tf:"lockout_time_mins,omitempty"`
+
+	// (Default: `90`) Specifies the maximum number of days before the password must be changed. Supported range: 0 to 999, inclusive. A value of zero (i.e. 0) indicates that the password does not need to be changed. Snowflake does not recommend choosing this value for a default account-level password policy or for any user-level policy. Instead, choose a value that meets your internal security guidelines. Default: 90, which means the password must be changed every 90 days.
+	MaxAgeDays *float64 `json:"maxAgeDays,omitempty" tf:"max_age_days,omitempty"`
+
+	// (Default: `256`) Specifies the maximum number of characters the password must contain. This number must be greater than or equal to the sum of PASSWORD_MIN_LENGTH, PASSWORD_MIN_UPPER_CASE_CHARS, and PASSWORD_MIN_LOWER_CASE_CHARS. Supported range: 8 to 256, inclusive. Default: 256
+	MaxLength *float64 `json:"maxLength,omitempty" tf:"max_length,omitempty"`
+
+	// (Default: `5`) Specifies the maximum number of attempts to enter a password before being locked out. Supported range: 1 to 10, inclusive. Default: 5
+	MaxRetries *float64 `json:"maxRetries,omitempty" tf:"max_retries,omitempty"`
+
+	// (Default: `0`) Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive. Default: 0
+	MinAgeDays *float64 `json:"minAgeDays,omitempty" tf:"min_age_days,omitempty"`
+
+	// (Default: `8`) Specifies the minimum number of characters the password must contain. Supported range: 8 to 256, inclusive. Default: 8
+	MinLength *float64 `json:"minLength,omitempty" tf:"min_length,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of lowercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinLowerCaseChars *float64 `json:"minLowerCaseChars,omitempty" tf:"min_lower_case_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of numeric characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinNumericChars *float64 `json:"minNumericChars,omitempty" tf:"min_numeric_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of special characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinSpecialChars *float64 `json:"minSpecialChars,omitempty" tf:"min_special_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of uppercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	MinUpperCaseChars *float64 `json:"minUpperCaseChars,omitempty" tf:"min_upper_case_chars,omitempty"`
+
+	// Identifier for the password policy; must be unique for your account.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Default: `false`) Whether to override a previous password policy with the same name.
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// The schema this password policy belongs to.
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+}
+
+type PasswordPolicyParameters struct {
+
+	// Adds a comment or overwrites an existing comment for the password policy.
+	// +kubebuilder:validation:Optional
+	Comment *string `json:"comment,omitempty" tf:"comment,omitempty"`
+
+	// The database this password policy belongs to.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Database *string `json:"database,omitempty" tf:"database,omitempty"`
+
+	// Reference to a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *v1.Reference `json:"databaseRef,omitempty" tf:"-"`
+
+	// Selector for a Database in database to populate database.
+	// +kubebuilder:validation:Optional
+	DatabaseSelector *v1.Selector `json:"databaseSelector,omitempty" tf:"-"`
+
+	// (Default: `0`) Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. When you increase the history value, Snowflake saves the previous values. When you decrease the value, Snowflake saves the stored values up to that value that is set. For example, if the history value is 8 and you change the history value to 3, Snowflake stores the most recent 3 passwords and deletes the 5 older password values from the history. Default: 0 Max: 24
+	// +kubebuilder:validation:Optional
+	History *float64 `json:"history,omitempty" tf:"history,omitempty"`
+
+	// (Default: `false`) Prevent overwriting a previous password policy with the same name.
+	// +kubebuilder:validation:Optional
+	IfNotExists *bool `json:"ifNotExists,omitempty" tf:"if_not_exists,omitempty"`
+
+	// (Default: `15`) Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. PASSWORD_MAX_RETRIES). Supported range: 1 to 999, inclusive. Default: 15
+	// +kubebuilder:validation:Optional
+	LockoutTimeMins *float64 `json:"lockoutTimeMins,omitempty" tf:"lockout_time_mins,omitempty"`
+
+	// (Default: `90`) Specifies the maximum number of days before the password must be changed. Supported range: 0 to 999, inclusive. A value of zero (i.e. 0) indicates that the password does not need to be changed. Snowflake does not recommend choosing this value for a default account-level password policy or for any user-level policy. Instead, choose a value that meets your internal security guidelines. Default: 90, which means the password must be changed every 90 days.
+	// +kubebuilder:validation:Optional
+	MaxAgeDays *float64 `json:"maxAgeDays,omitempty" tf:"max_age_days,omitempty"`
+
+	// (Default: `256`) Specifies the maximum number of characters the password must contain. This number must be greater than or equal to the sum of PASSWORD_MIN_LENGTH, PASSWORD_MIN_UPPER_CASE_CHARS, and PASSWORD_MIN_LOWER_CASE_CHARS. Supported range: 8 to 256, inclusive. Default: 256
+	// +kubebuilder:validation:Optional
+	MaxLength *float64 `json:"maxLength,omitempty" tf:"max_length,omitempty"`
+
+	// (Default: `5`) Specifies the maximum number of attempts to enter a password before being locked out. Supported range: 1 to 10, inclusive. Default: 5
+	// +kubebuilder:validation:Optional
+	MaxRetries *float64 `json:"maxRetries,omitempty" tf:"max_retries,omitempty"`
+
+	// (Default: `0`) Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive. Default: 0
+	// +kubebuilder:validation:Optional
+	MinAgeDays *float64 `json:"minAgeDays,omitempty" tf:"min_age_days,omitempty"`
+
+	// (Default: `8`) Specifies the minimum number of characters the password must contain. Supported range: 8 to 256, inclusive. Default: 8
+	// +kubebuilder:validation:Optional
+	MinLength *float64 `json:"minLength,omitempty" tf:"min_length,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of lowercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	// +kubebuilder:validation:Optional
+	MinLowerCaseChars *float64 `json:"minLowerCaseChars,omitempty" tf:"min_lower_case_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of numeric characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	// +kubebuilder:validation:Optional
+	MinNumericChars *float64 `json:"minNumericChars,omitempty" tf:"min_numeric_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of special characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	// +kubebuilder:validation:Optional
+	MinSpecialChars *float64 `json:"minSpecialChars,omitempty" tf:"min_special_chars,omitempty"`
+
+	// (Default: `1`) Specifies the minimum number of uppercase characters the password must contain. Supported range: 0 to 256, inclusive. Default: 1
+	// +kubebuilder:validation:Optional
+	MinUpperCaseChars *float64 `json:"minUpperCaseChars,omitempty" tf:"min_upper_case_chars,omitempty"`
+
+	// Identifier for the password policy; must be unique for your account.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// (Default: `false`) Whether to override a previous password policy with the same name.
+	// +kubebuilder:validation:Optional
+	OrReplace *bool `json:"orReplace,omitempty" tf:"or_replace,omitempty"`
+
+	// The schema this password policy belongs to.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Schema
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)
+	// +kubebuilder:validation:Optional
+	Schema *string `json:"schema,omitempty" tf:"schema,omitempty"`
+
+	// Reference to a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaRef *v1.Reference `json:"schemaRef,omitempty" tf:"-"`
+
+	// Selector for a Schema in database to populate schema.
+	// +kubebuilder:validation:Optional
+	SchemaSelector *v1.Selector `json:"schemaSelector,omitempty" tf:"-"`
+}
+
+// PasswordPolicySpec defines the desired state of PasswordPolicy
+type PasswordPolicySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     PasswordPolicyParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider PasswordPolicyInitParameters `json:"initProvider,omitempty"`
+}
+
+// PasswordPolicyStatus defines the observed state of PasswordPolicy.
+type PasswordPolicyStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        PasswordPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// PasswordPolicy is the Schema for the PasswordPolicys API. <no value>
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type PasswordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.name) || (has(self.initProvider) && has(self.initProvider.name))",message="spec.forProvider.name is a required parameter"
+	Spec   PasswordPolicySpec   `json:"spec"`
+	Status PasswordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PasswordPolicyList contains a list of PasswordPolicys
+type PasswordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PasswordPolicy `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	PasswordPolicy_Kind             = "PasswordPolicy"
+	PasswordPolicy_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PasswordPolicy_Kind}.String()
+	PasswordPolicy_KindAPIVersion   = PasswordPolicy_Kind + "." + CRDGroupVersion.String()
+	PasswordPolicy_GroupVersionKind = CRDGroupVersion.WithKind(PasswordPolicy_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PasswordPolicy{}, &PasswordPolicyList{})
+}
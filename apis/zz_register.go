@@ -12,6 +12,9 @@ import (
 
 	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
 	v1alpha1database "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	v1alpha1integration "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	v1alpha1network "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	v1alpha1security "github.com/allenkallz/provider-snowflake/apis/security/v1alpha1"
 	v1alpha1apis "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
 	v1beta1 "github.com/allenkallz/provider-snowflake/apis/v1beta1"
 )
@@ -21,6 +24,9 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		v1alpha1.SchemeBuilder.AddToScheme,
 		v1alpha1database.SchemeBuilder.AddToScheme,
+		v1alpha1integration.SchemeBuilder.AddToScheme,
+		v1alpha1network.SchemeBuilder.AddToScheme,
+		v1alpha1security.SchemeBuilder.AddToScheme,
 		v1alpha1apis.SchemeBuilder.AddToScheme,
 		v1beta1.SchemeBuilder.AddToScheme,
 	)
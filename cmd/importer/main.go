@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/allenkallz/provider-snowflake/apis/v1beta1"
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+	"github.com/allenkallz/provider-snowflake/internal/importer"
+)
+
+func main() {
+	var (
+		app = kingpin.New(filepath.Base(os.Args[0]), "Generates observe-only managed resource manifests for the objects of an existing Snowflake account.").DefaultEnvars()
+
+		organizationName = app.Flag("organization-name", "Snowflake organization name, as in the ProviderConfig spec.auth.organizationName.").String()
+		accountName      = app.Flag("account-name", "Snowflake account name, as in the ProviderConfig spec.auth.accountName.").String()
+		authType         = app.Flag("auth-type", "Authentication method, as in the ProviderConfig spec.auth.type.").Default(string(v1beta1.AuthMethodSnowflake)).Enum(string(v1beta1.AuthMethodSnowflake), string(v1beta1.AuthMethodJWT), string(v1beta1.AuthMethodPrivateKeyPassphrase))
+		credentials      = app.Flag("credentials", "Path to the JSON credentials document, in the same format as the ProviderConfig credentials secret.").String()
+		recording        = app.Flag("recording", "Path to recorded SHOW output to read instead of connecting to Snowflake.").ExistingFile()
+
+		kinds          = app.Flag("kind", "Kind to import. Can be repeated. Defaults to all of "+strings.Join(importer.KindNames(), ", ")+".").Strings()
+		providerConfig = app.Flag("provider-config", "Name of the ProviderConfig the generated manifests reference.").Default("default").String()
+		output         = app.Flag("output", "File to write the manifests to. Defaults to stdout.").Short('o').String()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	var c clients.SQLClient
+	if *recording != "" {
+		r, err := importer.LoadRecording(*recording)
+		kingpin.FatalIfError(err, "Cannot load recording")
+		c = r
+	} else {
+		if *credentials == "" {
+			kingpin.Fatalf("--credentials is required unless --recording is set")
+		}
+		data, err := os.ReadFile(*credentials)
+		kingpin.FatalIfError(err, "Cannot read credentials")
+		cfg, err := clients.Configuration(v1beta1.SnowflakeAuth{
+			AuthType:         v1beta1.AuthMethodType(*authType),
+			AccountName:      *accountName,
+			OrganizationName: *organizationName,
+		}, data)
+		kingpin.FatalIfError(err, "Cannot build Snowflake configuration")
+		c, err = clients.NewSQLClientFromConfiguration(cfg)
+		kingpin.FatalIfError(err, "Cannot create Snowflake client")
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		kingpin.FatalIfError(err, "Cannot create output file")
+		defer f.Close() //nolint:errcheck // written data is checked by Import
+		w = f
+	}
+
+	kingpin.FatalIfError(importer.New(c, *providerConfig).Import(context.Background(), w, *kinds...), "Cannot import managed resources")
+}
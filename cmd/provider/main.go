@@ -30,12 +30,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/allenkallz/provider-snowflake/apis"
 	"github.com/allenkallz/provider-snowflake/apis/v1alpha1"
 	"github.com/allenkallz/provider-snowflake/config"
 	"github.com/allenkallz/provider-snowflake/internal/clients"
 	"github.com/allenkallz/provider-snowflake/internal/controller"
+	"github.com/allenkallz/provider-snowflake/internal/controller/lookup"
+	"github.com/allenkallz/provider-snowflake/internal/controller/pipestatus"
 	"github.com/allenkallz/provider-snowflake/internal/features"
 )
 
@@ -48,6 +51,7 @@ func main() {
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
 		leaderElection          = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate        = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may be checked for drift from the desired state.").Default("10").Int()
+		pipeStatusInterval      = app.Flag("pipe-status-poll", "How often the load status of each Pipe is recorded, independently of the poll interval.").Default("1m").Duration()
 
 		terraformVersion = app.Flag("terraform-version", "Terraform version.").Required().Envar("TERRAFORM_VERSION").String()
 		providerSource   = app.Flag("terraform-provider-source", "Terraform provider source.").Required().Envar("TERRAFORM_PROVIDER_SOURCE").String()
@@ -57,6 +61,7 @@ func main() {
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+		certsDir                   = app.Flag("certs-dir", "The directory that contains the webhook server key and certificate. Webhooks are disabled if not set.").Envar("TLS_SERVER_CERTS_DIR").String()
 	)
 
 	kingpin.MustParse(app.Parse(os.Args[1:]))
@@ -84,6 +89,9 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *certsDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Snowflake APIs to scheme")
@@ -97,6 +105,7 @@ func main() {
 	// // custom
 	// ctx: context.Background()
 	provider := config.GetProvider()
+	controller.AddInitializers(provider)
 
 	o := tjcontroller.Options{
 		Options: xpcontroller.Options{
@@ -117,6 +126,9 @@ func main() {
 		// terraform.WithProviderRunner(terraform.NewSharedProvider(log, os.Getenv("TERRAFORM_NATIVE_PROVIDER_PATH"), terraform.WithNativeProviderArgs("-debuggable")))
 		WorkspaceStore: terraform.NewWorkspaceStore(log),
 		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion),
+		// Webhooks refuse deletes of protected resources, see
+		// internal/protection.
+		StartWebhooks: *certsDir != "",
 	}
 
 	if *enableExternalSecretStores {
@@ -154,5 +166,7 @@ func main() {
 	}
 
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Snowflake controllers")
+	kingpin.FatalIfError(lookup.Setup(mgr, o), "Cannot setup Snowflake lookup controllers")
+	kingpin.FatalIfError(pipestatus.Setup(mgr, o, *pipeStatusInterval), "Cannot setup Snowflake pipe status controller")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
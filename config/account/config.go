@@ -1,15 +1,63 @@
 package account
 
-import "github.com/crossplane/upjet/pkg/config"
+import (
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+	"github.com/allenkallz/provider-snowflake/internal/protection"
+	"github.com/allenkallz/provider-snowflake/internal/usage"
+)
 
 // Configure configures individual resources by adding custom ResourceConfigurators.
 func Configure(p *config.Provider) {
 	p.AddResourceConfigurator("snowflake_account", func(r *config.Resource) {
 		// We need to override the default group that upjet generated for
 		r.Kind = "Account"
+		// Refuse to drop protected accounts, see protection.AnnotationKeyDeletionProtection
+		r.InitializerFns = append(r.InitializerFns, protection.NewDeletionProtector)
 	})
 
 	p.AddResourceConfigurator("snowflake_account_role", func(r *config.Resource) {
 		r.Kind = "AccountRole"
 	})
+
+	p.AddResourceConfigurator("snowflake_warehouse", func(r *config.Resource) {
+		r.Kind = "Warehouse"
+		r.References["resource_monitor"] = config.Reference{
+			TerraformName: "snowflake_resource_monitor",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	p.AddResourceConfigurator("snowflake_resource_monitor", func(r *config.Resource) {
+		r.Kind = "ResourceMonitor"
+		// Filled in from SHOW RESOURCE MONITORS by usage.ResourceMonitorObserver
+		r.TerraformResource.Schema["used_credits"] = &schema.Schema{
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Credits used by the warehouses the monitor controls in the current interval.",
+		}
+		r.TerraformResource.Schema["remaining_credits"] = &schema.Schema{
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Credits left of the quota of the monitor in the current interval.",
+		}
+		r.InitializerFns = append(r.InitializerFns, usage.NewResourceMonitorObserver)
+	})
+
+	p.AddResourceConfigurator("snowflake_user", func(r *config.Resource) {
+		r.Kind = "User"
+		r.References["default_warehouse"] = config.Reference{
+			TerraformName: "snowflake_warehouse",
+			Extractor:     common.ExtractResourceName,
+		}
+		// network_policy is not a reference, as the network API group
+		// already references Users and Go does not allow import cycles.
+		// Attach policies to users with NetworkPolicyAttachments instead.
+	})
+
+	p.AddResourceConfigurator("snowflake_external_volume", func(r *config.Resource) {
+		r.Kind = "ExternalVolume"
+	})
 }
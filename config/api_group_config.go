@@ -3,13 +3,60 @@ package config
 var (
 	// DefaultApiGroupConfig is the default configuration for API groups.
 	resourceApiGroupConfig = map[string]string{
-		"snowflake_database":      "database",
-		"snowflake_database_role": "database",
-		"snowflake_file_format":   "database",
-		"snowflake_stage":         "database",
-		"snowflake_pipe":          "database",
+		"snowflake_database":                                "database",
+		"snowflake_database_role":                           "database",
+		"snowflake_file_format":                             "database",
+		"snowflake_stage":                                   "database",
+		"snowflake_pipe":                                    "database",
+		"snowflake_external_table":                          "database",
+		"snowflake_external_function":                       "database",
+		"snowflake_function_sql":                            "database",
+		"snowflake_function_python":                         "database",
+		"snowflake_function_java":                           "database",
+		"snowflake_function_javascript":                     "database",
+		"snowflake_function_scala":                          "database",
+		"snowflake_procedure_sql":                           "database",
+		"snowflake_procedure_python":                        "database",
+		"snowflake_procedure_java":                          "database",
+		"snowflake_procedure_javascript":                    "database",
+		"snowflake_procedure_scala":                         "database",
+		"snowflake_schema":                                  "database",
+		"snowflake_table":                                   "database",
+		"snowflake_view":                                    "database",
+		"snowflake_materialized_view":                       "database",
+		"snowflake_dynamic_table":                           "database",
+		"snowflake_task":                                    "database",
+		"snowflake_stream_on_table":                         "database",
+		"snowflake_stream_on_view":                          "database",
+		"snowflake_stream_on_external_table":                "database",
+		"snowflake_stream_on_directory_table":               "database",
+		"snowflake_alert":                                   "database",
+		"snowflake_sequence":                                "database",
+		"snowflake_tag":                                     "database",
+		"snowflake_tag_association":                         "database",
+		"snowflake_masking_policy":                          "database",
+		"snowflake_row_access_policy":                       "database",
+		"snowflake_table_column_masking_policy_application": "database",
+		"snowflake_email_notification_integration":          "integration",
+		"snowflake_notification_integration":                "integration",
+		"snowflake_storage_integration":                     "integration",
+		"snowflake_api_integration":                         "integration",
+		"snowflake_network_rule":                            "network",
+		"snowflake_network_policy":                          "network",
+		"snowflake_network_policy_attachment":               "network",
 
-		"snowflake_account":      "account",
-		"snowflake_account_role": "account",
+		"snowflake_password_policy":                          "security",
+		"snowflake_authentication_policy":                    "security",
+		"snowflake_account_password_policy_attachment":       "security",
+		"snowflake_account_authentication_policy_attachment": "security",
+		"snowflake_user_password_policy_attachment":          "security",
+		"snowflake_user_authentication_policy_attachment":    "security",
+
+		"snowflake_account":          "account",
+		"snowflake_account_role":     "account",
+		"snowflake_warehouse":        "account",
+		"snowflake_user":             "account",
+		"snowflake_resource_monitor": "account",
+		"snowflake_external_volume":  "account",
 	}
 )
@@ -0,0 +1,189 @@
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/crossplane/upjet/pkg/types/name"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// ExtractResourceName extracts the name of a referenced resource from its
+	// spec.forProvider.name. Snowflake resources are referenced by name, while
+	// their Terraform identifiers are quoted and qualified, e.g. "DB"."SCHEMA",
+	// so the default external name extractor cannot be used.
+	ExtractResourceName = `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("name",false)`
+
+	// ExtractResourceDatabase extracts the database of a referenced
+	// schema-level resource from its spec.forProvider.database.
+	ExtractResourceDatabase = `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("database",false)`
+
+	// ExtractResourceSchema extracts the schema of a referenced schema-level
+	// resource from its spec.forProvider.schema.
+	ExtractResourceSchema = `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("schema",false)`
+
+	// ExtractFullyQualifiedName extracts the fully qualified name of a
+	// referenced resource from its status.atProvider.fullyQualifiedName, for
+	// fields such as the predecessors of a task that take qualified names.
+	ExtractFullyQualifiedName = `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("fully_qualified_name",true)`
+
+	// ExtractStageLocation extracts the location of a referenced Stage, for
+	// fields such as the location of an external table. See StageLocation.
+	ExtractStageLocation = `github.com/allenkallz/provider-snowflake/config/common.StageLocation()`
+
+	// ExtractFileFormatName extracts a file format option naming a
+	// referenced FileFormat, for fields such as the file format of an
+	// external table. See FileFormatName.
+	ExtractFileFormatName = `github.com/allenkallz/provider-snowflake/config/common.FileFormatName()`
+)
+
+// StageLocation returns an extractor of the location of a stage, which is its
+// fully qualified name prefixed with @, e.g. @"DB"."SCHEMA"."STAGE".
+func StageLocation() reference.ExtractValueFn {
+	return prefixed("@", resource.ExtractParamPath("fully_qualified_name", true))
+}
+
+// FileFormatName returns an extractor of a FORMAT_NAME option naming a file
+// format by its fully qualified name, e.g. FORMAT_NAME = "DB"."SCHEMA"."CSV".
+func FileFormatName() reference.ExtractValueFn {
+	return prefixed("FORMAT_NAME = ", resource.ExtractParamPath("fully_qualified_name", true))
+}
+
+// prefixed returns an extractor that prefixes the values extracted by the
+// supplied extractor. Values that are not set yet are left empty, so that
+// the reference is resolved again once they are.
+func prefixed(prefix string, extract reference.ExtractValueFn) reference.ExtractValueFn {
+	return func(mg xpresource.Managed) string {
+		v := extract(mg)
+		if v == "" {
+			return ""
+		}
+		return prefix + v
+	}
+}
+
+// TagReferences makes the name, database and schema of the inline tag blocks
+// of a resource reference a Tag. All three are resolved from the same Tag
+// when their references name it.
+func TagReferences(r *config.Resource) {
+	r.References["tag.name"] = config.Reference{
+		TerraformName: "snowflake_tag",
+		Extractor:     ExtractResourceName,
+	}
+	r.References["tag.database"] = config.Reference{
+		TerraformName: "snowflake_tag",
+		Extractor:     ExtractResourceDatabase,
+	}
+	r.References["tag.schema"] = config.Reference{
+		TerraformName: "snowflake_tag",
+		Extractor:     ExtractResourceSchema,
+	}
+}
+
+// ConnectionDetails returns an AdditionalConnectionDetailsFn that publishes
+// the supplied attributes of a resource as connection details, keyed by their
+// Terraform names, so that compositions can pass them on to resources of
+// other providers. Attributes that are not set are left out.
+func ConnectionDetails(attributes ...string) config.AdditionalConnectionDetailsFn {
+	return func(attr map[string]any) (map[string][]byte, error) {
+		conn := map[string][]byte{}
+		for _, a := range attributes {
+			if v, ok := attr[a].(string); ok && v != "" {
+				conn[a] = []byte(v)
+			}
+		}
+		return conn, nil
+	}
+}
+
+// ConfigureArguments returns the supplied external name configuration,
+// changed to call fn with the parameters of a resource before its Terraform
+// configuration is built from them, with the values of sensitive parameters
+// read from their secrets. fn may change the parameters, for example to build
+// a Terraform argument from several spec fields.
+func ConfigureArguments(e config.ExternalName, fn func(parameters map[string]any)) config.ExternalName {
+	return ConfigureArgumentsFor(e, func(parameters map[string]any, _ string) {
+		fn(parameters)
+	})
+}
+
+// ConfigureArgumentsFor is ConfigureArguments for a fn that also needs the
+// external name of the resource.
+func ConfigureArgumentsFor(e config.ExternalName, fn func(parameters map[string]any, externalName string)) config.ExternalName {
+	setIdentifierArgument := e.SetIdentifierArgumentFn
+	e.SetIdentifierArgumentFn = func(base map[string]any, externalName string) {
+		fn(base, externalName)
+		setIdentifierArgument(base, externalName)
+	}
+	return e
+}
+
+// OmitArguments returns the supplied external name configuration, changed to
+// leave the supplied arguments out of the Terraform configuration of a
+// resource. It is meant for spec fields that are added to the Terraform
+// schema but reconciled by the provider itself, which Terraform would reject
+// as unsupported arguments.
+func OmitArguments(e config.ExternalName, arguments ...string) config.ExternalName {
+	return ConfigureArguments(e, func(parameters map[string]any) {
+		for _, a := range arguments {
+			delete(parameters, a)
+		}
+	})
+}
+
+// IdentifierFromParameters returns the supplied external name configuration,
+// changed to derive both the Terraform identifier and the external name of a
+// resource from its parameters with id, for resources whose identifiers are
+// deterministic but not just their names. The external name is derived from
+// the Terraform state rather than taken from the identifier Terraform
+// reports, so that it is the same however Terraform formats it. Resources
+// without an external name are looked up by the identifier of their
+// parameters before they are created.
+func IdentifierFromParameters(e config.ExternalName, id func(parameters map[string]any) (string, error)) config.ExternalName {
+	e.GetIDFn = func(_ context.Context, externalName string, parameters map[string]any, _ map[string]any) (string, error) {
+		if externalName != "" {
+			return externalName, nil
+		}
+		return id(parameters)
+	}
+	e.GetExternalNameFn = func(tfstate map[string]any) (string, error) {
+		return id(tfstate)
+	}
+	return e
+}
+
+// PrefixTypeNames makes the names of the Go types generated for the blocks of
+// a resource start with its kind, e.g. FunctionSQLArgumentsParameters rather
+// than ArgumentsParameters. Upjet otherwise names them after their blocks,
+// and renames the types of other kinds in the same API group with blocks of
+// the same names, which breaks the Go API of those kinds. It must be called
+// after the kind and the schema of the resource are configured.
+func PrefixTypeNames(r *config.Resource) {
+	if r.OverrideFieldNames == nil { //nolint:staticcheck // there are no previous API versions to load the names from
+		r.OverrideFieldNames = map[string]string{} //nolint:staticcheck // see above
+	}
+	prefixTypeNames(r, nil, r.TerraformResource.Schema)
+}
+
+func prefixTypeNames(r *config.Resource, path []string, s map[string]*schema.Schema) {
+	for field, fs := range s {
+		elem, ok := fs.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		p := append(append([]string{}, path...), name.NewFromSnake(field).Camel)
+		for _, suffix := range []string{"InitParameters", "Observation", "Parameters"} {
+			// Upjet prefixes the names of the parent blocks until the
+			// name is unique, so each of them could be generated.
+			for i := range p {
+				r.OverrideFieldNames[strings.Join(p[i:], "")+suffix] = r.Kind + strings.Join(p, "") + suffix //nolint:staticcheck // see above
+			}
+		}
+		prefixTypeNames(r, p, elem.Schema)
+	}
+}
@@ -1,6 +1,18 @@
 package database
 
-import "github.com/crossplane/upjet/pkg/config"
+import (
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+	"github.com/allenkallz/provider-snowflake/internal/function"
+	"github.com/allenkallz/provider-snowflake/internal/pipe"
+	"github.com/allenkallz/provider-snowflake/internal/protection"
+	"github.com/allenkallz/provider-snowflake/internal/refresh"
+	"github.com/allenkallz/provider-snowflake/internal/stage"
+	"github.com/allenkallz/provider-snowflake/internal/statement"
+	"github.com/allenkallz/provider-snowflake/internal/taskgraph"
+)
 
 // Configure configures individual resources by adding custom ResourceConfigurators.
 func Configure(p *config.Provider) {
@@ -10,6 +22,14 @@ func Configure(p *config.Provider) {
 		// We need to override the default group that upjet generated for
 		// r.ShortGroup = "database"
 		r.Kind = "Database"
+		r.References["external_volume"] = config.Reference{
+			TerraformName: "snowflake_external_volume",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Refuse to drop protected databases, see protection.AnnotationKeyDeletionProtection
+		r.InitializerFns = append(r.InitializerFns, protection.NewDeletionProtector)
+		// restore.NewDatabaseRestorer is added at runtime by
+		// controller.AddInitializers, as it depends on the generated APIs.
 	})
 
 	// DatabaseRole
@@ -25,11 +45,598 @@ func Configure(p *config.Provider) {
 	// Stage
 	p.AddResourceConfigurator("snowflake_stage", func(r *config.Resource) {
 		r.Kind = "Stage"
+		common.TagReferences(r)
+		r.References["storage_integration"] = config.Reference{
+			TerraformName: "snowflake_storage_integration",
+			Extractor:     common.ExtractResourceName,
+		}
+		// The location of an external stage, for example to configure event
+		// notifications for the pipes that load from it.
+		r.Sensitive.AdditionalConnectionDetailsFn = common.ConnectionDetails("url")
+		// Terraform takes credentials and encryption as free-form strings,
+		// which stage.Arguments builds from the fields below instead, so
+		// that secrets are only ever read from secrets. The strings are kept,
+		// deprecated, for Stages created before.
+		deprecated := map[string]string{
+			stage.ArgumentCredentials: "Deprecated: set awsKeyIdSecretRef, awsSecretKeySecretRef and awsTokenSecretRef, or azureSasTokenSecretRef, instead. Credentials of the stage, e.g. AWS_KEY_ID = '...' AWS_SECRET_KEY = '...'. Ignored if any of the fields that replace it is set.",
+			stage.ArgumentEncryption:  "Deprecated: set encryptionType, encryptionKmsKeyId and encryptionMasterKeySecretRef instead. Encryption settings of the stage, e.g. TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '...'. Ignored if any of the fields that replace it is set.",
+		}
+		for argument, description := range deprecated {
+			r.TerraformResource.Schema[argument].Description = description
+		}
+		arguments := map[string]*schema.Schema{
+			stage.ArgumentAWSKeyID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS access key ID of an external stage on S3, for accessing it without a storage integration.",
+			},
+			stage.ArgumentAWSSecretKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret access key of an external stage on S3.",
+			},
+			stage.ArgumentAWSToken: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS session token of an external stage on S3, for temporary credentials.",
+			},
+			stage.ArgumentAzureSASToken: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Shared access signature token of an external stage on Azure, for accessing it without a storage integration.",
+			},
+			stage.ArgumentEncryptionType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Encryption type of the files in the stage: SNOWFLAKE_FULL or SNOWFLAKE_SSE for internal stages, AWS_CSE, AWS_SSE_S3, AWS_SSE_KMS or NONE on S3, AZURE_CSE or NONE on Azure, and GCS_SSE_KMS or NONE on GCS.",
+			},
+			stage.ArgumentEncryptionKMSKeyID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the KMS key that encrypts the files in the stage, for AWS_SSE_KMS and GCS_SSE_KMS encryption.",
+			},
+			stage.ArgumentEncryptionMasterKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Master key, encoded in Base64, that encrypts the files in the stage, for AWS_CSE and AZURE_CSE encryption.",
+			},
+		}
+		for argument, s := range arguments {
+			r.TerraformResource.Schema[argument] = s
+		}
+		// Otherwise the generated docs of arguments ending in _id are taken
+		// from the docs of the id attribute.
+		if r.MetaResource != nil {
+			for argument, s := range r.TerraformResource.Schema {
+				if _, ok := arguments[argument]; !ok && deprecated[argument] == "" {
+					continue
+				}
+				kind := "(String)"
+				if s.Sensitive {
+					kind = "(String, Sensitive)"
+				}
+				r.MetaResource.ArgumentDocs[argument] = kind + " " + s.Description
+			}
+		}
+		r.ExternalName = common.ConfigureArguments(r.ExternalName, stage.Arguments)
+		// The examples scraped from the Terraform docs pass AWS credentials.
+		if r.MetaResource != nil {
+			for i := range r.MetaResource.Examples {
+				e := &r.MetaResource.Examples[i]
+				if _, err := e.Paved.GetValue(stage.ArgumentCredentials); err != nil {
+					continue
+				}
+				_ = e.Paved.DeleteField(stage.ArgumentCredentials)
+				_ = e.SetPathValue(stage.ArgumentAWSKeyID, "${var.example_aws_key_id}")
+				_ = e.SetPathValue(stage.ArgumentAWSSecretKey, "${var.example_aws_secret_key}")
+			}
+		}
+	})
+
+	// ExternalTable
+	p.AddResourceConfigurator("snowflake_external_table", func(r *config.Resource) {
+		r.Kind = "ExternalTable"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		// A referenced stage is the location of the whole stage. Set the
+		// location instead to load from a path in it, e.g. @STAGE/events/.
+		r.References["location"] = config.Reference{
+			TerraformName: "snowflake_stage",
+			Extractor:     common.ExtractStageLocation,
+		}
+		r.References["file_format"] = config.Reference{
+			TerraformName: "snowflake_file_format",
+			Extractor:     common.ExtractFileFormatName,
+		}
+		common.TagReferences(r)
+		// Keep the generated names of the column types of Table and the tag
+		// types of Stage, which were generated first. There are no previous
+		// API versions to load them from instead.
+		r.OverrideFieldNames = map[string]string{} //nolint:staticcheck // see above
+		for _, field := range []string{"Column", "Tag"} {
+			for _, suffix := range []string{"InitParameters", "Observation", "Parameters"} {
+				r.OverrideFieldNames[field+suffix] = "ExternalTable" + field + suffix
+			}
+		}
 	})
 
+	// ExternalFunction
+	p.AddResourceConfigurator("snowflake_external_function", func(r *config.Resource) {
+		r.Kind = "ExternalFunction"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["api_integration"] = config.Reference{
+			TerraformName: "snowflake_api_integration",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// Functions and procedures, with a kind per language
+	for _, l := range []struct{ language, kind string }{
+		{"sql", "SQL"},
+		{"python", "Python"},
+		{"java", "Java"},
+		{"javascript", "JavaScript"},
+		{"scala", "Scala"},
+	} {
+		p.AddResourceConfigurator("snowflake_function_"+l.language, func(r *config.Resource) {
+			r.Kind = "Function" + l.kind
+			configureFunction(r, "function_definition", function.ArgumentFunctionDefinitionConfigMapRef)
+		})
+		p.AddResourceConfigurator("snowflake_procedure_"+l.language, func(r *config.Resource) {
+			r.Kind = "Procedure" + l.kind
+			configureFunction(r, "procedure_definition", function.ArgumentProcedureDefinitionConfigMapRef)
+		})
+	}
+
 	// Pipe
 	p.AddResourceConfigurator("snowflake_pipe", func(r *config.Resource) {
 		r.Kind = "Pipe"
+		r.References["integration"] = config.Reference{
+			TerraformName: "snowflake_notification_integration",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["error_integration"] = config.Reference{
+			TerraformName: "snowflake_notification_integration",
+			Extractor:     common.ExtractResourceName,
+		}
+		// The queue that receives the event notifications of an auto-ingest
+		// pipe, which has to be configured on the bucket of its stage. The
+		// URL of the stage is published next to it by the pipestatus
+		// controller, see stage_url.
+		r.Sensitive.AdditionalConnectionDetailsFn = common.ConnectionDetails("notification_channel")
+		// Reconciled by pipe.Operator, as Terraform cannot pause a pipe
+		r.TerraformResource.Schema["execution_state"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Desired execution state of the pipe: RUNNING or PAUSED. Left unmanaged if not set. A pipe that Snowflake stopped, for example because its stage was dropped, is not resumed.",
+		}
+		r.ExternalName = common.OmitArguments(r.ExternalName, "execution_state")
+		// Filled in by pipe.Operator for refreshes requested with pipe.AnnotationKeyRefresh
+		r.TerraformResource.Schema["last_refresh_request"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Value of the snowflake.com/refresh annotation the latest refresh was run for.",
+		}
+		r.TerraformResource.Schema["last_refresh_time"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the latest refresh was run.",
+		}
+		r.TerraformResource.Schema["last_refresh_files"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of staged files the latest refresh queued for loading.",
+		}
+		r.TerraformResource.Schema["last_refresh_error"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Error of the latest refresh, if it failed.",
+		}
+		// Filled in from SYSTEM$PIPE_STATUS by the pipestatus controller,
+		// along with execution_state
+		r.TerraformResource.Schema["pending_file_count"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of files queued for loading by the pipe.",
+		}
+		r.TerraformResource.Schema["last_ingested_time"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the most recently loaded file was loaded.",
+		}
+		r.TerraformResource.Schema["last_error"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Latest error that keeps the pipe from loading files, such as a dropped or inaccessible table or stage.",
+		}
+		// Resolved from copy_statement by the pipestatus controller, which
+		// also publishes it as a connection detail
+		r.TerraformResource.Schema["stage_url"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "URL of the stage the pipe loads files from, empty for internal stages.",
+		}
+		r.InitializerFns = append(r.InitializerFns, pipe.NewOperator)
+	})
+
+	// Schema
+	p.AddResourceConfigurator("snowflake_schema", func(r *config.Resource) {
+		r.Kind = "Schema"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// Table
+	p.AddResourceConfigurator("snowflake_table", func(r *config.Resource) {
+		r.Kind = "Table"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		common.TagReferences(r)
+		// Refuse column drops and type narrowing, see protection.AnnotationKeyAllowDestructiveChanges
+		r.InitializerFns = append(r.InitializerFns, protection.NewTableGuard)
+	})
+
+	// View
+	p.AddResourceConfigurator("snowflake_view", func(r *config.Resource) {
+		r.Kind = "View"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Ignore whitespace and case differences in the statement returned by Snowflake
+		r.InitializerFns = append(r.InitializerFns, statement.NewRecorder)
+		r.ExternalName = common.ConfigureArgumentsFor(r.ExternalName, statement.Arguments)
+	})
+
+	// MaterializedView
+	p.AddResourceConfigurator("snowflake_materialized_view", func(r *config.Resource) {
+		r.Kind = "MaterializedView"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		common.TagReferences(r)
+		r.InitializerFns = append(r.InitializerFns, statement.NewRecorder)
+		r.ExternalName = common.ConfigureArgumentsFor(r.ExternalName, statement.Arguments)
+		// Keep the generated names of the tag types of Stage, which was
+		// generated before MaterializedView. There are no previous API
+		// versions to load them from instead.
+		r.OverrideFieldNames = map[string]string{} //nolint:staticcheck // see above
+		for _, suffix := range []string{"InitParameters", "Observation", "Parameters"} {
+			r.OverrideFieldNames["Tag"+suffix] = "MaterializedViewTag" + suffix //nolint:staticcheck // see above
+		}
+	})
+
+	// DynamicTable
+	p.AddResourceConfigurator("snowflake_dynamic_table", func(r *config.Resource) {
+		r.Kind = "DynamicTable"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["warehouse"] = config.Reference{
+			TerraformName: "snowflake_warehouse",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Filled in from the refresh history by refresh.DynamicTableObserver
+		r.TerraformResource.Schema["last_refresh_state"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the latest refresh that was not just scheduled: EXECUTING, SUCCEEDED, FAILED, CANCELLED or UPSTREAM_FAILED.",
+		}
+		r.TerraformResource.Schema["last_refresh_state_message"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the state of the latest refresh, such as the error of a failed refresh.",
+		}
+		r.TerraformResource.Schema["last_refresh_end_time"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the latest refresh completed.",
+		}
+		r.InitializerFns = append(r.InitializerFns, refresh.NewDynamicTableObserver)
+	})
+
+	// Task
+	p.AddResourceConfigurator("snowflake_task", func(r *config.Resource) {
+		r.Kind = "Task"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["warehouse"] = config.Reference{
+			TerraformName: "snowflake_warehouse",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["after"] = config.Reference{
+			TerraformName: "snowflake_task",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["finalize"] = config.Reference{
+			TerraformName: "snowflake_task",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["error_integration"] = config.Reference{
+			TerraformName: "snowflake_notification_integration",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Keep the root task suspended while its children change, see taskgraph.RootSuspender
+		r.InitializerFns = append(r.InitializerFns, taskgraph.NewRootSuspender)
+	})
+
+	// StreamOnTable
+	p.AddResourceConfigurator("snowflake_stream_on_table", func(r *config.Resource) {
+		r.Kind = "StreamOnTable"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["table"] = config.Reference{
+			TerraformName: "snowflake_table",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// StreamOnView
+	p.AddResourceConfigurator("snowflake_stream_on_view", func(r *config.Resource) {
+		r.Kind = "StreamOnView"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["view"] = config.Reference{
+			TerraformName: "snowflake_view",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
 	})
 
+	// StreamOnExternalTable
+	p.AddResourceConfigurator("snowflake_stream_on_external_table", func(r *config.Resource) {
+		r.Kind = "StreamOnExternalTable"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["external_table"] = config.Reference{
+			TerraformName: "snowflake_external_table",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// StreamOnDirectoryTable
+	p.AddResourceConfigurator("snowflake_stream_on_directory_table", func(r *config.Resource) {
+		r.Kind = "StreamOnDirectoryTable"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Snowflake only returns the name of the stage, so it must be in the
+		// same schema as the stream.
+		r.References["stage"] = config.Reference{
+			TerraformName: "snowflake_stage",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// Alert
+	p.AddResourceConfigurator("snowflake_alert", func(r *config.Resource) {
+		r.Kind = "Alert"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["warehouse"] = config.Reference{
+			TerraformName: "snowflake_warehouse",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// Sequence
+	p.AddResourceConfigurator("snowflake_sequence", func(r *config.Resource) {
+		r.Kind = "Sequence"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		// next_value advances every time the sequence is used. It is only
+		// computed, so it is reported in status.atProvider but is neither
+		// late initialized into the spec nor compared when planning.
+	})
+
+	// Tag
+	p.AddResourceConfigurator("snowflake_tag", func(r *config.Resource) {
+		r.Kind = "Tag"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["masking_policies"] = config.Reference{
+			TerraformName: "snowflake_masking_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// TagAssociation
+	p.AddResourceConfigurator("snowflake_tag_association", func(r *config.Resource) {
+		r.Kind = "TagAssociation"
+		r.References["tag_id"] = config.Reference{
+			TerraformName: "snowflake_tag",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// MaskingPolicy
+	p.AddResourceConfigurator("snowflake_masking_policy", func(r *config.Resource) {
+		r.Kind = "MaskingPolicy"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// RowAccessPolicy
+	p.AddResourceConfigurator("snowflake_row_access_policy", func(r *config.Resource) {
+		r.Kind = "RowAccessPolicy"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// TableColumnMaskingPolicyApplication
+	p.AddResourceConfigurator("snowflake_table_column_masking_policy_application", func(r *config.Resource) {
+		r.Kind = "TableColumnMaskingPolicyApplication"
+		r.References["table"] = config.Reference{
+			TerraformName: "snowflake_table",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["masking_policy"] = config.Reference{
+			TerraformName: "snowflake_masking_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+}
+
+// configureFunction configures a function or procedure of any language,
+// whose definition is in the supplied argument, or in the ConfigMap key
+// referenced by the supplied ref argument.
+func configureFunction(r *config.Resource, definition, ref string) {
+	r.References["database"] = config.Reference{
+		TerraformName: "snowflake_database",
+		Extractor:     common.ExtractResourceName,
+	}
+	r.References["schema"] = config.Reference{
+		TerraformName: "snowflake_schema",
+		Extractor:     common.ExtractResourceName,
+	}
+	// The stages holding the code of functions and procedures that run on
+	// the JVM or Python, and the JAR that Scala and Java code is built into.
+	for _, block := range []string{"imports", "target_path"} {
+		if _, ok := r.TerraformResource.Schema[block]; ok {
+			r.References[block+".stage_location"] = config.Reference{
+				TerraformName: "snowflake_stage",
+				Extractor:     common.ExtractFullyQualifiedName,
+			}
+		}
+	}
+	// Overloads are told apart by their argument types, see function.Identifier
+	r.ExternalName = common.IdentifierFromParameters(r.ExternalName, function.Identifier)
+
+	// Loaded by function.DefinitionLoader, so the definition is no longer
+	// required where Terraform requires it, and never late-initialized from
+	// the loaded one.
+	r.TerraformResource.Schema[definition].Required = false
+	r.TerraformResource.Schema[definition].Optional = true
+	r.LateInitializer.IgnoredFields = append(r.LateInitializer.IgnoredFields, definition)
+	r.TerraformResource.Schema[ref] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Reference to the ConfigMap key to load the definition from, instead of setting it inline.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the ConfigMap.",
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Namespace of the ConfigMap.",
+				},
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key of the definition in the ConfigMap.",
+				},
+			},
+		},
+	}
+	r.SchemaElementOptions.SetEmbeddedObject(ref)
+	r.ExternalName = common.OmitArguments(r.ExternalName, ref)
+	r.ExternalName = common.ConfigureArguments(r.ExternalName, function.Arguments(definition, ref))
+	r.InitializerFns = append(r.InitializerFns, function.NewDefinitionLoader)
+	// There are ten kinds with the same blocks, and other kinds in the API
+	// group have blocks such as parameters too.
+	common.PrefixTypeNames(r)
 }
@@ -11,15 +11,68 @@ import "github.com/crossplane/upjet/pkg/config"
 var ExternalNameConfigs = map[string]config.ExternalName{
 	// Import requires using a randomly generated ID from provider: nl-2e21sda
 	// Database
-	"snowflake_database":      config.IdentifierFromProvider,
-	"snowflake_file_format":   config.IdentifierFromProvider,
-	"snowflake_database_role": config.IdentifierFromProvider,
-	"snowflake_stage":         config.IdentifierFromProvider,
-	"snowflake_pipe":          config.IdentifierFromProvider,
+	"snowflake_database":                                config.IdentifierFromProvider,
+	"snowflake_file_format":                             config.IdentifierFromProvider,
+	"snowflake_database_role":                           config.IdentifierFromProvider,
+	"snowflake_stage":                                   config.IdentifierFromProvider,
+	"snowflake_pipe":                                    config.IdentifierFromProvider,
+	"snowflake_external_table":                          config.IdentifierFromProvider,
+	"snowflake_external_function":                       config.IdentifierFromProvider,
+	"snowflake_function_sql":                            config.IdentifierFromProvider,
+	"snowflake_function_python":                         config.IdentifierFromProvider,
+	"snowflake_function_java":                           config.IdentifierFromProvider,
+	"snowflake_function_javascript":                     config.IdentifierFromProvider,
+	"snowflake_function_scala":                          config.IdentifierFromProvider,
+	"snowflake_procedure_sql":                           config.IdentifierFromProvider,
+	"snowflake_procedure_python":                        config.IdentifierFromProvider,
+	"snowflake_procedure_java":                          config.IdentifierFromProvider,
+	"snowflake_procedure_javascript":                    config.IdentifierFromProvider,
+	"snowflake_procedure_scala":                         config.IdentifierFromProvider,
+	"snowflake_schema":                                  config.IdentifierFromProvider,
+	"snowflake_table":                                   config.IdentifierFromProvider,
+	"snowflake_view":                                    config.IdentifierFromProvider,
+	"snowflake_materialized_view":                       config.IdentifierFromProvider,
+	"snowflake_dynamic_table":                           config.IdentifierFromProvider,
+	"snowflake_task":                                    config.IdentifierFromProvider,
+	"snowflake_stream_on_table":                         config.IdentifierFromProvider,
+	"snowflake_stream_on_view":                          config.IdentifierFromProvider,
+	"snowflake_stream_on_external_table":                config.IdentifierFromProvider,
+	"snowflake_stream_on_directory_table":               config.IdentifierFromProvider,
+	"snowflake_alert":                                   config.IdentifierFromProvider,
+	"snowflake_sequence":                                config.IdentifierFromProvider,
+	"snowflake_tag":                                     config.IdentifierFromProvider,
+	"snowflake_tag_association":                         config.IdentifierFromProvider,
+	"snowflake_masking_policy":                          config.IdentifierFromProvider,
+	"snowflake_row_access_policy":                       config.IdentifierFromProvider,
+	"snowflake_table_column_masking_policy_application": config.IdentifierFromProvider,
+	"snowflake_email_notification_integration":          config.IdentifierFromProvider,
+	"snowflake_notification_integration":                config.IdentifierFromProvider,
+	"snowflake_storage_integration":                     config.IdentifierFromProvider,
+	"snowflake_api_integration":                         config.IdentifierFromProvider,
+	"snowflake_network_rule":                            config.IdentifierFromProvider,
+	"snowflake_network_policy":                          config.IdentifierFromProvider,
+	"snowflake_network_policy_attachment":               config.IdentifierFromProvider,
 
 	// Account
-	"snowflake_account":      config.IdentifierFromProvider,
-	"snowflake_account_role": config.IdentifierFromProvider,
+	"snowflake_account":          config.IdentifierFromProvider,
+	"snowflake_account_role":     config.IdentifierFromProvider,
+	"snowflake_warehouse":        config.IdentifierFromProvider,
+	"snowflake_user":             config.IdentifierFromProvider,
+	"snowflake_resource_monitor": config.IdentifierFromProvider,
+	"snowflake_external_volume":  config.IdentifierFromProvider,
+
+	// Security
+	"snowflake_password_policy":       config.IdentifierFromProvider,
+	"snowflake_authentication_policy": config.IdentifierFromProvider,
+	// The identifiers of policy attachments are derived from what they
+	// attach: database|schema|policy for the account, and
+	// "user"|"database"."schema"."policy" for users, see
+	// config/security. Existing attachments can be imported by setting
+	// these as external names.
+	"snowflake_account_password_policy_attachment":       config.IdentifierFromProvider,
+	"snowflake_account_authentication_policy_attachment": config.IdentifierFromProvider,
+	"snowflake_user_password_policy_attachment":          config.IdentifierFromProvider,
+	"snowflake_user_authentication_policy_attachment":    config.IdentifierFromProvider,
 }
 
 // ExternalNameConfigurations applies all external name configs listed in the
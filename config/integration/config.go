@@ -0,0 +1,45 @@
+package integration
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	// EmailNotificationIntegration
+	p.AddResourceConfigurator("snowflake_email_notification_integration", func(r *config.Resource) {
+		r.Kind = "EmailNotificationIntegration"
+	})
+
+	// NotificationIntegration
+	p.AddResourceConfigurator("snowflake_notification_integration", func(r *config.Resource) {
+		r.Kind = "NotificationIntegration"
+	})
+
+	// StorageIntegration
+	p.AddResourceConfigurator("snowflake_storage_integration", func(r *config.Resource) {
+		r.Kind = "StorageIntegration"
+		// The identities Snowflake uses to access the storage, which have to
+		// be trusted on the cloud provider side.
+		r.Sensitive.AdditionalConnectionDetailsFn = common.ConnectionDetails(
+			"storage_aws_iam_user_arn",
+			"storage_aws_external_id",
+			"storage_gcp_service_account",
+			"azure_consent_url",
+			"azure_multi_tenant_app_name",
+		)
+	})
+
+	// ApiIntegration
+	p.AddResourceConfigurator("snowflake_api_integration", func(r *config.Resource) {
+		r.Kind = "ApiIntegration"
+		// The identity Snowflake uses to call the proxy service, which has to
+		// be trusted by the role in api_aws_role_arn.
+		r.Sensitive.AdditionalConnectionDetailsFn = common.ConnectionDetails(
+			"api_aws_iam_user_arn",
+			"api_aws_external_id",
+		)
+	})
+}
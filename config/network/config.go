@@ -0,0 +1,54 @@
+package network
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+	"github.com/allenkallz/provider-snowflake/internal/networkpolicy"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	// NetworkRule
+	p.AddResourceConfigurator("snowflake_network_rule", func(r *config.Resource) {
+		r.Kind = "NetworkRule"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// NetworkPolicy
+	p.AddResourceConfigurator("snowflake_network_policy", func(r *config.Resource) {
+		r.Kind = "NetworkPolicy"
+		r.References["allowed_network_rule_list"] = config.Reference{
+			TerraformName: "snowflake_network_rule",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["blocked_network_rule_list"] = config.Reference{
+			TerraformName: "snowflake_network_rule",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// NetworkPolicyAttachment
+	p.AddResourceConfigurator("snowflake_network_policy_attachment", func(r *config.Resource) {
+		r.Kind = "NetworkPolicyAttachment"
+		// Derived from the policy, see networkpolicy.AttachmentIdentifier
+		r.ExternalName = common.IdentifierFromParameters(r.ExternalName, networkpolicy.AttachmentIdentifier)
+		r.References["network_policy_name"] = config.Reference{
+			TerraformName: "snowflake_network_policy",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["users"] = config.Reference{
+			TerraformName: "snowflake_user",
+			Extractor:     common.ExtractResourceName,
+		}
+		// Refuse to lock the provider out, see networkpolicy.AnnotationKeyAllowSelfLockout
+		r.InitializerFns = append(r.InitializerFns, networkpolicy.NewGuard)
+	})
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/allenkallz/provider-snowflake/config/account"
 	"github.com/allenkallz/provider-snowflake/config/database"
+	"github.com/allenkallz/provider-snowflake/config/integration"
+	"github.com/allenkallz/provider-snowflake/config/network"
+	"github.com/allenkallz/provider-snowflake/config/security"
 	ujconfig "github.com/crossplane/upjet/pkg/config"
 )
 
@@ -44,6 +47,9 @@ func GetProvider() *ujconfig.Provider {
 		// add custom config functions
 		database.Configure,
 		account.Configure,
+		integration.Configure,
+		network.Configure,
+		security.Configure,
 	} {
 		configure(pc)
 	}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+// terraformed is the part of a generated managed resource the Terraform
+// configuration is built from.
+type terraformed interface {
+	resource.Managed
+	GetParameters() (map[string]any, error)
+}
+
+func TestEquivalentStatement(t *testing.T) {
+	const (
+		desired  = "select id\n  from events"
+		observed = "SELECT id FROM events"
+	)
+	view := &v1alpha1.View{}
+	view.Spec.ForProvider.Statement = ptr(desired)
+	view.Status.AtProvider.Statement = ptr(observed)
+	materialized := &v1alpha1.MaterializedView{}
+	materialized.Spec.ForProvider.Statement = ptr(desired)
+	materialized.Status.AtProvider.Statement = ptr(observed)
+
+	cases := map[string]struct {
+		reason   string
+		resource string
+		mg       terraformed
+	}{
+		"View": {
+			reason:   "Terraform should be given the observed statement of a View while it is equivalent to the desired one.",
+			resource: "snowflake_view",
+			mg:       view,
+		},
+		"MaterializedView": {
+			reason:   "Terraform should be given the observed statement of a MaterializedView while it is equivalent to the desired one.",
+			resource: "snowflake_materialized_view",
+			mg:       materialized,
+		},
+	}
+	p := GetProvider()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			meta.SetExternalName(tc.mg, name)
+			before := tc.mg.DeepCopyObject()
+			r := p.Resources[tc.resource]
+			for _, fn := range r.InitializerFns {
+				if err := fn(nil).Initialize(context.Background(), tc.mg); err != nil {
+					t.Fatalf("\n%s\nInitialize(...): %v", tc.reason, err)
+				}
+			}
+			if diff := cmp.Diff(before, tc.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): resource changed, -want, +got:\n%s", tc.reason, diff)
+			}
+			parameters, err := tc.mg.GetParameters()
+			if err != nil {
+				t.Fatal(errors.Wrap(err, "cannot get parameters"))
+			}
+			r.ExternalName.SetIdentifierArgumentFn(parameters, name)
+			if diff := cmp.Diff(observed, parameters["statement"]); diff != "" {
+				t.Errorf("\n%s\nSetIdentifierArgumentFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,89 @@
+package security
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+	"github.com/allenkallz/provider-snowflake/internal/policy"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	// PasswordPolicy
+	p.AddResourceConfigurator("snowflake_password_policy", func(r *config.Resource) {
+		r.Kind = "PasswordPolicy"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// AuthenticationPolicy
+	p.AddResourceConfigurator("snowflake_authentication_policy", func(r *config.Resource) {
+		r.Kind = "AuthenticationPolicy"
+		r.References["database"] = config.Reference{
+			TerraformName: "snowflake_database",
+			Extractor:     common.ExtractResourceName,
+		}
+		r.References["schema"] = config.Reference{
+			TerraformName: "snowflake_schema",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// AccountPasswordPolicyAttachment
+	p.AddResourceConfigurator("snowflake_account_password_policy_attachment", func(r *config.Resource) {
+		r.Kind = "AccountPasswordPolicyAttachment"
+		// Derived from the policy, see policy.AccountAttachmentIdentifier
+		r.ExternalName = common.IdentifierFromParameters(r.ExternalName, policy.AccountAttachmentIdentifier("password_policy"))
+		r.References["password_policy"] = config.Reference{
+			TerraformName: "snowflake_password_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// AccountAuthenticationPolicyAttachment
+	p.AddResourceConfigurator("snowflake_account_authentication_policy_attachment", func(r *config.Resource) {
+		r.Kind = "AccountAuthenticationPolicyAttachment"
+		// Derived from the policy, see policy.AccountAttachmentIdentifier
+		r.ExternalName = common.IdentifierFromParameters(r.ExternalName, policy.AccountAttachmentIdentifier("authentication_policy"))
+		r.References["authentication_policy"] = config.Reference{
+			TerraformName: "snowflake_authentication_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+	})
+
+	// UserPasswordPolicyAttachment
+	p.AddResourceConfigurator("snowflake_user_password_policy_attachment", func(r *config.Resource) {
+		r.Kind = "UserPasswordPolicyAttachment"
+		// Derived from the user and policy, see policy.UserAttachmentIdentifier
+		r.ExternalName = common.IdentifierFromParameters(r.ExternalName, policy.UserAttachmentIdentifier("password_policy_name"))
+		r.References["password_policy_name"] = config.Reference{
+			TerraformName: "snowflake_password_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["user_name"] = config.Reference{
+			TerraformName: "snowflake_user",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+
+	// UserAuthenticationPolicyAttachment
+	p.AddResourceConfigurator("snowflake_user_authentication_policy_attachment", func(r *config.Resource) {
+		r.Kind = "UserAuthenticationPolicyAttachment"
+		// Derived from the user and policy, see policy.UserAttachmentIdentifier
+		r.ExternalName = common.IdentifierFromParameters(r.ExternalName, policy.UserAttachmentIdentifier("authentication_policy_name"))
+		r.References["authentication_policy_name"] = config.Reference{
+			TerraformName: "snowflake_authentication_policy",
+			Extractor:     common.ExtractFullyQualifiedName,
+		}
+		r.References["user_name"] = config.Reference{
+			TerraformName: "snowflake_user",
+			Extractor:     common.ExtractResourceName,
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Package fake provides a fake SQLClient for tests.
+package fake
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+// A SQLClient answers queries from canned rows and records the queries and
+// statements it runs.
+type SQLClient struct {
+	// Rows are the rows returned per query.
+	Rows map[string][]map[string]string
+
+	// Err is returned by queries that have no canned rows.
+	Err error
+
+	// Queries are the queries run so far, in order.
+	Queries []string
+
+	// Execs are the statements run so far, in order.
+	Execs []string
+}
+
+// Query returns the canned rows of the supplied query, or Err if there are
+// none.
+func (c *SQLClient) Query(_ context.Context, query string, _ ...any) ([]map[string]string, error) {
+	c.Queries = append(c.Queries, query)
+	if rows, ok := c.Rows[query]; ok || c.Err == nil {
+		return rows, nil
+	}
+	return nil, c.Err
+}
+
+// Exec records the supplied statement.
+func (c *SQLClient) Exec(_ context.Context, query string, _ ...any) error {
+	c.Execs = append(c.Execs, query)
+	return nil
+}
+
+// Close does nothing.
+func (c *SQLClient) Close() error { return nil }
+
+// NewSQLClientFn returns a clients.SQLClientFn that always returns the
+// supplied SQLClient.
+func NewSQLClientFn(c *SQLClient) clients.SQLClientFn {
+	return func(context.Context, client.Client, resource.Managed) (clients.SQLClient, error) {
+		return c, nil
+	}
+}
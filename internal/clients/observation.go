@@ -0,0 +1,54 @@
+package clients
+
+import (
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	errSetObservationFn = "cannot set %s"
+	errConvertManaged   = "cannot convert paved object to managed resource"
+)
+
+// FullyQualifiedName joins the supplied names as a quoted, dot separated
+// identifier, e.g. "DB"."SCHEMA"."NAME".
+func FullyQualifiedName(names ...string) string {
+	q := make([]string, len(names))
+	for i, n := range names {
+		q[i] = QuoteIdentifier(n)
+	}
+	return strings.Join(q, ".")
+}
+
+// SchemaObjectName returns the quoted, fully qualified name of the schema
+// object with the supplied paved managed resource, from the database, schema
+// and name of its spec.forProvider. It returns false if any of them is not
+// set.
+func SchemaObjectName(p *fieldpath.Paved) (string, bool) {
+	names := make([]string, 0, 3)
+	for _, field := range []string{"spec.forProvider.database", "spec.forProvider.schema", "spec.forProvider.name"} {
+		n, _ := p.GetString(field)
+		if n == "" {
+			return "", false
+		}
+		names = append(names, n)
+	}
+	return FullyQualifiedName(names...), true
+}
+
+// SetObservation sets the supplied fields of the supplied managed resource,
+// which was paved as p, for initializers that record what they observe in the
+// status. The fields should not be part of the Terraform state, so that they
+// survive the observation.
+func SetObservation(mg resource.Managed, p *fieldpath.Paved, observation map[string]any) error {
+	for field, value := range observation {
+		if err := p.SetValue(field, value); err != nil {
+			return errors.Wrapf(err, errSetObservationFn, field)
+		}
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(p.UnstructuredContent(), mg), errConvertManaged)
+}
@@ -0,0 +1,65 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSchemaObjectName(t *testing.T) {
+	cases := map[string]struct {
+		forProvider map[string]any
+		want        string
+		wantOK      bool
+	}{
+		"Set": {
+			forProvider: map[string]any{"database": "ANALYTICS", "schema": "RAW", "name": `my"pipe`},
+			want:        `"ANALYTICS"."RAW"."my""pipe"`,
+			wantOK:      true,
+		},
+		"NoSchema": {
+			forProvider: map[string]any{"database": "ANALYTICS", "name": "EVENTS_PIPE"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := fieldpath.Pave(map[string]any{"spec": map[string]any{"forProvider": tc.forProvider}})
+			got, ok := SchemaObjectName(p)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("SchemaObjectName(...): want %s, %t, got %s, %t", tc.want, tc.wantOK, got, ok)
+			}
+		})
+	}
+}
+
+type observed struct {
+	fake.Managed
+	Status struct {
+		AtProvider struct {
+			State string `json:"state,omitempty"`
+			Files int64  `json:"files,omitempty"`
+		} `json:"atProvider"`
+	} `json:"status"`
+}
+
+func TestSetObservation(t *testing.T) {
+	mg := &observed{}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetObservation(mg, p, map[string]any{
+		"status.atProvider.state": "RUNNING",
+		"status.atProvider.files": int64(3),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("RUNNING", mg.Status.AtProvider.State); diff != "" {
+		t.Errorf("SetObservation(...): -want state, +got state:\n%s", diff)
+	}
+	if diff := cmp.Diff(int64(3), mg.Status.AtProvider.Files); diff != "" {
+		t.Errorf("SetObservation(...): -want files, +got files:\n%s", diff)
+	}
+}
@@ -0,0 +1,431 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	databasev1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/apis/v1beta1"
+)
+
+const (
+	testVersion         = "1.5.7"
+	testProviderSource  = "Snowflake-Labs/snowflake"
+	testProviderVersion = "1.0.5"
+
+	testConfigName = "default"
+	testSecretName = "snowflake-creds"
+	testNamespace  = "crossplane-system"
+	testSecretKey  = "credentials"
+	testUID        = "3b2ea6b6-6f39-4ae4-a0a0-29b7d1f04e4c"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		corev1.AddToScheme,
+		v1beta1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
+	} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func secretSelectors() xpv1.CommonCredentialSelectors {
+	return xpv1.CommonCredentialSelectors{
+		SecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: testSecretName, Namespace: testNamespace},
+			Key:             testSecretKey,
+		},
+	}
+}
+
+func providerConfig(auth v1beta1.SnowflakeAuth, source xpv1.CredentialsSource, sel xpv1.CommonCredentialSelectors) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: testConfigName},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{Source: source, CommonCredentialSelectors: sel},
+			Auth:        auth,
+		},
+	}
+}
+
+func credentialsSecret(data string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: testNamespace},
+		Data:       map[string][]byte{testSecretKey: []byte(data)},
+	}
+}
+
+func managedDatabase(ref *xpv1.Reference) *databasev1alpha1.Database {
+	return &databasev1alpha1.Database{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databasev1alpha1.Database_GroupVersionKind.GroupVersion().String(),
+			Kind:       databasev1alpha1.Database_Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "db", UID: types.UID(testUID)},
+		Spec: databasev1alpha1.DatabaseSpec{
+			ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: ref},
+		},
+	}
+}
+
+func snowflakeAuth(t v1beta1.AuthMethodType) v1beta1.SnowflakeAuth {
+	return v1beta1.SnowflakeAuth{AuthType: t, AccountName: "ab12345.eu-central-1", OrganizationName: "MYORG"}
+}
+
+func TestTerraformSetupBuilder(t *testing.T) {
+	defaultRef := &xpv1.Reference{Name: testConfigName}
+
+	type args struct {
+		objects []client.Object
+		mg      *databasev1alpha1.Database
+		env     map[string]string
+		file    string
+	}
+	type want struct {
+		configuration map[string]any
+		err           error
+		tracked       bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoProviderConfigRef": {
+			reason: "A managed resource without a providerConfigRef should return an error.",
+			args:   args{mg: managedDatabase(nil)},
+			want:   want{err: errors.New(errNoProviderConfig)},
+		},
+		"ProviderConfigNotFound": {
+			reason: "A reference to a ProviderConfig that does not exist should return an error.",
+			args:   args{mg: managedDatabase(defaultRef)},
+			want: want{err: errors.Wrap(kerrors.NewNotFound(
+				schema.GroupResource{Group: v1beta1.Group, Resource: "providerconfigs"}, testConfigName), errGetProviderConfig)},
+		},
+		"MissingAccountName": {
+			reason: "A ProviderConfig without an account name should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(v1beta1.SnowflakeAuth{AuthType: v1beta1.AuthMethodSnowflake, OrganizationName: "MYORG"}, xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u","password":"p"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'accountName' is required in provider config spec."),
+				tracked: true,
+			},
+		},
+		"MissingOrganizationName": {
+			reason: "A ProviderConfig without an organization name should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(v1beta1.SnowflakeAuth{AuthType: v1beta1.AuthMethodSnowflake, AccountName: "ab12345"}, xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u","password":"p"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'organizationName' is required in provider config spec."),
+				tracked: true,
+			},
+		},
+		"SecretNotFound": {
+			reason: "A ProviderConfig referencing a Secret that does not exist should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceSecret, secretSelectors()),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(kerrors.NewNotFound(
+					schema.GroupResource{Resource: "secrets"}, testSecretName), "cannot get credentials secret"), errExtractCredentials),
+				tracked: true,
+			},
+		},
+		"MalformedJSON": {
+			reason: "Credentials that are not a JSON object should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.Wrap(errors.New("unexpected end of JSON input"), errUnmarshalCredentials),
+				tracked: true,
+			},
+		},
+		"NoneSource": {
+			reason: "The None credentials source provides no credentials document to unmarshal.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceNone, xpv1.CommonCredentialSelectors{}),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.Wrap(errors.New("unexpected end of JSON input"), errUnmarshalCredentials),
+				tracked: true,
+			},
+		},
+		"UnsupportedAuthType": {
+			reason: "An unknown authentication method should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth("OAuth"), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("unsupported authentication method: OAuth"),
+				tracked: true,
+			},
+		},
+		"SnowflakeMissingPassword": {
+			reason: "Snowflake authentication without a password should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'password' is required for snowflake authentication."),
+				tracked: true,
+			},
+		},
+		"SnowflakeMissingUsername": {
+			reason: "Snowflake authentication without a username should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"password":"p"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'username' is required for snowflake authentication."),
+				tracked: true,
+			},
+		},
+		"SnowflakeFromSecret": {
+			reason: "Snowflake authentication should configure the user and password from a Secret.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(` {"username":"u","password":"p","warehouse":"WH"} `),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				configuration: map[string]any{
+					keyOrganizationName: "MYORG",
+					keyAccountName:      "AB12345-EU-CENTRAL-1",
+					keyWarehouse:        "WH",
+					keyUser:             "u",
+					keyPassword:         "p",
+					keyAuthenticator:    SnowflakeAuthenticator,
+				},
+				tracked: true,
+			},
+		},
+		"SnowflakeFromEnvironment": {
+			reason: "Credentials should be read from an environment variable.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceEnvironment, xpv1.CommonCredentialSelectors{
+						Env: &xpv1.EnvSelector{Name: "SNOWFLAKE_TEST_CREDENTIALS"},
+					}),
+				},
+				mg:  managedDatabase(defaultRef),
+				env: map[string]string{"SNOWFLAKE_TEST_CREDENTIALS": `{"username":"u","password":"p"}`},
+			},
+			want: want{
+				configuration: map[string]any{
+					keyOrganizationName: "MYORG",
+					keyAccountName:      "AB12345-EU-CENTRAL-1",
+					keyWarehouse:        "",
+					keyUser:             "u",
+					keyPassword:         "p",
+					keyAuthenticator:    SnowflakeAuthenticator,
+				},
+				tracked: true,
+			},
+		},
+		"SnowflakeFromFilesystem": {
+			reason: "Credentials should be read from a file.",
+			args: args{
+				mg:   managedDatabase(defaultRef),
+				file: `{"username":"u","password":"p"}`,
+			},
+			want: want{
+				configuration: map[string]any{
+					keyOrganizationName: "MYORG",
+					keyAccountName:      "AB12345-EU-CENTRAL-1",
+					keyWarehouse:        "",
+					keyUser:             "u",
+					keyPassword:         "p",
+					keyAuthenticator:    SnowflakeAuthenticator,
+				},
+				tracked: true,
+			},
+		},
+		"JWTMissingPrivateKey": {
+			reason: "JWT authentication without a private key should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodJWT), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'privateKey' is required for JWT authentication."),
+				tracked: true,
+			},
+		},
+		"JWTMissingUsername": {
+			reason: "JWT authentication without a username should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodJWT), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"private_key":"KEY"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'username' is required for JWT authentication."),
+				tracked: true,
+			},
+		},
+		"JWT": {
+			reason: "JWT authentication should configure the user, private key and role.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodJWT), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u","private_key":"KEY","role":"SYSADMIN","warehouse":"WH"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				configuration: map[string]any{
+					keyOrganizationName: "MYORG",
+					keyAccountName:      "AB12345-EU-CENTRAL-1",
+					keyWarehouse:        "WH",
+					keyUser:             "u",
+					keyPrivateKey:       "KEY",
+					keyRole:             "SYSADMIN",
+					keyAuthenticator:    JwtAuthenticator,
+				},
+				tracked: true,
+			},
+		},
+		"PrivateKeyPassphraseMissingPassphrase": {
+			reason: "Private key passphrase authentication without a passphrase should return an error.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodPrivateKeyPassphrase), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u","private_key":"KEY"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				err:     errors.New("snowflake 'privateKeyPassphrase' is required for private key passphrase authentication."),
+				tracked: true,
+			},
+		},
+		"PrivateKeyPassphrase": {
+			reason: "Private key passphrase authentication should configure the passphrase and use the JWT authenticator.",
+			args: args{
+				objects: []client.Object{
+					providerConfig(snowflakeAuth(v1beta1.AuthMethodPrivateKeyPassphrase), xpv1.CredentialsSourceSecret, secretSelectors()),
+					credentialsSecret(`{"username":"u","private_key":"KEY","private_key_passphrase":"secret","role":"SYSADMIN"}`),
+				},
+				mg: managedDatabase(defaultRef),
+			},
+			want: want{
+				configuration: map[string]any{
+					keyOrganizationName:     "MYORG",
+					keyAccountName:          "AB12345-EU-CENTRAL-1",
+					keyWarehouse:            "",
+					keyUser:                 "u",
+					keyPrivateKey:           "KEY",
+					keyPrivateKeyPassphrase: "secret",
+					keyRole:                 "SYSADMIN",
+					keyAuthenticator:        JwtAuthenticator,
+				},
+				tracked: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.args.env {
+				t.Setenv(k, v)
+			}
+			objects := tc.args.objects
+			if tc.args.file != "" {
+				path := filepath.Join(t.TempDir(), "credentials.json")
+				if err := os.WriteFile(path, []byte(tc.args.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				objects = append(objects, providerConfig(snowflakeAuth(v1beta1.AuthMethodSnowflake), xpv1.CredentialsSourceFilesystem, xpv1.CommonCredentialSelectors{
+					Fs: &xpv1.FsSelector{Path: path},
+				}))
+			}
+			kube := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(objects...).Build()
+
+			fn := TerraformSetupBuilder(testVersion, testProviderSource, testProviderVersion)
+			got, err := fn(context.Background(), kube, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err == nil {
+				want := terraform.Setup{
+					Version:       testVersion,
+					Requirement:   terraform.ProviderRequirement{Source: testProviderSource, Version: testProviderVersion},
+					Configuration: tc.want.configuration,
+				}
+				if diff := cmp.Diff(want, got, cmp.AllowUnexported(terraform.Setup{})); diff != "" {
+					t.Errorf("\n%s\nTerraformSetupBuilder(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+
+			pcu := &v1beta1.ProviderConfigUsage{}
+			err = kube.Get(context.Background(), types.NamespacedName{Name: testUID}, pcu)
+			if tc.want.tracked != (err == nil) {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): want usage tracked %t, got error %v", tc.reason, tc.want.tracked, err)
+			}
+			if err == nil && pcu.ProviderConfigReference.Name != testConfigName {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): want usage of %q, got %q", tc.reason, testConfigName, pcu.ProviderConfigReference.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package clients
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/youmark/pkcs8"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errQuery             = "cannot run query"
+	errExec              = "cannot run statement"
+	errScanRow           = "cannot scan row"
+	errDecodePrivateKey  = "cannot decode private key: no PEM block found"
+	errParsePrivateKey   = "cannot parse private key"
+	errPrivateKeyNotRSA  = "private key is not an RSA key"
+	errUnsupportedAuthFn = "unsupported authenticator %q"
+	errHashConfiguration = "cannot hash provider configuration"
+
+	sqlApplication = "crossplane-provider-snowflake"
+)
+
+// A SQLClient runs SQL statements against Snowflake. It covers the operations
+// the Terraform provider does not offer, such as UNDROP or ALTER PIPE.
+type SQLClient interface {
+	// Query runs a statement that returns rows, such as SHOW or SELECT. Each
+	// row is returned as a map of lower-cased column names to their values,
+	// with NULL values returned as empty strings.
+	Query(ctx context.Context, query string, args ...any) ([]map[string]string, error)
+
+	// Exec runs a statement that does not return rows.
+	Exec(ctx context.Context, query string, args ...any) error
+
+	// Close releases the connections held by the client. It does nothing for
+	// the shared clients returned by NewSQLClient.
+	Close() error
+}
+
+// A SQLClientFn returns a SQLClient authenticated with the ProviderConfig
+// referenced by the supplied managed resource.
+type SQLClientFn func(ctx context.Context, kube client.Client, mg resource.Managed) (SQLClient, error)
+
+// sqlClients are the clients returned by NewSQLClient.
+var sqlClients = newClientCache(NewSQLClientFromConfiguration)
+
+// NewSQLClient returns a SQLClient authenticated with the ProviderConfig
+// referenced by the supplied managed resource. The client is shared by all
+// managed resources that use the same ProviderConfig, so that each poll does
+// not log in to Snowflake again, and is replaced when the credentials of the
+// ProviderConfig change. Closing it does nothing.
+func NewSQLClient(ctx context.Context, kube client.Client, mg resource.Managed) (SQLClient, error) {
+	providerConfig, err := getProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	data, err := resource.CommonCredentialExtractor(ctx, providerConfig.Spec.Credentials.Source, kube, providerConfig.Spec.Credentials.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractCredentials)
+	}
+	configuration, err := Configuration(providerConfig.Spec.Auth, data)
+	if err != nil {
+		return nil, err
+	}
+	return sqlClients.get(providerConfig.GetName(), configuration)
+}
+
+// A clientCache caches a SQLClient per ProviderConfig, together with a hash of
+// the provider configuration it was created for.
+type clientCache struct {
+	newClient func(configuration map[string]any) (SQLClient, error)
+
+	mu      sync.Mutex
+	clients map[string]cachedClient
+}
+
+type cachedClient struct {
+	client SQLClient
+	hash   string
+}
+
+func newClientCache(fn func(configuration map[string]any) (SQLClient, error)) *clientCache {
+	return &clientCache{newClient: fn, clients: map[string]cachedClient{}}
+}
+
+// get returns the client of the named ProviderConfig. A cached client created
+// for a different configuration is closed and replaced. Queries that already
+// started on it are allowed to finish.
+func (c *clientCache) get(name string, configuration map[string]any) (SQLClient, error) {
+	hash, err := configurationHash(configuration)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.clients[name]; ok {
+		if cached.hash == hash {
+			return sharedClient{cached.client}, nil
+		}
+		_ = cached.client.Close()
+		delete(c.clients, name)
+	}
+	client, err := c.newClient(configuration)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[name] = cachedClient{client: client, hash: hash}
+	return sharedClient{client}, nil
+}
+
+func configurationHash(configuration map[string]any) (string, error) {
+	// Maps are marshalled with sorted keys, so equal configurations have
+	// equal hashes.
+	b, err := json.Marshal(configuration)
+	if err != nil {
+		return "", errors.Wrap(err, errHashConfiguration)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// A sharedClient is a cached SQLClient. It is closed by the cache rather than
+// by its users.
+type sharedClient struct {
+	SQLClient
+}
+
+func (sharedClient) Close() error {
+	return nil
+}
+
+// NewSQLClientFromConfiguration returns a SQLClient for the supplied provider
+// configuration, as returned by Configuration.
+func NewSQLClientFromConfiguration(configuration map[string]any) (SQLClient, error) {
+	cfg, err := sqlConfig(configuration)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlClient{db: sql.OpenDB(gosnowflake.NewConnector(gosnowflake.SnowflakeDriver{}, *cfg))}, nil
+}
+
+func sqlConfig(configuration map[string]any) (*gosnowflake.Config, error) {
+	str := func(key string) string {
+		v, _ := configuration[key].(string)
+		return v
+	}
+	cfg := &gosnowflake.Config{
+		Account:     fmt.Sprintf("%s-%s", str(keyOrganizationName), str(keyAccountName)),
+		User:        str(keyUser),
+		Role:        str(keyRole),
+		Warehouse:   str(keyWarehouse),
+		Application: sqlApplication,
+	}
+	switch a := str(keyAuthenticator); a {
+	case SnowflakeAuthenticator:
+		cfg.Authenticator = gosnowflake.AuthTypeSnowflake
+		cfg.Password = str(keyPassword)
+	case JwtAuthenticator:
+		cfg.Authenticator = gosnowflake.AuthTypeJwt
+		key, err := parsePrivateKey(str(keyPrivateKey), str(keyPrivateKeyPassphrase))
+		if err != nil {
+			return nil, err
+		}
+		cfg.PrivateKey = key
+	default:
+		return nil, errors.Errorf(errUnsupportedAuthFn, a)
+	}
+	return cfg, nil
+}
+
+func parsePrivateKey(data, passphrase string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New(errDecodePrivateKey)
+	}
+	var (
+		key any
+		err error
+	)
+	switch {
+	case passphrase != "":
+		key, err = pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+	case block.Type == "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errParsePrivateKey)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New(errPrivateKeyNotRSA)
+	}
+	return rsaKey, nil
+}
+
+type sqlClient struct {
+	db *sql.DB
+}
+
+func (c *sqlClient) Query(ctx context.Context, query string, args ...any) ([]map[string]string, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errQuery)
+	}
+	defer rows.Close() //nolint:errcheck // rows.Err reports what matters
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Wrap(err, errQuery)
+	}
+	var result []map[string]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, errors.Wrap(err, errScanRow)
+		}
+		row := make(map[string]string, len(columns))
+		for i, c := range columns {
+			row[strings.ToLower(c)] = values[i].String
+		}
+		result = append(result, row)
+	}
+	return result, errors.Wrap(rows.Err(), errQuery)
+}
+
+func (c *sqlClient) Exec(ctx context.Context, query string, args ...any) error {
+	_, err := c.db.ExecContext(ctx, query, args...)
+	return errors.Wrap(err, errExec)
+}
+
+func (c *sqlClient) Close() error {
+	return c.db.Close()
+}
+
+// QuoteIdentifier returns the supplied name as a quoted, case-sensitive
+// Snowflake identifier.
+func QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// SplitIdentifier returns the parts of the supplied, possibly qualified,
+// identifier, e.g. DB."my.schema".T. Quoted parts are returned without their
+// quotes and unquoted parts are upper-cased, as Snowflake resolves them.
+func SplitIdentifier(name string) []string {
+	return splitIdentifier(name, strings.ToUpper)
+}
+
+// ParseIdentifier returns the parts of the supplied, possibly qualified,
+// identifier the way the Terraform provider parses them from its arguments.
+// Quoted parts are returned without their quotes and unquoted parts exactly
+// as written, as the Terraform provider quotes every part of the names it is
+// given, which makes them case-sensitive.
+func ParseIdentifier(name string) []string {
+	return splitIdentifier(name, func(part string) string { return part })
+}
+
+// splitIdentifier returns the parts of the supplied identifier, with fn
+// applied to the unquoted ones.
+func splitIdentifier(name string, fn func(part string) string) []string {
+	var parts []string
+	var part strings.Builder
+	quoted, inQuotes := false, false
+	end := func() {
+		p := part.String()
+		if !quoted {
+			p = fn(strings.TrimSpace(p))
+		}
+		parts = append(parts, p)
+		part.Reset()
+		quoted = false
+	}
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case c == '"' && inQuotes && i+1 < len(name) && name[i+1] == '"':
+			part.WriteByte('"')
+			i++
+		case c == '"':
+			inQuotes = !inQuotes
+			quoted = true
+		case c == '.' && !inQuotes:
+			end()
+		default:
+			part.WriteByte(c)
+		}
+	}
+	end()
+	return parts
+}
+
+// QuoteString returns the supplied value as a single-quoted Snowflake string
+// literal, for statements such as SHOW ... LIKE that do not accept bind
+// parameters.
+func QuoteString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
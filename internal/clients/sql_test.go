@@ -0,0 +1,256 @@
+package clients
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/youmark/pkcs8"
+)
+
+func TestSQLConfig(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs1 := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unencrypted := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	der, err = pkcs8.MarshalPrivateKey(key, []byte("secret"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}))
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err = x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	base := func(extra map[string]any) map[string]any {
+		c := map[string]any{
+			keyOrganizationName: "MYORG",
+			keyAccountName:      "AB12345",
+			keyUser:             "u",
+			keyRole:             "SYSADMIN",
+			keyWarehouse:        "WH",
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	type want struct {
+		cfg *gosnowflake.Config
+		err error
+	}
+	cases := map[string]struct {
+		reason        string
+		configuration map[string]any
+		want          want
+	}{
+		"Password": {
+			reason:        "The Snowflake authenticator should authenticate with a password.",
+			configuration: base(map[string]any{keyAuthenticator: SnowflakeAuthenticator, keyPassword: "p"}),
+			want: want{cfg: &gosnowflake.Config{
+				Account:       "MYORG-AB12345",
+				User:          "u",
+				Role:          "SYSADMIN",
+				Warehouse:     "WH",
+				Application:   sqlApplication,
+				Authenticator: gosnowflake.AuthTypeSnowflake,
+				Password:      "p",
+			}},
+		},
+		"PKCS1": {
+			reason:        "A PKCS #1 RSA key should be accepted.",
+			configuration: base(map[string]any{keyAuthenticator: JwtAuthenticator, keyPrivateKey: pkcs1}),
+			want: want{cfg: &gosnowflake.Config{
+				Account:       "MYORG-AB12345",
+				User:          "u",
+				Role:          "SYSADMIN",
+				Warehouse:     "WH",
+				Application:   sqlApplication,
+				Authenticator: gosnowflake.AuthTypeJwt,
+				PrivateKey:    key,
+			}},
+		},
+		"PKCS8": {
+			reason:        "An unencrypted PKCS #8 RSA key should be accepted.",
+			configuration: base(map[string]any{keyAuthenticator: JwtAuthenticator, keyPrivateKey: unencrypted}),
+			want: want{cfg: &gosnowflake.Config{
+				Account:       "MYORG-AB12345",
+				User:          "u",
+				Role:          "SYSADMIN",
+				Warehouse:     "WH",
+				Application:   sqlApplication,
+				Authenticator: gosnowflake.AuthTypeJwt,
+				PrivateKey:    key,
+			}},
+		},
+		"EncryptedPKCS8": {
+			reason:        "An encrypted PKCS #8 RSA key should be decrypted with the passphrase.",
+			configuration: base(map[string]any{keyAuthenticator: JwtAuthenticator, keyPrivateKey: encrypted, keyPrivateKeyPassphrase: "secret"}),
+			want: want{cfg: &gosnowflake.Config{
+				Account:       "MYORG-AB12345",
+				User:          "u",
+				Role:          "SYSADMIN",
+				Warehouse:     "WH",
+				Application:   sqlApplication,
+				Authenticator: gosnowflake.AuthTypeJwt,
+				PrivateKey:    key,
+			}},
+		},
+		"NotPEM": {
+			reason:        "A private key that is not PEM encoded should return an error.",
+			configuration: base(map[string]any{keyAuthenticator: JwtAuthenticator, keyPrivateKey: "KEY"}),
+			want:          want{err: errors.New(errDecodePrivateKey)},
+		},
+		"NotRSA": {
+			reason:        "A private key that is not an RSA key should return an error.",
+			configuration: base(map[string]any{keyAuthenticator: JwtAuthenticator, keyPrivateKey: ec}),
+			want:          want{err: errors.New(errPrivateKeyNotRSA)},
+		},
+		"UnsupportedAuthenticator": {
+			reason:        "An unknown authenticator should return an error.",
+			configuration: base(map[string]any{keyAuthenticator: "OAUTH"}),
+			want:          want{err: errors.Errorf(errUnsupportedAuthFn, "OAUTH")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := sqlConfig(tc.configuration)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nsqlConfig(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, got, cmp.Comparer(func(a, b *rsa.PrivateKey) bool {
+				return a == b || (a != nil && a.Equal(b))
+			})); diff != "" {
+				t.Errorf("\n%s\nsqlConfig(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	cases := map[string]struct {
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		"Identifier":            {fn: QuoteIdentifier, in: "analytics", want: `"analytics"`},
+		"IdentifierWithQuote":   {fn: QuoteIdentifier, in: `my"db`, want: `"my""db"`},
+		"String":                {fn: QuoteString, in: "ANALYTICS%", want: `'ANALYTICS%'`},
+		"StringWithQuote":       {fn: QuoteString, in: `it's`, want: `'it\'s'`},
+		"StringWithBackslashes": {fn: QuoteString, in: `a\'b`, want: `'a\\\'b'`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.fn(tc.in); got != tc.want {
+				t.Errorf("quote(%q): want %s, got %s", tc.in, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSplitIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want []string
+	}{
+		"Name":         {name: "strong_passwords", want: []string{"STRONG_PASSWORDS"}},
+		"Quoted":       {name: `"SECURITY"."POLICIES"."Strong"`, want: []string{"SECURITY", "POLICIES", "Strong"}},
+		"Unquoted":     {name: "security.policies.strong", want: []string{"SECURITY", "POLICIES", "STRONG"}},
+		"QuotedDot":    {name: `SECURITY."my.schema".STRONG`, want: []string{"SECURITY", "my.schema", "STRONG"}},
+		"EscapedQuote": {name: `SECURITY.POLICIES."a""b"`, want: []string{"SECURITY", "POLICIES", `a"b`}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SplitIdentifier(tc.name)); diff != "" {
+				t.Errorf("SplitIdentifier(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestParseIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want []string
+	}{
+		"Name":         {name: "strong_passwords", want: []string{"strong_passwords"}},
+		"Quoted":       {name: `"SECURITY"."POLICIES"."Strong"`, want: []string{"SECURITY", "POLICIES", "Strong"}},
+		"Unquoted":     {name: "security.Policies.strong", want: []string{"security", "Policies", "strong"}},
+		"QuotedDot":    {name: `security."my.schema".strong`, want: []string{"security", "my.schema", "strong"}},
+		"EscapedQuote": {name: `SECURITY.POLICIES."a""b"`, want: []string{"SECURITY", "POLICIES", `a"b`}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ParseIdentifier(tc.name)); diff != "" {
+				t.Errorf("ParseIdentifier(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+type closeCounter struct {
+	SQLClient
+	closed int
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestClientCache(t *testing.T) {
+	var created []*closeCounter
+	cache := newClientCache(func(map[string]any) (SQLClient, error) {
+		c := &closeCounter{}
+		created = append(created, c)
+		return c, nil
+	})
+	get := func(name, password string) SQLClient {
+		t.Helper()
+		c, err := cache.get(name, map[string]any{keyUser: "u", keyPassword: password})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	first := get("default", "p")
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	get("default", "p")
+	if len(created) != 1 || created[0].closed != 0 {
+		t.Errorf("the same configuration should reuse the open client: created %d clients, closed the first %d times", len(created), created[0].closed)
+	}
+
+	get("other", "p")
+	if len(created) != 2 {
+		t.Errorf("another ProviderConfig should have its own client: created %d clients", len(created))
+	}
+
+	get("default", "rotated")
+	if len(created) != 3 || created[0].closed != 1 || created[1].closed != 0 {
+		t.Errorf("changed credentials should replace and close only the client of their ProviderConfig: created %d clients, closed %d and %d times", len(created), created[0].closed, created[1].closed)
+	}
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+
+	"github.com/allenkallz/provider-snowflake/internal/restore"
+)
+
+// AddInitializers adds the initializers that depend on the generated API
+// types, and so cannot be configured in the config package which the code
+// generator builds before the APIs exist. The generated controller of a
+// resource only runs its initializers if it has at least one configured at
+// generation time.
+func AddInitializers(p *ujconfig.Provider) {
+	// Undrop instead of create when opted in, see restore.AnnotationKeyRestoreDropped
+	p.Resources["snowflake_database"].InitializerFns = append(p.Resources["snowflake_database"].InitializerFns, restore.NewDatabaseRestorer)
+}
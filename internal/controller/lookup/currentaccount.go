@@ -0,0 +1,52 @@
+package lookup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+const (
+	errNotCurrentAccountLookup = "managed resource is not a CurrentAccountLookup"
+	errNoCurrentAccount        = "query returned no rows"
+)
+
+const currentAccountQuery = `SELECT CURRENT_ACCOUNT() AS account, CURRENT_ACCOUNT_NAME() AS account_name, ` +
+	`CURRENT_ORGANIZATION_NAME() AS organization_name, CURRENT_REGION() AS region`
+
+var currentAccount = kind{
+	gvk:  v1alpha1.CurrentAccountLookupGroupVersionKind,
+	obj:  &v1alpha1.CurrentAccountLookup{},
+	list: &v1alpha1.CurrentAccountLookupList{},
+	query: func(_ resource.Managed) (string, error) {
+		return currentAccountQuery, nil
+	},
+	observe: func(mg resource.Managed, rows []map[string]string) error {
+		cr, ok := mg.(*v1alpha1.CurrentAccountLookup)
+		if !ok {
+			return errors.New(errNotCurrentAccountLookup)
+		}
+		if len(rows) == 0 {
+			return errors.New(errNoCurrentAccount)
+		}
+		row := rows[0]
+		cr.Status.AtProvider = v1alpha1.CurrentAccountLookupObservation{
+			Account:          row["account"],
+			AccountName:      row["account_name"],
+			OrganizationName: row["organization_name"],
+			Region:           row["region"],
+			URL:              fmt.Sprintf("https://%s-%s.snowflakecomputing.com", hostLabel(row["organization_name"]), hostLabel(row["account_name"])),
+		}
+		return nil
+	},
+}
+
+// hostLabel converts an organization or account name to its form in account
+// URLs, where underscores are replaced by hyphens.
+func hostLabel(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+}
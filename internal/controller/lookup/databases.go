@@ -0,0 +1,44 @@
+package lookup
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+const errNotDatabaseLookup = "managed resource is not a DatabaseLookup"
+
+var databases = kind{
+	gvk:  v1alpha1.DatabaseLookupGroupVersionKind,
+	obj:  &v1alpha1.DatabaseLookup{},
+	list: &v1alpha1.DatabaseLookupList{},
+	query: func(mg resource.Managed) (string, error) {
+		cr, ok := mg.(*v1alpha1.DatabaseLookup)
+		if !ok {
+			return "", errors.New(errNotDatabaseLookup)
+		}
+		p := cr.Spec.ForProvider
+		return show("DATABASES", p.Like, p.StartsWith, p.Limit), nil
+	},
+	observe: func(mg resource.Managed, rows []map[string]string) error {
+		cr, ok := mg.(*v1alpha1.DatabaseLookup)
+		if !ok {
+			return errors.New(errNotDatabaseLookup)
+		}
+		dbs := make([]v1alpha1.DatabaseObservation, 0, len(rows))
+		for _, row := range rows {
+			dbs = append(dbs, v1alpha1.DatabaseObservation{
+				Name:          row["name"],
+				Kind:          row["kind"],
+				Owner:         row["owner"],
+				Comment:       row["comment"],
+				Origin:        row["origin"],
+				RetentionTime: row["retention_time"],
+				CreatedOn:     row["created_on"],
+			})
+		}
+		cr.Status.AtProvider.Databases = dbs
+		return nil
+	},
+}
@@ -0,0 +1,115 @@
+package lookup
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+const (
+	errNotGrantLookup = "managed resource is not a GrantLookup"
+	errGrantsOn       = "grantsOn requires either account or objectType and objectName"
+	errGrantsTo       = "grantsTo requires one of accountRole, databaseRole or user"
+	errGrantsOf       = "grantsOf requires one of accountRole or databaseRole"
+	errIdentifierFn   = "%s is not a valid identifier: %q"
+)
+
+// identifier matches a Snowflake identifier that is either quoted or unquoted,
+// as the CRD schema does. Names are checked again here because they are
+// written into the statement as they are.
+const identifier = `("[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)`
+
+var (
+	objectTypeRe   = regexp.MustCompile(`^[A-Za-z ]+$`)
+	nameRe         = regexp.MustCompile(`^` + identifier + `$`)
+	databaseRoleRe = regexp.MustCompile(`^` + identifier + `\.` + identifier + `$`)
+	objectNameRe   = regexp.MustCompile(`^` + identifier + `(\.` + identifier + `){0,2}$`)
+)
+
+// withName appends the supplied name to the statement if it matches re.
+func withName(statement string, re *regexp.Regexp, field, name string) (string, error) {
+	if !re.MatchString(name) {
+		return "", errors.Errorf(errIdentifierFn, field, name)
+	}
+	return statement + " " + name, nil
+}
+
+var grants = kind{
+	gvk:   v1alpha1.GrantLookupGroupVersionKind,
+	obj:   &v1alpha1.GrantLookup{},
+	list:  &v1alpha1.GrantLookupList{},
+	query: showGrants,
+	observe: func(mg resource.Managed, rows []map[string]string) error {
+		cr, ok := mg.(*v1alpha1.GrantLookup)
+		if !ok {
+			return errors.New(errNotGrantLookup)
+		}
+		gs := make([]v1alpha1.GrantObservation, 0, len(rows))
+		for _, row := range rows {
+			gs = append(gs, v1alpha1.GrantObservation{
+				CreatedOn:   row["created_on"],
+				Privilege:   row["privilege"],
+				GrantedOn:   row["granted_on"],
+				Name:        row["name"],
+				Role:        row["role"],
+				GrantedTo:   row["granted_to"],
+				GranteeName: row["grantee_name"],
+				GrantOption: row["grant_option"],
+				GrantedBy:   row["granted_by"],
+			})
+		}
+		cr.Status.AtProvider.Grants = gs
+		return nil
+	},
+}
+
+// showGrants builds the SHOW GRANTS statement for the parameters of a
+// GrantLookup. Identifiers are passed as they are, so that unquoted names keep
+// Snowflake's case-insensitive resolution, and must be valid identifiers.
+func showGrants(mg resource.Managed) (string, error) {
+	cr, ok := mg.(*v1alpha1.GrantLookup)
+	if !ok {
+		return "", errors.New(errNotGrantLookup)
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case p.GrantsOn != nil:
+		on := p.GrantsOn
+		if on.Account != nil && *on.Account {
+			return "SHOW GRANTS ON ACCOUNT", nil
+		}
+		if on.ObjectType == nil || on.ObjectName == nil {
+			return "", errors.New(errGrantsOn)
+		}
+		stmt, err := withName("SHOW GRANTS ON", objectTypeRe, "grantsOn.objectType", strings.ToUpper(*on.ObjectType))
+		if err != nil {
+			return "", err
+		}
+		return withName(stmt, objectNameRe, "grantsOn.objectName", *on.ObjectName)
+	case p.GrantsTo != nil:
+		to := p.GrantsTo
+		switch {
+		case to.AccountRole != nil:
+			return withName("SHOW GRANTS TO ROLE", nameRe, "grantsTo.accountRole", *to.AccountRole)
+		case to.DatabaseRole != nil:
+			return withName("SHOW GRANTS TO DATABASE ROLE", databaseRoleRe, "grantsTo.databaseRole", *to.DatabaseRole)
+		case to.User != nil:
+			return withName("SHOW GRANTS TO USER", nameRe, "grantsTo.user", *to.User)
+		}
+		return "", errors.New(errGrantsTo)
+	case p.GrantsOf != nil:
+		of := p.GrantsOf
+		switch {
+		case of.AccountRole != nil:
+			return withName("SHOW GRANTS OF ROLE", nameRe, "grantsOf.accountRole", *of.AccountRole)
+		case of.DatabaseRole != nil:
+			return withName("SHOW GRANTS OF DATABASE ROLE", databaseRoleRe, "grantsOf.databaseRole", *of.DatabaseRole)
+		}
+		return "", errors.New(errGrantsOf)
+	}
+	return "SHOW GRANTS", nil
+}
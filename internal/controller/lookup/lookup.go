@@ -0,0 +1,155 @@
+// Package lookup contains the controllers of the observe-only lookup kinds.
+// They periodically query Snowflake and record the results in status, and
+// never create, update or delete anything in Snowflake.
+package lookup
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/apis/v1beta1"
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errTrackUsage = "cannot track ProviderConfig usage"
+	errConnect    = "cannot connect to Snowflake"
+	errLookup     = "cannot look up Snowflake objects"
+)
+
+// A kind describes how to look up the objects of one lookup kind.
+type kind struct {
+	gvk  schema.GroupVersionKind
+	obj  resource.Managed
+	list resource.ManagedList
+
+	// query returns the statement that lists the objects.
+	query func(mg resource.Managed) (string, error)
+
+	// observe records the rows returned by query in the status of mg.
+	observe func(mg resource.Managed, rows []map[string]string) error
+}
+
+// Setup adds the controllers of all lookup kinds to the supplied manager.
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	for _, k := range []kind{databases, warehouses, users, grants, currentAccount} {
+		if err := setup(mgr, o, k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setup(mgr ctrl.Manager, o tjcontroller.Options, k kind) error {
+	name := managed.ControllerName(k.gvk.String())
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClient: clients.NewSQLClient, kind: k}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(3 * time.Minute),
+		// Lookups have no external name, so skip the default initializer.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+	}
+	if o.PollJitter != 0 {
+		opts = append(opts, managed.WithPollJitterHook(o.PollJitter))
+	}
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, k.list, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrapf(err, "cannot register MR state metrics recorder for kind %s", k.gvk.Kind)
+		}
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(k.gvk), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(k.obj).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+	kind      kind
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	t := resource.NewProviderConfigUsageTracker(c.kube, &v1beta1.ProviderConfigUsage{})
+	if err := t.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+	return &external{kube: c.kube, newClient: c.newClient, kind: c.kind}, nil
+}
+
+// external only ever observes. It reports the lookup as existing and up to
+// date so that the managed reconciler never attempts to change Snowflake.
+type external struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+	kind      kind
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(mg) {
+		// There is nothing to delete in Snowflake.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	query, err := e.kind.query(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	c, err := e.newClient(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errConnect)
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	rows, err := c.Query(ctx, query)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	if err := e.kind.observe(mg, rows); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	mg.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,309 @@
+package lookup
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestShow(t *testing.T) {
+	cases := map[string]struct {
+		like, startsWith *string
+		limit            *int64
+		want             string
+	}{
+		"NoFilters":  {want: "SHOW DATABASES"},
+		"AllFilters": {like: ptr("ANALYTICS%"), startsWith: ptr("ANA"), limit: ptr[int64](10), want: "SHOW DATABASES LIKE 'ANALYTICS%' STARTS WITH 'ANA' LIMIT 10"},
+		"Quoted":     {like: ptr(`it's\`), want: `SHOW DATABASES LIKE 'it\'s\\'`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := show("DATABASES", tc.like, tc.startsWith, tc.limit); got != tc.want {
+				t.Errorf("show(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestShowGrants(t *testing.T) {
+	type want struct {
+		query string
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.GrantLookupParameters
+		want   want
+	}{
+		"Default": {
+			reason: "Without parameters the roles granted to the provider's user should be listed.",
+			want:   want{query: "SHOW GRANTS"},
+		},
+		"OnAccount": {
+			reason: "Grants on the account should be listed.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOn: &v1alpha1.GrantsOnParameters{Account: ptr(true)}},
+			want:   want{query: "SHOW GRANTS ON ACCOUNT"},
+		},
+		"OnObject": {
+			reason: "The object type should be upper-cased and the quoted parts of the name kept as they are.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOn: &v1alpha1.GrantsOnParameters{ObjectType: ptr("dynamic table"), ObjectName: ptr(`analytics."Raw".events`)}},
+			want:   want{query: `SHOW GRANTS ON DYNAMIC TABLE analytics."Raw".events`},
+		},
+		"OnObjectWithoutName": {
+			reason: "Grants on an object require its name.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOn: &v1alpha1.GrantsOnParameters{ObjectType: ptr("TABLE")}},
+			want:   want{err: errors.New(errGrantsOn)},
+		},
+		"OnInvalidObjectType": {
+			reason: "An object type that is not a keyword should be rejected.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOn: &v1alpha1.GrantsOnParameters{ObjectType: ptr("TABLE;"), ObjectName: ptr("T")}},
+			want:   want{err: errors.Errorf(errIdentifierFn, "grantsOn.objectType", "TABLE;")},
+		},
+		"OnInvalidObjectName": {
+			reason: "An object name with more than three parts should be rejected.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOn: &v1alpha1.GrantsOnParameters{ObjectType: ptr("TABLE"), ObjectName: ptr("A.B.C.D")}},
+			want:   want{err: errors.Errorf(errIdentifierFn, "grantsOn.objectName", "A.B.C.D")},
+		},
+		"ToRole": {
+			reason: "Grants to an account role should be listed.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{AccountRole: ptr("ANALYST")}},
+			want:   want{query: "SHOW GRANTS TO ROLE ANALYST"},
+		},
+		"ToQuotedRole": {
+			reason: "A quoted role name should be passed with its quotes.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{AccountRole: ptr(`"Data Engineers"`)}},
+			want:   want{query: `SHOW GRANTS TO ROLE "Data Engineers"`},
+		},
+		"ToDatabaseRole": {
+			reason: "Grants to a database role should be listed.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{DatabaseRole: ptr("ANALYTICS.READER")}},
+			want:   want{query: "SHOW GRANTS TO DATABASE ROLE ANALYTICS.READER"},
+		},
+		"ToUser": {
+			reason: "Grants to a user should be listed.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{User: ptr("JDOE")}},
+			want:   want{query: "SHOW GRANTS TO USER JDOE"},
+		},
+		"ToInjectedUser": {
+			reason: "A name that would end the statement should be rejected.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{User: ptr("JDOE; DROP USER ADMIN")}},
+			want:   want{err: errors.Errorf(errIdentifierFn, "grantsTo.user", "JDOE; DROP USER ADMIN")},
+		},
+		"ToNobody": {
+			reason: "Grants to require a grantee.",
+			p:      v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{}},
+			want:   want{err: errors.New(errGrantsTo)},
+		},
+		"OfRole": {
+			reason: "The grants of an account role should be listed.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{AccountRole: ptr("ANALYST")}},
+			want:   want{query: "SHOW GRANTS OF ROLE ANALYST"},
+		},
+		"OfDatabaseRoleWithoutDatabase": {
+			reason: "A database role name requires the name of its database.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{DatabaseRole: ptr("READER")}},
+			want:   want{err: errors.Errorf(errIdentifierFn, "grantsOf.databaseRole", "READER")},
+		},
+		"OfNothing": {
+			reason: "Grants of require a role.",
+			p:      v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{}},
+			want:   want{err: errors.New(errGrantsOf)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := showGrants(&v1alpha1.GrantLookup{Spec: v1alpha1.GrantLookupSpec{ForProvider: tc.p}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nshowGrants(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if got != tc.want.query {
+				t.Errorf("\n%s\nshowGrants(...): want %s, got %s", tc.reason, tc.want.query, got)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		query string
+		cr    resource.Managed
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		kind   kind
+		cr     resource.Managed
+		rows   map[string][]map[string]string
+		err    error
+		want   want
+	}{
+		"Databases": {
+			reason: "The databases returned by SHOW DATABASES should be recorded.",
+			kind:   databases,
+			cr:     &v1alpha1.DatabaseLookup{Spec: v1alpha1.DatabaseLookupSpec{ForProvider: v1alpha1.DatabaseLookupParameters{Like: ptr("ANALYTICS%")}}},
+			rows: map[string][]map[string]string{"SHOW DATABASES LIKE 'ANALYTICS%'": {
+				{"name": "ANALYTICS", "kind": "STANDARD", "owner": "SYSADMIN", "retention_time": "1", "created_on": "2024-05-10"},
+			}},
+			want: want{
+				query: "SHOW DATABASES LIKE 'ANALYTICS%'",
+				cr: &v1alpha1.DatabaseLookup{
+					Spec: v1alpha1.DatabaseLookupSpec{ForProvider: v1alpha1.DatabaseLookupParameters{Like: ptr("ANALYTICS%")}},
+					Status: v1alpha1.DatabaseLookupStatus{AtProvider: v1alpha1.DatabaseLookupObservation{Databases: []v1alpha1.DatabaseObservation{
+						{Name: "ANALYTICS", Kind: "STANDARD", Owner: "SYSADMIN", RetentionTime: "1", CreatedOn: "2024-05-10"},
+					}}},
+				},
+			},
+		},
+		"Warehouses": {
+			reason: "The warehouses returned by SHOW WAREHOUSES should be recorded.",
+			kind:   warehouses,
+			cr:     &v1alpha1.WarehouseLookup{},
+			rows: map[string][]map[string]string{"SHOW WAREHOUSES": {
+				{"name": "COMPUTE_WH", "state": "SUSPENDED", "type": "STANDARD", "size": "X-Small", "auto_suspend": "600", "auto_resume": "true"},
+			}},
+			want: want{
+				query: "SHOW WAREHOUSES",
+				cr: &v1alpha1.WarehouseLookup{Status: v1alpha1.WarehouseLookupStatus{AtProvider: v1alpha1.WarehouseLookupObservation{Warehouses: []v1alpha1.WarehouseObservation{
+					{Name: "COMPUTE_WH", State: "SUSPENDED", Type: "STANDARD", Size: "X-Small", AutoSuspend: "600", AutoResume: "true"},
+				}}}},
+			},
+		},
+		"Users": {
+			reason: "The users returned by SHOW USERS should be recorded.",
+			kind:   users,
+			cr:     &v1alpha1.UserLookup{Spec: v1alpha1.UserLookupSpec{ForProvider: v1alpha1.UserLookupParameters{StartsWith: ptr("SVC_"), Limit: ptr[int64](1)}}},
+			rows: map[string][]map[string]string{"SHOW USERS STARTS WITH 'SVC_' LIMIT 1": {
+				{"name": "SVC_DBT", "login_name": "SVC_DBT", "type": "SERVICE", "disabled": "false", "default_role": "TRANSFORMER"},
+			}},
+			want: want{
+				query: "SHOW USERS STARTS WITH 'SVC_' LIMIT 1",
+				cr: &v1alpha1.UserLookup{
+					Spec: v1alpha1.UserLookupSpec{ForProvider: v1alpha1.UserLookupParameters{StartsWith: ptr("SVC_"), Limit: ptr[int64](1)}},
+					Status: v1alpha1.UserLookupStatus{AtProvider: v1alpha1.UserLookupObservation{Users: []v1alpha1.UserObservation{
+						{Name: "SVC_DBT", LoginName: "SVC_DBT", Type: "SERVICE", Disabled: "false", DefaultRole: "TRANSFORMER"},
+					}}},
+				},
+			},
+		},
+		"GrantsTo": {
+			reason: "The privileges returned by SHOW GRANTS TO should be recorded.",
+			kind:   grants,
+			cr:     &v1alpha1.GrantLookup{Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{AccountRole: ptr("ANALYST")}}}},
+			rows: map[string][]map[string]string{"SHOW GRANTS TO ROLE ANALYST": {
+				{"privilege": "USAGE", "granted_on": "DATABASE", "name": "ANALYTICS", "granted_to": "ROLE", "grantee_name": "ANALYST", "grant_option": "false", "granted_by": "SYSADMIN"},
+			}},
+			want: want{
+				query: "SHOW GRANTS TO ROLE ANALYST",
+				cr: &v1alpha1.GrantLookup{
+					Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsTo: &v1alpha1.GrantsToParameters{AccountRole: ptr("ANALYST")}}},
+					Status: v1alpha1.GrantLookupStatus{AtProvider: v1alpha1.GrantLookupObservation{Grants: []v1alpha1.GrantObservation{
+						{Privilege: "USAGE", GrantedOn: "DATABASE", Name: "ANALYTICS", GrantedTo: "ROLE", GranteeName: "ANALYST", GrantOption: "false", GrantedBy: "SYSADMIN"},
+					}}},
+				},
+			},
+		},
+		"GrantsOf": {
+			reason: "The granted role returned by SHOW GRANTS OF should be recorded as the role, not as the type of object granted on.",
+			kind:   grants,
+			cr:     &v1alpha1.GrantLookup{Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{AccountRole: ptr("ANALYST")}}}},
+			rows: map[string][]map[string]string{"SHOW GRANTS OF ROLE ANALYST": {
+				{"role": "ANALYST", "granted_to": "USER", "grantee_name": "JDOE", "granted_by": "SECURITYADMIN"},
+			}},
+			want: want{
+				query: "SHOW GRANTS OF ROLE ANALYST",
+				cr: &v1alpha1.GrantLookup{
+					Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{AccountRole: ptr("ANALYST")}}},
+					Status: v1alpha1.GrantLookupStatus{AtProvider: v1alpha1.GrantLookupObservation{Grants: []v1alpha1.GrantObservation{
+						{Role: "ANALYST", GrantedTo: "USER", GranteeName: "JDOE", GrantedBy: "SECURITYADMIN"},
+					}}},
+				},
+			},
+		},
+		"CurrentAccount": {
+			reason: "The current account should be recorded together with its URL.",
+			kind:   currentAccount,
+			cr:     &v1alpha1.CurrentAccountLookup{},
+			rows: map[string][]map[string]string{currentAccountQuery: {
+				{"account": "AB12345", "account_name": "MY_ACCOUNT", "organization_name": "MYORG", "region": "AWS_EU_CENTRAL_1"},
+			}},
+			want: want{
+				query: currentAccountQuery,
+				cr: &v1alpha1.CurrentAccountLookup{Status: v1alpha1.CurrentAccountLookupStatus{AtProvider: v1alpha1.CurrentAccountLookupObservation{
+					Account:          "AB12345",
+					AccountName:      "MY_ACCOUNT",
+					OrganizationName: "MYORG",
+					Region:           "AWS_EU_CENTRAL_1",
+					URL:              "https://myorg-my-account.snowflakecomputing.com",
+				}}},
+			},
+		},
+		"NoCurrentAccount": {
+			reason: "A current account query without rows should return an error.",
+			kind:   currentAccount,
+			cr:     &v1alpha1.CurrentAccountLookup{},
+			want: want{
+				query: currentAccountQuery,
+				cr:    &v1alpha1.CurrentAccountLookup{},
+				err:   errors.Wrap(errors.New(errNoCurrentAccount), errLookup),
+			},
+		},
+		"QueryFailed": {
+			reason: "An error running the query should be returned.",
+			kind:   warehouses,
+			cr:     &v1alpha1.WarehouseLookup{},
+			err:    errors.New("boom"),
+			want: want{
+				query: "SHOW WAREHOUSES",
+				cr:    &v1alpha1.WarehouseLookup{},
+				err:   errors.Wrap(errors.New("boom"), errLookup),
+			},
+		},
+		"InvalidParameters": {
+			reason: "Invalid parameters should be rejected before anything is run.",
+			kind:   grants,
+			cr:     &v1alpha1.GrantLookup{Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{}}}},
+			want: want{
+				cr:  &v1alpha1.GrantLookup{Spec: v1alpha1.GrantLookupSpec{ForProvider: v1alpha1.GrantLookupParameters{GrantsOf: &v1alpha1.GrantsOfParameters{}}}},
+				err: errors.Wrap(errors.New(errGrantsOf), errLookup),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sql := &fake.SQLClient{Rows: tc.rows, Err: tc.err}
+			e := &external{newClient: fake.NewSQLClientFn(sql), kind: tc.kind}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			var queries []string
+			if tc.want.query != "" {
+				queries = []string{tc.want.query}
+			}
+			if diff := cmp.Diff(queries, sql.Queries); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want queries, +got queries:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+			tc.want.cr.SetConditions(xpv1.Available())
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package lookup
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+// show builds a SHOW statement with the optional LIKE, STARTS WITH and LIMIT
+// filters, in the order Snowflake expects them.
+func show(objects string, like, startsWith *string, limit *int64) string {
+	b := strings.Builder{}
+	b.WriteString("SHOW ")
+	b.WriteString(objects)
+	if like != nil {
+		b.WriteString(" LIKE " + clients.QuoteString(*like))
+	}
+	if startsWith != nil {
+		b.WriteString(" STARTS WITH " + clients.QuoteString(*startsWith))
+	}
+	if limit != nil {
+		b.WriteString(" LIMIT " + strconv.FormatInt(*limit, 10))
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package lookup
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+const errNotUserLookup = "managed resource is not a UserLookup"
+
+var users = kind{
+	gvk:  v1alpha1.UserLookupGroupVersionKind,
+	obj:  &v1alpha1.UserLookup{},
+	list: &v1alpha1.UserLookupList{},
+	query: func(mg resource.Managed) (string, error) {
+		cr, ok := mg.(*v1alpha1.UserLookup)
+		if !ok {
+			return "", errors.New(errNotUserLookup)
+		}
+		p := cr.Spec.ForProvider
+		return show("USERS", p.Like, p.StartsWith, p.Limit), nil
+	},
+	observe: func(mg resource.Managed, rows []map[string]string) error {
+		cr, ok := mg.(*v1alpha1.UserLookup)
+		if !ok {
+			return errors.New(errNotUserLookup)
+		}
+		us := make([]v1alpha1.UserObservation, 0, len(rows))
+		for _, row := range rows {
+			us = append(us, v1alpha1.UserObservation{
+				Name:             row["name"],
+				LoginName:        row["login_name"],
+				DisplayName:      row["display_name"],
+				Type:             row["type"],
+				Disabled:         row["disabled"],
+				DefaultRole:      row["default_role"],
+				DefaultWarehouse: row["default_warehouse"],
+				Owner:            row["owner"],
+				Comment:          row["comment"],
+				CreatedOn:        row["created_on"],
+			})
+		}
+		cr.Status.AtProvider.Users = us
+		return nil
+	},
+}
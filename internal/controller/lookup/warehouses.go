@@ -0,0 +1,45 @@
+package lookup
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/apis/lookup/v1alpha1"
+)
+
+const errNotWarehouseLookup = "managed resource is not a WarehouseLookup"
+
+var warehouses = kind{
+	gvk:  v1alpha1.WarehouseLookupGroupVersionKind,
+	obj:  &v1alpha1.WarehouseLookup{},
+	list: &v1alpha1.WarehouseLookupList{},
+	query: func(mg resource.Managed) (string, error) {
+		cr, ok := mg.(*v1alpha1.WarehouseLookup)
+		if !ok {
+			return "", errors.New(errNotWarehouseLookup)
+		}
+		return show("WAREHOUSES", cr.Spec.ForProvider.Like, nil, nil), nil
+	},
+	observe: func(mg resource.Managed, rows []map[string]string) error {
+		cr, ok := mg.(*v1alpha1.WarehouseLookup)
+		if !ok {
+			return errors.New(errNotWarehouseLookup)
+		}
+		whs := make([]v1alpha1.WarehouseObservation, 0, len(rows))
+		for _, row := range rows {
+			whs = append(whs, v1alpha1.WarehouseObservation{
+				Name:        row["name"],
+				State:       row["state"],
+				Type:        row["type"],
+				Size:        row["size"],
+				AutoSuspend: row["auto_suspend"],
+				AutoResume:  row["auto_resume"],
+				Owner:       row["owner"],
+				Comment:     row["comment"],
+				CreatedOn:   row["created_on"],
+			})
+		}
+		cr.Status.AtProvider.Warehouses = whs
+		return nil
+	},
+}
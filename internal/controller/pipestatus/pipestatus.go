@@ -0,0 +1,182 @@
+// Package pipestatus contains a controller that periodically records the
+// status Snowflake reports for each Pipe, such as the files it has yet to load
+// and the errors that keep it from loading them, and the URL of the stage it
+// loads them from. Terraform does not report them, and the managed reconciler
+// of Pipes only observes them as often as it checks for drift.
+package pipestatus
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+	"github.com/allenkallz/provider-snowflake/internal/pipe"
+)
+
+const (
+	// TypeHealthy is the condition type recording whether a Pipe can load
+	// files. It is False while Snowflake reports an error for the pipe, or
+	// has stopped or stalled it.
+	TypeHealthy xpv1.ConditionType = "Healthy"
+
+	// ReasonNoErrors means Snowflake reports no errors for the pipe.
+	ReasonNoErrors xpv1.ConditionReason = "NoErrors"
+
+	// ReasonPipeError means Snowflake reports an error for the pipe.
+	ReasonPipeError xpv1.ConditionReason = "PipeError"
+
+	// ReasonStatusUnavailable means the status of the pipe cannot be read.
+	ReasonStatusUnavailable xpv1.ConditionReason = "StatusUnavailable"
+
+	// ConnectionDetailStageURL is the connection detail the URL of the stage
+	// of a Pipe is published as, next to its notification_channel.
+	ConnectionDetailStageURL = "stage_url"
+
+	controllerName = "pipestatus"
+
+	errGetPipe      = "cannot get Pipe"
+	errConnect      = "cannot connect to Snowflake"
+	errUpdateStatus = "cannot update Pipe status"
+	errPublish      = "cannot publish Pipe connection details"
+)
+
+// Setup adds a controller that records the status of every Pipe at the
+// supplied interval to the supplied manager.
+func Setup(mgr ctrl.Manager, o tjcontroller.Options, interval time.Duration) error {
+	r := &Reconciler{
+		kube:      mgr.GetClient(),
+		newClient: clients.NewSQLClient,
+		publisher: managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
+		interval:  interval,
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		WithOptions(o.ForControllerRuntime()).
+		// Reconcile when a Pipe is created, changed or gets its external
+		// name, but not when its status changes, which this controller does.
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})).
+		For(&v1alpha1.Pipe{}).
+		Complete(ratelimiter.NewReconciler(controllerName, r, o.GlobalRateLimiter))
+}
+
+// A Reconciler records the status of a Pipe and requeues it to record it
+// again after its interval. It publishes the URL of the stage of the Pipe to
+// its connection secret, which the managed reconciler of Pipes patches rather
+// than replaces, so the URL is kept next to the connection details it
+// publishes.
+type Reconciler struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+	publisher managed.ConnectionPublisher
+	interval  time.Duration
+}
+
+// Reconcile records the status of the supplied Pipe, if it exists in
+// Snowflake.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	cr := &v1alpha1.Pipe{}
+	if err := r.kube.Get(ctx, req.NamespacedName, cr); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPipe)
+	}
+	p := cr.Spec.ForProvider
+	if meta.WasDeleted(cr) || meta.IsPaused(cr) || meta.GetExternalName(cr) == "" ||
+		p.Database == nil || p.Schema == nil || p.Name == nil {
+		// Reconciled again once it is created or unpaused.
+		return reconcile.Result{}, nil
+	}
+
+	orig := cr.DeepCopy()
+	s, url, err := r.observe(ctx, cr)
+	if err != nil {
+		cr.SetConditions(unavailable(err))
+	} else {
+		record(cr, s, url)
+	}
+	// The managed reconciler updates the status too. Patch with optimistic
+	// locking so that neither overwrites the conditions the other just set.
+	if err := r.kube.Status().Patch(ctx, cr, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+	if err == nil && url != "" {
+		if _, err := r.publisher.PublishConnection(ctx, cr, managed.ConnectionDetails{ConnectionDetailStageURL: []byte(url)}); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errPublish)
+		}
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// observe returns the status of the supplied Pipe and the URL of its stage,
+// which is empty if the stage is internal or cannot be told from the COPY
+// statement.
+func (r *Reconciler) observe(ctx context.Context, cr *v1alpha1.Pipe) (pipe.Status, string, error) {
+	c, err := r.newClient(ctx, r.kube, cr)
+	if err != nil {
+		return pipe.Status{}, "", errors.Wrap(err, errConnect)
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+	p := cr.Spec.ForProvider
+	s, err := pipe.GetStatus(ctx, c, clients.FullyQualifiedName(*p.Database, *p.Schema, *p.Name))
+	if err != nil || p.CopyStatement == nil {
+		return s, "", err
+	}
+	stage, ok := pipe.StageOf(*p.CopyStatement, *p.Database, *p.Schema)
+	if !ok {
+		return s, "", nil
+	}
+	url, err := pipe.GetStageURL(ctx, c, stage[0], stage[1], stage[2])
+	return s, url, err
+}
+
+// record records the supplied status of a pipe and the URL of its stage in
+// the status of cr.
+func record(cr *v1alpha1.Pipe, s pipe.Status, url string) {
+	lastError := s.Error
+	if lastError == "" {
+		lastError = s.Fault
+	}
+	cr.Status.AtProvider.ExecutionState = &s.ExecutionState
+	cr.Status.AtProvider.PendingFileCount = &s.PendingFileCount
+	cr.Status.AtProvider.LastIngestedTime = &s.LastIngestedTimestamp
+	cr.Status.AtProvider.LastError = &lastError
+	cr.Status.AtProvider.StageURL = &url
+
+	msg := lastError
+	if msg == "" && (strings.HasPrefix(s.ExecutionState, "STOPPED_") || strings.HasPrefix(s.ExecutionState, "STALLED_")) {
+		msg = "Snowflake reports the pipe as " + s.ExecutionState
+	}
+	if msg != "" {
+		cr.SetConditions(condition(corev1.ConditionFalse, ReasonPipeError, msg))
+		return
+	}
+	cr.SetConditions(condition(corev1.ConditionTrue, ReasonNoErrors, ""))
+}
+
+func unavailable(err error) xpv1.Condition {
+	return condition(corev1.ConditionUnknown, ReasonStatusUnavailable, err.Error())
+}
+
+func condition(s corev1.ConditionStatus, r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
@@ -0,0 +1,200 @@
+package pipestatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	sqlfake "github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func newPipe(externalName string) *v1alpha1.Pipe {
+	db, schema, name := "ANALYTICS", "RAW", "EVENTS"
+	cr := &v1alpha1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{Name: "events"},
+		Spec: v1alpha1.PipeSpec{
+			ForProvider: v1alpha1.PipeParameters{Database: &db, Schema: &schema, Name: &name},
+		},
+	}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestReconcile(t *testing.T) {
+	query := `SELECT SYSTEM$PIPE_STATUS('"ANALYTICS"."RAW"."EVENTS"') AS status`
+	status := func(s string) map[string][]map[string]string {
+		return map[string][]map[string]string{query: {{"status": s}}}
+	}
+	interval := time.Minute
+
+	stages := `SHOW STAGES LIKE 'LANDING' IN SCHEMA "ANALYTICS"."RAW"`
+	withStage := func(cr *v1alpha1.Pipe) *v1alpha1.Pipe {
+		cr.Spec.ForProvider.CopyStatement = ptr("COPY INTO ANALYTICS.RAW.EVENTS FROM @landing/events/ FILE_FORMAT = (TYPE = JSON)")
+		cr.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "events", Namespace: "crossplane-system"}
+		return cr
+	}
+
+	type want struct {
+		result    reconcile.Result
+		obs       v1alpha1.PipeObservation
+		condition *xpv1.Condition
+		secret    map[string][]byte
+	}
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Pipe
+		sql    *sqlfake.SQLClient
+		want   want
+	}{
+		"NotCreated": {
+			reason: "A pipe without an external name has no status yet.",
+			cr:     newPipe(""),
+			sql:    &sqlfake.SQLClient{Rows: status(`{"executionState":"RUNNING"}`)},
+		},
+		"Running": {
+			reason: "The status of a pipe without errors should be recorded, and the pipe reported healthy.",
+			cr:     newPipe("x"),
+			sql:    &sqlfake.SQLClient{Rows: status(`{"executionState":"RUNNING","pendingFileCount":3,"lastIngestedTimestamp":"2024-05-10T12:00:00.000Z"}`)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				obs: v1alpha1.PipeObservation{
+					ExecutionState:   ptr("RUNNING"),
+					PendingFileCount: ptr(int64(3)),
+					LastIngestedTime: ptr("2024-05-10T12:00:00.000Z"),
+					LastError:        ptr(""),
+					StageURL:         ptr(""),
+				},
+				condition: &xpv1.Condition{Type: TypeHealthy, Status: corev1.ConditionTrue, Reason: ReasonNoErrors},
+			},
+		},
+		"Error": {
+			reason: "The error of a pipe should be recorded, and the pipe reported unhealthy.",
+			cr:     newPipe("x"),
+			sql:    &sqlfake.SQLClient{Rows: status(`{"executionState":"STOPPED_STAGE_DROPPED","error":"Stage does not exist"}`)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				obs: v1alpha1.PipeObservation{
+					ExecutionState:   ptr("STOPPED_STAGE_DROPPED"),
+					PendingFileCount: ptr(int64(0)),
+					LastIngestedTime: ptr(""),
+					LastError:        ptr("Stage does not exist"),
+					StageURL:         ptr(""),
+				},
+				condition: &xpv1.Condition{Type: TypeHealthy, Status: corev1.ConditionFalse, Reason: ReasonPipeError, Message: "Stage does not exist"},
+			},
+		},
+		"Stalled": {
+			reason: "A stalled pipe should be reported unhealthy even if Snowflake reports no error.",
+			cr:     newPipe("x"),
+			sql:    &sqlfake.SQLClient{Rows: status(`{"executionState":"STALLED_EXECUTION_ERROR"}`)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				obs: v1alpha1.PipeObservation{
+					ExecutionState:   ptr("STALLED_EXECUTION_ERROR"),
+					PendingFileCount: ptr(int64(0)),
+					LastIngestedTime: ptr(""),
+					LastError:        ptr(""),
+					StageURL:         ptr(""),
+				},
+				condition: &xpv1.Condition{Type: TypeHealthy, Status: corev1.ConditionFalse, Reason: ReasonPipeError, Message: "Snowflake reports the pipe as STALLED_EXECUTION_ERROR"},
+			},
+		},
+		"StageURL": {
+			reason: "The URL of the stage of a pipe should be recorded and published as a connection detail.",
+			cr:     withStage(newPipe("x")),
+			sql: &sqlfake.SQLClient{Rows: map[string][]map[string]string{
+				query:  {{"status": `{"executionState":"RUNNING"}`}},
+				stages: {{"name": "LANDING_OLD", "url": "s3://old/"}, {"name": "LANDING", "url": "s3://landing/"}},
+			}},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				obs: v1alpha1.PipeObservation{
+					ExecutionState:   ptr("RUNNING"),
+					PendingFileCount: ptr(int64(0)),
+					LastIngestedTime: ptr(""),
+					LastError:        ptr(""),
+					StageURL:         ptr("s3://landing/"),
+				},
+				condition: &xpv1.Condition{Type: TypeHealthy, Status: corev1.ConditionTrue, Reason: ReasonNoErrors},
+				secret:    map[string][]byte{ConnectionDetailStageURL: []byte("s3://landing/")},
+			},
+		},
+		"Unavailable": {
+			reason: "A status that cannot be read should be reported as unknown and read again later.",
+			cr:     newPipe("x"),
+			sql:    &sqlfake.SQLClient{Err: errors.New("boom")},
+			want: want{
+				result:    reconcile.Result{RequeueAfter: interval},
+				condition: &xpv1.Condition{Type: TypeHealthy, Status: corev1.ConditionUnknown, Reason: ReasonStatusUnavailable, Message: "cannot get pipe status: boom"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.cr).WithStatusSubresource(tc.cr).Build()
+			r := &Reconciler{
+				kube:      kube,
+				newClient: sqlfake.NewSQLClientFn(tc.sql),
+				publisher: managed.NewAPISecretPublisher(kube, s),
+				interval:  interval,
+			}
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.cr.GetName()}})
+			if err != nil {
+				t.Fatalf("\n%s\nReconcile(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			stored := &v1alpha1.Pipe{}
+			if err := kube.Get(context.Background(), client.ObjectKeyFromObject(tc.cr), stored); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.obs, stored.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+			if ref := tc.cr.GetWriteConnectionSecretToReference(); ref != nil {
+				secret := &corev1.Secret{}
+				if err := kube.Get(context.Background(), types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.want.secret, secret.Data); diff != "" {
+					t.Errorf("\n%s\nReconcile(...): -want connection details, +got connection details:\n%s", tc.reason, diff)
+				}
+			}
+			var c *xpv1.Condition
+			if got := stored.GetCondition(TypeHealthy); got.Status != corev1.ConditionUnknown || got.Reason != "" {
+				c = &got
+			}
+			if diff := cmp.Diff(tc.want.condition, c, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
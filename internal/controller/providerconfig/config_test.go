@@ -0,0 +1,182 @@
+package providerconfig
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/upjet/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/allenkallz/provider-snowflake/apis/v1beta1"
+)
+
+const (
+	finalizer = "in-use.crossplane.io"
+
+	timeout  = 30 * time.Second
+	interval = 250 * time.Millisecond
+)
+
+// startEnvironment starts a kube-apiserver and etcd with the provider's CRDs
+// installed, and a manager running the ProviderConfig controller. The test is
+// skipped unless KUBEBUILDER_ASSETS points at the envtest binaries; run
+// `make test.envtest` to download them.
+func startEnvironment(t *testing.T) client.Client {
+	t.Helper()
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set; skipping envtest suite")
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "package", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("cannot start envtest environment: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("cannot stop envtest environment: %v", err)
+		}
+	})
+
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  s,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	if err != nil {
+		t.Fatalf("cannot create manager: %v", err)
+	}
+	o := controller.Options{
+		Options: xpcontroller.Options{
+			Logger:                  logging.NewNopLogger(),
+			PollInterval:            time.Second,
+			MaxConcurrentReconciles: 1,
+		},
+	}
+	if err := Setup(mgr, o); err != nil {
+		t.Fatalf("cannot set up controller: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("cannot start manager: %v", err)
+		}
+	}()
+	// Registered after env.Stop so that it runs first.
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return mgr.GetClient()
+}
+
+func eventually(t *testing.T, what string, cond func(ctx context.Context) (bool, error)) {
+	t.Helper()
+	if err := wait.PollUntilContextTimeout(context.Background(), interval, timeout, true, cond); err != nil {
+		t.Fatalf("timed out waiting for %s: %v", what, err)
+	}
+}
+
+func TestProviderConfigUsage(t *testing.T) {
+	kube := startEnvironment(t)
+	ctx := context.Background()
+
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "snowflake-creds", Namespace: "crossplane-system"},
+						Key:             "credentials",
+					},
+				},
+			},
+			Auth: v1beta1.SnowflakeAuth{AuthType: v1beta1.AuthMethodSnowflake, AccountName: "ab12345", OrganizationName: "MYORG"},
+		},
+	}
+	if err := kube.Create(ctx, pc); err != nil {
+		t.Fatalf("cannot create ProviderConfig: %v", err)
+	}
+	key := client.ObjectKeyFromObject(pc)
+
+	eventually(t, "the ProviderConfig to get the in-use finalizer", func(ctx context.Context) (bool, error) {
+		if err := kube.Get(ctx, key, pc); err != nil {
+			return false, err
+		}
+		return meta.FinalizerExists(pc, finalizer), nil
+	})
+
+	pcu := &v1beta1.ProviderConfigUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "3b2ea6b6-6f39-4ae4-a0a0-29b7d1f04e4c",
+			Labels: map[string]string{xpv1.LabelKeyProviderName: pc.Name},
+		},
+		ProviderConfigUsage: xpv1.ProviderConfigUsage{
+			ProviderConfigReference: xpv1.Reference{Name: pc.Name},
+			ResourceReference: xpv1.TypedReference{
+				APIVersion: "database.snowflake.com/v1alpha1",
+				Kind:       "Database",
+				Name:       "analytics",
+			},
+		},
+	}
+	if err := kube.Create(ctx, pcu); err != nil {
+		t.Fatalf("cannot create ProviderConfigUsage: %v", err)
+	}
+
+	eventually(t, "the ProviderConfig to count its user", func(ctx context.Context) (bool, error) {
+		if err := kube.Get(ctx, key, pc); err != nil {
+			return false, err
+		}
+		return pc.Status.Users == 1, nil
+	})
+
+	if err := kube.Delete(ctx, pc); err != nil {
+		t.Fatalf("cannot delete ProviderConfig: %v", err)
+	}
+	// The ProviderConfig must outlive its usages.
+	time.Sleep(2 * time.Second)
+	if err := kube.Get(ctx, key, pc); err != nil {
+		t.Fatalf("ProviderConfig in use was deleted: %v", err)
+	}
+	if !meta.WasDeleted(pc) || !meta.FinalizerExists(pc, finalizer) {
+		t.Fatalf("want ProviderConfig in use to be held by its finalizer, got deletion timestamp %v and finalizers %v", pc.GetDeletionTimestamp(), pc.GetFinalizers())
+	}
+
+	if err := kube.Delete(ctx, pcu); err != nil {
+		t.Fatalf("cannot delete ProviderConfigUsage: %v", err)
+	}
+	eventually(t, "the unused ProviderConfig to be deleted", func(ctx context.Context) (bool, error) {
+		err := kube.Get(ctx, key, &v1beta1.ProviderConfig{})
+		return kerrors.IsNotFound(err), client.IgnoreNotFound(err)
+	})
+}
@@ -11,12 +11,58 @@ import (
 
 	account "github.com/allenkallz/provider-snowflake/internal/controller/account/account"
 	accountrole "github.com/allenkallz/provider-snowflake/internal/controller/account/accountrole"
+	externalvolume "github.com/allenkallz/provider-snowflake/internal/controller/account/externalvolume"
+	resourcemonitor "github.com/allenkallz/provider-snowflake/internal/controller/account/resourcemonitor"
+	user "github.com/allenkallz/provider-snowflake/internal/controller/account/user"
+	warehouse "github.com/allenkallz/provider-snowflake/internal/controller/account/warehouse"
+	alert "github.com/allenkallz/provider-snowflake/internal/controller/database/alert"
 	database "github.com/allenkallz/provider-snowflake/internal/controller/database/database"
 	databaserole "github.com/allenkallz/provider-snowflake/internal/controller/database/databaserole"
+	dynamictable "github.com/allenkallz/provider-snowflake/internal/controller/database/dynamictable"
+	externalfunction "github.com/allenkallz/provider-snowflake/internal/controller/database/externalfunction"
+	externaltable "github.com/allenkallz/provider-snowflake/internal/controller/database/externaltable"
 	fileformat "github.com/allenkallz/provider-snowflake/internal/controller/database/fileformat"
+	functionjava "github.com/allenkallz/provider-snowflake/internal/controller/database/functionjava"
+	functionjavascript "github.com/allenkallz/provider-snowflake/internal/controller/database/functionjavascript"
+	functionpython "github.com/allenkallz/provider-snowflake/internal/controller/database/functionpython"
+	functionscala "github.com/allenkallz/provider-snowflake/internal/controller/database/functionscala"
+	functionsql "github.com/allenkallz/provider-snowflake/internal/controller/database/functionsql"
+	maskingpolicy "github.com/allenkallz/provider-snowflake/internal/controller/database/maskingpolicy"
+	materializedview "github.com/allenkallz/provider-snowflake/internal/controller/database/materializedview"
 	pipe "github.com/allenkallz/provider-snowflake/internal/controller/database/pipe"
+	procedurejava "github.com/allenkallz/provider-snowflake/internal/controller/database/procedurejava"
+	procedurejavascript "github.com/allenkallz/provider-snowflake/internal/controller/database/procedurejavascript"
+	procedurepython "github.com/allenkallz/provider-snowflake/internal/controller/database/procedurepython"
+	procedurescala "github.com/allenkallz/provider-snowflake/internal/controller/database/procedurescala"
+	proceduresql "github.com/allenkallz/provider-snowflake/internal/controller/database/proceduresql"
+	rowaccesspolicy "github.com/allenkallz/provider-snowflake/internal/controller/database/rowaccesspolicy"
+	schema "github.com/allenkallz/provider-snowflake/internal/controller/database/schema"
+	sequence "github.com/allenkallz/provider-snowflake/internal/controller/database/sequence"
 	stage "github.com/allenkallz/provider-snowflake/internal/controller/database/stage"
+	streamondirectorytable "github.com/allenkallz/provider-snowflake/internal/controller/database/streamondirectorytable"
+	streamonexternaltable "github.com/allenkallz/provider-snowflake/internal/controller/database/streamonexternaltable"
+	streamontable "github.com/allenkallz/provider-snowflake/internal/controller/database/streamontable"
+	streamonview "github.com/allenkallz/provider-snowflake/internal/controller/database/streamonview"
+	table "github.com/allenkallz/provider-snowflake/internal/controller/database/table"
+	tablecolumnmaskingpolicyapplication "github.com/allenkallz/provider-snowflake/internal/controller/database/tablecolumnmaskingpolicyapplication"
+	tag "github.com/allenkallz/provider-snowflake/internal/controller/database/tag"
+	tagassociation "github.com/allenkallz/provider-snowflake/internal/controller/database/tagassociation"
+	task "github.com/allenkallz/provider-snowflake/internal/controller/database/task"
+	view "github.com/allenkallz/provider-snowflake/internal/controller/database/view"
+	apiintegration "github.com/allenkallz/provider-snowflake/internal/controller/integration/apiintegration"
+	emailnotificationintegration "github.com/allenkallz/provider-snowflake/internal/controller/integration/emailnotificationintegration"
+	notificationintegration "github.com/allenkallz/provider-snowflake/internal/controller/integration/notificationintegration"
+	storageintegration "github.com/allenkallz/provider-snowflake/internal/controller/integration/storageintegration"
+	networkpolicy "github.com/allenkallz/provider-snowflake/internal/controller/network/networkpolicy"
+	networkpolicyattachment "github.com/allenkallz/provider-snowflake/internal/controller/network/networkpolicyattachment"
+	networkrule "github.com/allenkallz/provider-snowflake/internal/controller/network/networkrule"
 	providerconfig "github.com/allenkallz/provider-snowflake/internal/controller/providerconfig"
+	accountauthenticationpolicyattachment "github.com/allenkallz/provider-snowflake/internal/controller/security/accountauthenticationpolicyattachment"
+	accountpasswordpolicyattachment "github.com/allenkallz/provider-snowflake/internal/controller/security/accountpasswordpolicyattachment"
+	authenticationpolicy "github.com/allenkallz/provider-snowflake/internal/controller/security/authenticationpolicy"
+	passwordpolicy "github.com/allenkallz/provider-snowflake/internal/controller/security/passwordpolicy"
+	userauthenticationpolicyattachment "github.com/allenkallz/provider-snowflake/internal/controller/security/userauthenticationpolicyattachment"
+	userpasswordpolicyattachment "github.com/allenkallz/provider-snowflake/internal/controller/security/userpasswordpolicyattachment"
 )
 
 // Setup creates all controllers with the supplied logger and adds them to
@@ -25,12 +71,58 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		account.Setup,
 		accountrole.Setup,
+		externalvolume.Setup,
+		resourcemonitor.Setup,
+		user.Setup,
+		warehouse.Setup,
+		alert.Setup,
 		database.Setup,
 		databaserole.Setup,
+		dynamictable.Setup,
+		externalfunction.Setup,
+		externaltable.Setup,
 		fileformat.Setup,
+		functionjava.Setup,
+		functionjavascript.Setup,
+		functionpython.Setup,
+		functionscala.Setup,
+		functionsql.Setup,
+		maskingpolicy.Setup,
+		materializedview.Setup,
 		pipe.Setup,
+		procedurejava.Setup,
+		procedurejavascript.Setup,
+		procedurepython.Setup,
+		procedurescala.Setup,
+		proceduresql.Setup,
+		rowaccesspolicy.Setup,
+		schema.Setup,
+		sequence.Setup,
 		stage.Setup,
+		streamondirectorytable.Setup,
+		streamonexternaltable.Setup,
+		streamontable.Setup,
+		streamonview.Setup,
+		table.Setup,
+		tablecolumnmaskingpolicyapplication.Setup,
+		tag.Setup,
+		tagassociation.Setup,
+		task.Setup,
+		view.Setup,
+		apiintegration.Setup,
+		emailnotificationintegration.Setup,
+		notificationintegration.Setup,
+		storageintegration.Setup,
+		networkpolicy.Setup,
+		networkpolicyattachment.Setup,
+		networkrule.Setup,
 		providerconfig.Setup,
+		accountauthenticationpolicyattachment.Setup,
+		accountpasswordpolicyattachment.Setup,
+		authenticationpolicy.Setup,
+		passwordpolicy.Setup,
+		userauthenticationpolicyattachment.Setup,
+		userpasswordpolicyattachment.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,114 @@
+package function
+
+import (
+	"context"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Terraform arguments of the functions and procedures that reference the
+// ConfigMap keys to load their definitions from. They are added to the
+// Terraform schemas in the config package, and left out of the Terraform
+// configuration.
+const (
+	ArgumentFunctionDefinitionConfigMapRef  = "function_definition_config_map_ref"
+	ArgumentProcedureDefinitionConfigMapRef = "procedure_definition_config_map_ref"
+)
+
+const (
+	errPaveObject   = "cannot pave object"
+	errGetConfigMap = "cannot get ConfigMap %s/%s"
+	errNoKeyFn      = "ConfigMap %s/%s has no key %q"
+)
+
+// definitionRefFields are the spec fields of the references to the ConfigMap
+// keys to load the definitions of functions and procedures from.
+var definitionRefFields = []string{
+	"spec.forProvider.functionDefinitionConfigMapRef",
+	"spec.forProvider.procedureDefinitionConfigMapRef",
+}
+
+// A configMapKeySelector references the key of a ConfigMap. Managed resources
+// are cluster scoped, so it includes the namespace of the ConfigMap.
+type configMapKeySelector struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+}
+
+// definitions holds the value of each ConfigMap key referenced by a function
+// or procedure, as last loaded by a DefinitionLoader.
+var definitions sync.Map
+
+// A DefinitionLoader is a managed.Initializer that loads the definition of a
+// function or procedure from the ConfigMap key it references, so that the
+// code can be kept in files of its own. The definition is loaded at every
+// poll, so a change to the ConfigMap is applied at the next one, and is
+// passed to Terraform by Arguments. The managed resource is never changed.
+type DefinitionLoader struct {
+	kube client.Client
+}
+
+// NewDefinitionLoader returns a new DefinitionLoader. It satisfies the
+// signature of upjet's config.NewInitializerFn.
+func NewDefinitionLoader(kube client.Client) managed.Initializer {
+	return &DefinitionLoader{kube: kube}
+}
+
+// Initialize loads the value of the ConfigMap key the supplied function or
+// procedure references, if any.
+func (l *DefinitionLoader) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	for _, f := range definitionRefFields {
+		ref := configMapKeySelector{}
+		if err := p.GetValueInto(f, &ref); err != nil {
+			continue
+		}
+		nn := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		cm := &corev1.ConfigMap{}
+		if err := l.kube.Get(ctx, nn, cm); err != nil {
+			return errors.Wrapf(err, errGetConfigMap, nn.Namespace, nn.Name)
+		}
+		definition, ok := cm.Data[ref.Key]
+		if !ok {
+			return errors.Errorf(errNoKeyFn, nn.Namespace, nn.Name, ref.Key)
+		}
+		definitions.Store(ref, definition)
+	}
+	return nil
+}
+
+// Arguments returns a fn for common.ConfigureArguments that sets the supplied
+// definition argument of the Terraform parameters of a function or procedure
+// to the value DefinitionLoader loaded from the ConfigMap key of the supplied
+// reference argument, if any. The reference takes precedence over an inline
+// definition.
+func Arguments(definition, ref string) func(parameters map[string]any) {
+	return func(parameters map[string]any) {
+		r, ok := parameters[ref].(map[string]any)
+		if !ok {
+			return
+		}
+		key := configMapKeySelector{}
+		key.Name, _ = r["name"].(string)
+		key.Namespace, _ = r["namespace"].(string)
+		key.Key, _ = r["key"].(string)
+		if d, ok := definitions.Load(key); ok {
+			parameters[definition] = d
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestDefinitionLoader(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "functions", Namespace: "analytics"},
+		Data:       map[string]string{"cents.sql": "amount * 100"},
+	}
+	ref := func(key string) *v1alpha1.FunctionSQLFunctionDefinitionConfigMapRefParameters {
+		return &v1alpha1.FunctionSQLFunctionDefinitionConfigMapRefParameters{Name: ptr("functions"), Namespace: ptr("analytics"), Key: ptr(key)}
+	}
+
+	type want struct {
+		err        error
+		definition any
+	}
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.FunctionSQLParameters
+		want   want
+	}{
+		"Inline": {
+			reason: "An inline definition should be left as is.",
+			params: v1alpha1.FunctionSQLParameters{FunctionDefinition: ptr("amount * 100")},
+			want:   want{definition: "amount * 100"},
+		},
+		"ConfigMap": {
+			reason: "The definition should be loaded from the referenced ConfigMap key.",
+			params: v1alpha1.FunctionSQLParameters{FunctionDefinitionConfigMapRef: ref("cents.sql")},
+			want:   want{definition: "amount * 100"},
+		},
+		"ConfigMapOverridesInline": {
+			reason: "The referenced ConfigMap key should take precedence over an inline definition.",
+			params: v1alpha1.FunctionSQLParameters{FunctionDefinition: ptr("amount"), FunctionDefinitionConfigMapRef: ref("cents.sql")},
+			want:   want{definition: "amount * 100"},
+		},
+		"MissingKey": {
+			reason: "A reference to a missing key should return an error.",
+			params: v1alpha1.FunctionSQLParameters{FunctionDefinitionConfigMapRef: ref("dollars.sql")},
+			want:   want{err: errors.Errorf(errNoKeyFn, "analytics", "functions", "dollars.sql")},
+		},
+		"MissingConfigMap": {
+			reason: "A reference to a missing ConfigMap should return an error.",
+			params: v1alpha1.FunctionSQLParameters{FunctionDefinitionConfigMapRef: &v1alpha1.FunctionSQLFunctionDefinitionConfigMapRefParameters{
+				Name: ptr("procedures"), Namespace: ptr("analytics"), Key: ptr("cents.sql"),
+			}},
+			want: want{err: errors.Wrap(
+				kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "procedures"),
+				fmt.Sprintf(errGetConfigMap, "analytics", "procedures"),
+			)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cm).Build()
+			cr := &v1alpha1.FunctionSQL{
+				ObjectMeta: metav1.ObjectMeta{Name: "cents"},
+				Spec:       v1alpha1.FunctionSQLSpec{ForProvider: tc.params},
+			}
+
+			before := cr.DeepCopy()
+
+			err := NewDefinitionLoader(kube).Initialize(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(before, cr); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): resource changed, -want, +got:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			parameters, err := cr.GetParameters()
+			if err != nil {
+				t.Fatal(err)
+			}
+			Arguments("function_definition", ArgumentFunctionDefinitionConfigMapRef)(parameters)
+			if diff := cmp.Diff(tc.want.definition, parameters["function_definition"]); diff != "" {
+				t.Errorf("\n%s\nArguments(...): -want definition, +got definition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
@@ -0,0 +1,76 @@
+// Package function supports the functions and procedures of all languages.
+// Snowflake overloads them by the types of their arguments, so their
+// identifiers include the argument types, and their bodies can be loaded from
+// ConfigMaps rather than written inline.
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errIdentifierFn = "cannot derive identifier: %s is not set"
+)
+
+// synonyms are the synonyms of the Snowflake data types Snowflake reports the
+// argument types of functions and procedures by, for example in SHOW
+// FUNCTIONS.
+var synonyms = map[string][]string{
+	"NUMBER":        {"DECIMAL", "DEC", "NUMERIC", "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT"},
+	"FLOAT":         {"FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION", "REAL"},
+	"VARCHAR":       {"CHAR", "CHARACTER", "NCHAR", "STRING", "TEXT", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"},
+	"BINARY":        {"VARBINARY"},
+	"TIMESTAMP_NTZ": {"TIMESTAMP", "DATETIME", "TIMESTAMPNTZ", "TIMESTAMP WITHOUT TIME ZONE"},
+	"TIMESTAMP_LTZ": {"TIMESTAMPLTZ", "TIMESTAMP WITH LOCAL TIME ZONE"},
+	"TIMESTAMP_TZ":  {"TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE"},
+}
+
+// DataType returns the name Snowflake reports the supplied argument type by,
+// without its precision, length or element types, which do not tell
+// overloads apart. For example, both INT and NUMBER(10, 2) are NUMBER.
+// Types Snowflake has no synonyms for, such as VARIANT, are upper-cased.
+func DataType(t string) string {
+	t = strings.ToUpper(strings.TrimSpace(t))
+	if i := strings.Index(t, "("); i >= 0 {
+		t = strings.TrimSpace(t[:i])
+	}
+	t = strings.Join(strings.Fields(t), " ")
+	for name, s := range synonyms {
+		for _, synonym := range s {
+			if t == synonym {
+				return name
+			}
+		}
+	}
+	return t
+}
+
+// Identifier returns the identifier of the function or procedure with the
+// supplied Terraform parameters, which is its quoted, fully qualified name
+// followed by the types of its arguments, e.g. "DB"."SCHEMA"."F"(NUMBER,
+// VARCHAR). It is the same for all equivalent argument types, so that it
+// does not change when an argument type is written differently.
+func Identifier(parameters map[string]any) (string, error) {
+	p := fieldpath.Pave(parameters)
+	names := make([]string, 0, 3)
+	for _, field := range []string{"database", "schema", "name"} {
+		v, _ := p.GetString(field)
+		if v == "" {
+			return "", errors.Errorf(errIdentifierFn, field)
+		}
+		names = append(names, clients.QuoteIdentifier(v))
+	}
+	args, _ := parameters["arguments"].([]any)
+	types := make([]string, 0, len(args))
+	for i := range args {
+		t, _ := p.GetString(fmt.Sprintf("arguments[%d].arg_data_type", i))
+		types = append(types, DataType(t))
+	}
+	return strings.Join(names, ".") + "(" + strings.Join(types, ", ") + ")", nil
+}
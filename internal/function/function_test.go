@@ -0,0 +1,97 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestDataType(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		t      string
+		want   string
+	}{
+		"Synonym": {
+			reason: "Synonyms should be reported by the name Snowflake reports them by.",
+			t:      "int",
+			want:   "NUMBER",
+		},
+		"Precision": {
+			reason: "Precision and scale should be left out.",
+			t:      "NUMBER(10, 2)",
+			want:   "NUMBER",
+		},
+		"MultipleWords": {
+			reason: "Synonyms of several words should be recognized regardless of whitespace.",
+			t:      " timestamp  with time zone ",
+			want:   "TIMESTAMP_TZ",
+		},
+		"ElementTypes": {
+			reason: "The element types of structured types should be left out.",
+			t:      "ARRAY(VARCHAR)",
+			want:   "ARRAY",
+		},
+		"NoSynonyms": {
+			reason: "Types without synonyms should be upper-cased.",
+			t:      "variant",
+			want:   "VARIANT",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, DataType(tc.t)); diff != "" {
+				t.Errorf("\n%s\nDataType(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+	cases := map[string]struct {
+		reason     string
+		parameters map[string]any
+		want       want
+	}{
+		"Arguments": {
+			reason: "The identifier should include the argument types Snowflake reports.",
+			parameters: map[string]any{
+				"database": "ANALYTICS",
+				"schema":   "RAW",
+				"name":     "parse_event",
+				"arguments": []any{
+					map[string]any{"arg_name": "payload", "arg_data_type": "STRING"},
+					map[string]any{"arg_name": "strict", "arg_data_type": "BOOLEAN", "arg_default_value": "false"},
+				},
+			},
+			want: want{id: `"ANALYTICS"."RAW"."parse_event"(VARCHAR, BOOLEAN)`},
+		},
+		"NoArguments": {
+			reason:     "A function without arguments should have an empty argument list.",
+			parameters: map[string]any{"database": "ANALYTICS", "schema": "RAW", "name": "NOW_UTC"},
+			want:       want{id: `"ANALYTICS"."RAW"."NOW_UTC"()`},
+		},
+		"NoSchema": {
+			reason:     "An identifier cannot be derived without a schema.",
+			parameters: map[string]any{"database": "ANALYTICS", "name": "NOW_UTC"},
+			want:       want{err: errors.Errorf(errIdentifierFn, "schema")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := Identifier(tc.parameters)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nIdentifier(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nIdentifier(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package importer generates observe-only managed resource manifests for the
+// objects that already exist in a Snowflake account.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errUnknownKindFmt = "unknown kind %q"
+	errListFmt        = "cannot list %s objects"
+	errMarshal        = "cannot marshal manifest"
+	errWrite          = "cannot write manifest"
+)
+
+// An Object is an existing Snowflake object that can be imported.
+type Object struct {
+	// Name is a human readable name, used to derive metadata.name.
+	Name string
+	// ExternalName is the Terraform identifier of the object.
+	ExternalName string
+	// ForProvider holds the spec.forProvider fields that identify the
+	// object.
+	ForProvider map[string]any
+}
+
+// A Kind is a managed resource kind that can be imported.
+type Kind struct {
+	APIVersion string
+	Kind       string
+	// List returns the existing objects of this kind.
+	List func(ctx context.Context, c clients.SQLClient) ([]Object, error)
+}
+
+// An Importer writes observe-only manifests for existing objects.
+type Importer struct {
+	client         clients.SQLClient
+	providerConfig string
+	names          map[string]int
+}
+
+// New returns an Importer that lists objects with the supplied client and
+// references the supplied ProviderConfig from the manifests it writes.
+func New(c clients.SQLClient, providerConfig string) *Importer {
+	return &Importer{client: c, providerConfig: providerConfig, names: map[string]int{}}
+}
+
+// KindNames returns the names of all importable kinds.
+func KindNames() []string {
+	names := make([]string, 0, len(Kinds))
+	for _, k := range Kinds {
+		names = append(names, k.Kind)
+	}
+	return names
+}
+
+// Import writes a manifest for every existing object of the supplied kinds,
+// or of all kinds if none are supplied, as a multi-document YAML stream.
+func (i *Importer) Import(ctx context.Context, w io.Writer, kinds ...string) error {
+	selected := Kinds
+	if len(kinds) > 0 {
+		selected = make([]Kind, 0, len(kinds))
+		for _, name := range kinds {
+			k, ok := kindByName(name)
+			if !ok {
+				return errors.Errorf(errUnknownKindFmt, name)
+			}
+			selected = append(selected, k)
+		}
+	}
+	for _, k := range selected {
+		objs, err := k.List(ctx, i.client)
+		if err != nil {
+			return errors.Wrapf(err, errListFmt, k.Kind)
+		}
+		for _, o := range objs {
+			if err := i.write(w, k, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (i *Importer) write(w io.Writer, k Kind, o Object) error {
+	m := map[string]any{
+		"apiVersion": k.APIVersion,
+		"kind":       k.Kind,
+		"metadata": map[string]any{
+			"name": i.name(k.Kind, o.Name),
+			"annotations": map[string]any{
+				meta.AnnotationKeyExternalName: o.ExternalName,
+			},
+		},
+		"spec": map[string]any{
+			"managementPolicies": []string{string(xpv1.ManagementActionObserve)},
+			"forProvider":        o.ForProvider,
+			"providerConfigRef": map[string]any{
+				"name": i.providerConfig,
+			},
+		},
+	}
+	b, err := yaml.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+	_, err = fmt.Fprintf(w, "---\n%s", b)
+	return errors.Wrap(err, errWrite)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// name returns a unique, valid Kubernetes object name for an object.
+func (i *Importer) name(kind, name string) string {
+	n := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if n == "" {
+		n = strings.ToLower(kind)
+	}
+	if len(n) > validation.DNS1123SubdomainMaxLength-6 {
+		n = strings.Trim(n[:validation.DNS1123SubdomainMaxLength-6], "-")
+	}
+	key := kind + "/" + n
+	i.names[key]++
+	if c := i.names[key]; c > 1 {
+		n = fmt.Sprintf("%s-%d", n, c)
+	}
+	return n
+}
+
+func kindByName(name string) (Kind, bool) {
+	for _, k := range Kinds {
+		if strings.EqualFold(k.Kind, name) {
+			return k, true
+		}
+	}
+	return Kind{}, false
+}
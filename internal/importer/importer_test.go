@@ -0,0 +1,310 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+const recordingPath = "../../examples/importer/recording.yaml"
+
+// manifest is what an imported manifest tells about the object it imports.
+type manifest struct {
+	Name         string
+	ExternalName string
+	ForProvider  map[string]any
+}
+
+// manifests parses the manifests of the supplied kind from a YAML stream
+// written by Import, and checks the fields every manifest has in common.
+func manifests(t *testing.T, stream, apiVersion, kind string) []manifest {
+	t.Helper()
+	var got []manifest
+	for _, doc := range strings.Split(stream, "---\n") {
+		if doc == "" {
+			continue
+		}
+		m := struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Metadata   struct {
+				Name        string            `json:"name"`
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+			Spec struct {
+				ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies"`
+				ForProvider        map[string]any          `json:"forProvider"`
+				ProviderConfigRef  xpv1.Reference          `json:"providerConfigRef"`
+			} `json:"spec"`
+		}{}
+		if err := yaml.Unmarshal([]byte(doc), &m); err != nil {
+			t.Fatalf("cannot parse manifest:\n%s\n%v", doc, err)
+		}
+		if m.APIVersion != apiVersion || m.Kind != kind {
+			t.Errorf("Import(...): want only %s %s manifests, got %s %s", apiVersion, kind, m.APIVersion, m.Kind)
+		}
+		if diff := cmp.Diff(xpv1.ManagementPolicies{xpv1.ManagementActionObserve}, m.Spec.ManagementPolicies); diff != "" {
+			t.Errorf("Import(...): -want management policies, +got management policies:\n%s", diff)
+		}
+		if m.Spec.ProviderConfigRef.Name != "snowflake" {
+			t.Errorf("Import(...): want providerConfigRef snowflake, got %q", m.Spec.ProviderConfigRef.Name)
+		}
+		got = append(got, manifest{
+			Name:         m.Metadata.Name,
+			ExternalName: m.Metadata.Annotations[meta.AnnotationKeyExternalName],
+			ForProvider:  m.Spec.ForProvider,
+		})
+	}
+	return got
+}
+
+func named(name string) map[string]any {
+	return map[string]any{"name": name}
+}
+
+func inSchema(database, schema, name string) map[string]any {
+	return map[string]any{"database": database, "schema": schema, "name": name}
+}
+
+// TestImportRecording replays the example recording for each kind. External
+// names must be the identifiers the Terraform provider imports the kind by,
+// which are quoted, dot separated identifiers for most kinds, but
+// database|schema|name for older schema-level kinds such as Stage and Pipe,
+// and bare names for integrations.
+func TestImportRecording(t *testing.T) {
+	r, err := LoadRecording(recordingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string][]manifest{
+		"Account": {{Name: "analytics-prod", ExternalName: `"MYORG"."ANALYTICS_PROD"`, ForProvider: named("ANALYTICS_PROD")}},
+		// System-defined roles such as ACCOUNTADMIN are skipped.
+		"AccountRole":     {{Name: "analyst", ExternalName: `"ANALYST"`, ForProvider: named("ANALYST")}},
+		"Warehouse":       {{Name: "transforming", ExternalName: `"TRANSFORMING"`, ForProvider: named("TRANSFORMING")}},
+		"User":            {{Name: "analyst-jane", ExternalName: `"ANALYST_JANE"`, ForProvider: named("ANALYST_JANE")}},
+		"ResourceMonitor": {{Name: "reporting-monthly", ExternalName: `"REPORTING_MONTHLY"`, ForProvider: named("REPORTING_MONTHLY")}},
+		"ExternalVolume":  {{Name: "lakehouse", ExternalName: `"LAKEHOUSE"`, ForProvider: named("LAKEHOUSE")}},
+		// Application databases such as SNOWFLAKE are skipped.
+		"Database": {{Name: "analytics", ExternalName: `"ANALYTICS"`, ForProvider: named("ANALYTICS")}},
+		"DatabaseRole": {{
+			Name:         "analytics-reader",
+			ExternalName: `"ANALYTICS"."READER"`,
+			ForProvider:  map[string]any{"database": "ANALYTICS", "name": "READER"},
+		}},
+		// Every INFORMATION_SCHEMA is skipped.
+		"Schema": {{
+			Name:         "analytics-raw",
+			ExternalName: `"ANALYTICS"."RAW"`,
+			ForProvider:  map[string]any{"database": "ANALYTICS", "name": "RAW"},
+		}},
+		"Table": {{Name: "analytics-raw-events", ExternalName: "ANALYTICS|RAW|EVENTS", ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS")}},
+		// Materialized views are listed by SHOW VIEWS too, but skipped.
+		"View":             {{Name: "analytics-raw-recent-events", ExternalName: `"ANALYTICS"."RAW"."RECENT_EVENTS"`, ForProvider: inSchema("ANALYTICS", "RAW", "RECENT_EVENTS")}},
+		"MaterializedView": {{Name: "analytics-raw-daily-events", ExternalName: "ANALYTICS|RAW|DAILY_EVENTS", ForProvider: inSchema("ANALYTICS", "RAW", "DAILY_EVENTS")}},
+		"DynamicTable":     {{Name: "analytics-raw-events-by-user", ExternalName: "ANALYTICS|RAW|EVENTS_BY_USER", ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_BY_USER")}},
+		"Task":             {{Name: "analytics-raw-load-events", ExternalName: `"ANALYTICS"."RAW"."LOAD_EVENTS"`, ForProvider: inSchema("ANALYTICS", "RAW", "LOAD_EVENTS")}},
+		// Streams are told apart by the type of their source.
+		"StreamOnTable":          {{Name: "analytics-raw-events-changes", ExternalName: `"ANALYTICS"."RAW"."EVENTS_CHANGES"`, ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_CHANGES")}},
+		"StreamOnView":           {{Name: "analytics-raw-recent-events-changes", ExternalName: `"ANALYTICS"."RAW"."RECENT_EVENTS_CHANGES"`, ForProvider: inSchema("ANALYTICS", "RAW", "RECENT_EVENTS_CHANGES")}},
+		"StreamOnExternalTable":  {{Name: "analytics-raw-events-external-changes", ExternalName: `"ANALYTICS"."RAW"."EVENTS_EXTERNAL_CHANGES"`, ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_EXTERNAL_CHANGES")}},
+		"StreamOnDirectoryTable": {{Name: "analytics-raw-landing-files", ExternalName: `"ANALYTICS"."RAW"."LANDING_FILES"`, ForProvider: inSchema("ANALYTICS", "RAW", "LANDING_FILES")}},
+		"Alert":                  {{Name: "analytics-raw-events-late", ExternalName: "ANALYTICS|RAW|EVENTS_LATE", ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_LATE")}},
+		"Sequence":               {{Name: "analytics-raw-event-ids", ExternalName: "ANALYTICS|RAW|EVENT_IDS", ForProvider: inSchema("ANALYTICS", "RAW", "EVENT_IDS")}},
+		"Tag":                    {{Name: "analytics-governance-cost-center", ExternalName: `"ANALYTICS"."GOVERNANCE"."COST_CENTER"`, ForProvider: inSchema("ANALYTICS", "GOVERNANCE", "COST_CENTER")}},
+		"MaskingPolicy":          {{Name: "analytics-governance-mask-email", ExternalName: `"ANALYTICS"."GOVERNANCE"."MASK_EMAIL"`, ForProvider: inSchema("ANALYTICS", "GOVERNANCE", "MASK_EMAIL")}},
+		"RowAccessPolicy":        {{Name: "analytics-governance-region-access", ExternalName: `"ANALYTICS"."GOVERNANCE"."REGION_ACCESS"`, ForProvider: inSchema("ANALYTICS", "GOVERNANCE", "REGION_ACCESS")}},
+		"FileFormat":             {{Name: "analytics-raw-csv-format", ExternalName: "ANALYTICS|RAW|CSV_FORMAT", ForProvider: inSchema("ANALYTICS", "RAW", "CSV_FORMAT")}},
+		"Stage":                  {{Name: "analytics-raw-landing", ExternalName: "ANALYTICS|RAW|LANDING", ForProvider: inSchema("ANALYTICS", "RAW", "LANDING")}},
+		"Pipe":                   {{Name: "analytics-raw-events-pipe", ExternalName: "ANALYTICS|RAW|EVENTS_PIPE", ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_PIPE")}},
+		"ExternalTable":          {{Name: "analytics-raw-events-external", ExternalName: "ANALYTICS|RAW|EVENTS_EXTERNAL", ForProvider: inSchema("ANALYTICS", "RAW", "EVENTS_EXTERNAL")}},
+		// Functions and procedures are overloaded, so their identifiers and
+		// names include their argument types.
+		"ExternalFunction": {{Name: "analytics-enriched-geocode-varchar-varchar", ExternalName: `"ANALYTICS"."ENRICHED"."GEOCODE"(VARCHAR, VARCHAR)`, ForProvider: inSchema("ANALYTICS", "ENRICHED", "GEOCODE")}},
+		"FunctionSQL":      {{Name: "analytics-raw-cents-number", ExternalName: `"ANALYTICS"."RAW"."CENTS"(NUMBER)`, ForProvider: inSchema("ANALYTICS", "RAW", "CENTS")}},
+		// Optional arguments are listed in brackets.
+		"FunctionPython":     {{Name: "analytics-raw-parse-event-varchar-boolean", ExternalName: `"ANALYTICS"."RAW"."PARSE_EVENT"(VARCHAR, BOOLEAN)`, ForProvider: inSchema("ANALYTICS", "RAW", "PARSE_EVENT")}},
+		"FunctionJava":       {{Name: "analytics-raw-normalize-url-varchar", ExternalName: `"ANALYTICS"."RAW"."NORMALIZE_URL"(VARCHAR)`, ForProvider: inSchema("ANALYTICS", "RAW", "NORMALIZE_URL")}},
+		"FunctionJavaScript": {{Name: "analytics-raw-parse-user-agent-varchar", ExternalName: `"ANALYTICS"."RAW"."PARSE_USER_AGENT"(VARCHAR)`, ForProvider: inSchema("ANALYTICS", "RAW", "PARSE_USER_AGENT")}},
+		"FunctionScala":      {{Name: "analytics-raw-sessionize-array-number", ExternalName: `"ANALYTICS"."RAW"."SESSIONIZE"(ARRAY, NUMBER)`, ForProvider: inSchema("ANALYTICS", "RAW", "SESSIONIZE")}},
+		// Built-in procedures have no schema and are skipped. The language of
+		// procedures is described, as SHOW PROCEDURES does not report it.
+		"ProcedureSQL":        {{Name: "analytics-raw-purge-events-date", ExternalName: `"ANALYTICS"."RAW"."PURGE_EVENTS"(DATE)`, ForProvider: inSchema("ANALYTICS", "RAW", "PURGE_EVENTS")}},
+		"ProcedurePython":     {{Name: "analytics-raw-backfill-events-date-date", ExternalName: `"ANALYTICS"."RAW"."BACKFILL_EVENTS"(DATE, DATE)`, ForProvider: inSchema("ANALYTICS", "RAW", "BACKFILL_EVENTS")}},
+		"ProcedureJava":       {{Name: "analytics-raw-compact-events", ExternalName: `"ANALYTICS"."RAW"."COMPACT_EVENTS"()`, ForProvider: inSchema("ANALYTICS", "RAW", "COMPACT_EVENTS")}},
+		"ProcedureJavaScript": {{Name: "analytics-raw-notify-owners-varchar", ExternalName: `"ANALYTICS"."RAW"."NOTIFY_OWNERS"(VARCHAR)`, ForProvider: inSchema("ANALYTICS", "RAW", "NOTIFY_OWNERS")}},
+		"ProcedureScala":      {{Name: "analytics-raw-rebuild-sessions-number", ExternalName: `"ANALYTICS"."RAW"."REBUILD_SESSIONS"(NUMBER)`, ForProvider: inSchema("ANALYTICS", "RAW", "REBUILD_SESSIONS")}},
+		// Email and queue notification integrations are told apart by type.
+		"EmailNotificationIntegration": {{Name: "data-quality-email", ExternalName: "DATA_QUALITY_EMAIL", ForProvider: named("DATA_QUALITY_EMAIL")}},
+		"NotificationIntegration":      {{Name: "pipeline-errors", ExternalName: "PIPELINE_ERRORS", ForProvider: named("PIPELINE_ERRORS")}},
+		"StorageIntegration":           {{Name: "landing-s3", ExternalName: "LANDING_S3", ForProvider: named("LANDING_S3")}},
+		"ApiIntegration":               {{Name: "enrichment-api", ExternalName: "ENRICHMENT_API", ForProvider: named("ENRICHMENT_API")}},
+		"NetworkRule":                  {{Name: "security-network-office-ips", ExternalName: "SECURITY|NETWORK|OFFICE_IPS", ForProvider: inSchema("SECURITY", "NETWORK", "OFFICE_IPS")}},
+		"NetworkPolicy":                {{Name: "office-only", ExternalName: `"OFFICE_ONLY"`, ForProvider: named("OFFICE_ONLY")}},
+		"PasswordPolicy":               {{Name: "security-policies-strong-passwords", ExternalName: "SECURITY|POLICIES|STRONG_PASSWORDS", ForProvider: inSchema("SECURITY", "POLICIES", "STRONG_PASSWORDS")}},
+		"AuthenticationPolicy":         {{Name: "security-policies-require-mfa", ExternalName: `"SECURITY"."POLICIES"."REQUIRE_MFA"`, ForProvider: inSchema("SECURITY", "POLICIES", "REQUIRE_MFA")}},
+		// Policy attachments are read from the policy references, and
+		// identified by what they attach.
+		"AccountPasswordPolicyAttachment": {{
+			Name:         "account-security-policies-strong-passwords",
+			ExternalName: "SECURITY|POLICIES|STRONG_PASSWORDS",
+			ForProvider:  map[string]any{"passwordPolicy": `"SECURITY"."POLICIES"."STRONG_PASSWORDS"`},
+		}},
+		"AccountAuthenticationPolicyAttachment": {{
+			Name:         "account-security-policies-require-mfa",
+			ExternalName: "SECURITY|POLICIES|REQUIRE_MFA",
+			ForProvider:  map[string]any{"authenticationPolicy": `"SECURITY"."POLICIES"."REQUIRE_MFA"`},
+		}},
+		"UserPasswordPolicyAttachment": {{
+			Name:         "analyst-jane-security-policies-strong-passwords",
+			ExternalName: `"ANALYST_JANE"|"SECURITY"."POLICIES"."STRONG_PASSWORDS"`,
+			ForProvider:  map[string]any{"userName": "ANALYST_JANE", "passwordPolicyName": `"SECURITY"."POLICIES"."STRONG_PASSWORDS"`},
+		}},
+		"UserAuthenticationPolicyAttachment": {{
+			Name:         "analyst-jane-security-policies-require-mfa",
+			ExternalName: `"ANALYST_JANE"|"SECURITY"."POLICIES"."REQUIRE_MFA"`,
+			ForProvider:  map[string]any{"userName": "ANALYST_JANE", "authenticationPolicyName": `"SECURITY"."POLICIES"."REQUIRE_MFA"`},
+		}},
+	}
+
+	for _, k := range Kinds {
+		t.Run(k.Kind, func(t *testing.T) {
+			want, ok := cases[k.Kind]
+			if !ok {
+				t.Fatalf("no expected manifests for kind %s", k.Kind)
+			}
+			w := &bytes.Buffer{}
+			if err := New(r, "snowflake").Import(context.Background(), w, k.Kind); err != nil {
+				t.Fatalf("Import(...): %v", err)
+			}
+			if diff := cmp.Diff(want, manifests(t, w.String(), k.APIVersion, k.Kind)); diff != "" {
+				t.Errorf("Import(...): -want manifests, +got manifests:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	long := strings.Repeat("A", 300)
+
+	type want struct {
+		names []string
+		err   error
+	}
+	cases := map[string]struct {
+		reason    string
+		recording Recording
+		kinds     []string
+		want      want
+	}{
+		"Sanitized": {
+			reason: "Names should be lower-cased, with runs of characters Kubernetes does not allow replaced by a hyphen, and trimmed.",
+			recording: Recording{"SHOW WAREHOUSES": {
+				{"name": "Reporting Warehouse"},
+				{"name": "_ETL__WH$"},
+				{"name": "dev.wh"},
+			}},
+			kinds: []string{"Warehouse"},
+			want:  want{names: []string{"reporting-warehouse", "etl-wh", "dev-wh"}},
+		},
+		"NothingValid": {
+			reason:    "A name without any valid character should be replaced by the kind.",
+			recording: Recording{"SHOW WAREHOUSES": {{"name": "ÜBER"}, {"name": "$$"}}},
+			kinds:     []string{"Warehouse"},
+			want:      want{names: []string{"ber", "warehouse"}},
+		},
+		"Long": {
+			reason:    "Long names should be truncated, leaving room for a suffix.",
+			recording: Recording{"SHOW WAREHOUSES": {{"name": long}, {"name": long}}},
+			kinds:     []string{"Warehouse"},
+			want: want{names: []string{
+				strings.ToLower(long[:validation.DNS1123SubdomainMaxLength-6]),
+				strings.ToLower(long[:validation.DNS1123SubdomainMaxLength-6]) + "-2",
+			}},
+		},
+		"Deduplicated": {
+			reason: "Names that sanitize to the same name should be numbered.",
+			recording: Recording{"SHOW WAREHOUSES": {
+				{"name": "ETL_WH"},
+				{"name": "etl-wh"},
+				{"name": `"ETL WH"`},
+			}},
+			kinds: []string{"Warehouse"},
+			want:  want{names: []string{"etl-wh", "etl-wh-2", "etl-wh-3"}},
+		},
+		"DeduplicatedPerKind": {
+			reason: "Objects of different kinds may have the same name.",
+			recording: Recording{
+				"SHOW WAREHOUSES":        {{"name": "ANALYTICS"}},
+				"SHOW RESOURCE MONITORS": {{"name": "ANALYTICS"}},
+			},
+			kinds: []string{"Warehouse", "resourcemonitor"},
+			want:  want{names: []string{"analytics", "analytics"}},
+		},
+		"UnknownKind": {
+			reason: "An unknown kind should return an error.",
+			kinds:  []string{"Bucket"},
+			want:   want{err: errors.Errorf(errUnknownKindFmt, "Bucket")},
+		},
+		"NotRecorded": {
+			reason: "A query without recorded output should return an error.",
+			kinds:  []string{"Warehouse"},
+			want:   want{err: errors.Wrapf(errors.Errorf(errNotRecordedFmt, "SHOW WAREHOUSES"), errListFmt, "Warehouse")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			err := New(tc.recording, "default").Import(context.Background(), w, tc.kinds...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nImport(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			var names []string
+			for _, doc := range strings.Split(w.String(), "---\n") {
+				if doc == "" {
+					continue
+				}
+				m := struct {
+					Metadata struct {
+						Name string `json:"name"`
+					} `json:"metadata"`
+				}{}
+				if err := yaml.Unmarshal([]byte(doc), &m); err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, m.Metadata.Name)
+			}
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("\n%s\nImport(...): -want names, +got names:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestArgumentTypes(t *testing.T) {
+	cases := map[string]struct {
+		arguments string
+		want      []string
+	}{
+		"None":      {arguments: "F() RETURN NUMBER"},
+		"Optional":  {arguments: "F(VARCHAR, [BOOLEAN]) RETURN VARIANT", want: []string{"VARCHAR", "BOOLEAN"}},
+		"Synonyms":  {arguments: "F(INT, STRING, DOUBLE) RETURN TABLE (A NUMBER)", want: []string{"NUMBER", "VARCHAR", "FLOAT"}},
+		"Timestamp": {arguments: "F(TIMESTAMP_LTZ) RETURN DATE", want: []string{"TIMESTAMP_LTZ"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, argumentTypes(tc.arguments)); diff != "" {
+				t.Errorf("argumentTypes(%q): -want, +got:\n%s", tc.arguments, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,651 @@
+package importer
+
+import (
+	"context"
+	"strings"
+
+	accountv1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	databasev1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	integrationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	networkv1alpha1 "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	securityv1alpha1 "github.com/allenkallz/provider-snowflake/apis/security/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+	"github.com/allenkallz/provider-snowflake/internal/function"
+)
+
+// Kinds are the importable managed resource kinds. External names follow the
+// import formats documented for the corresponding Terraform resources.
+var Kinds = []Kind{
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.Account_Kind,
+		List:       listAccounts,
+	},
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.AccountRole_Kind,
+		List:       listAccountRoles,
+	},
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.Warehouse_Kind,
+		List:       accountObjects("WAREHOUSES"),
+	},
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.User_Kind,
+		List:       accountObjects("USERS"),
+	},
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.ResourceMonitor_Kind,
+		List:       accountObjects("RESOURCE MONITORS"),
+	},
+	{
+		APIVersion: accountv1alpha1.CRDGroupVersion.String(),
+		Kind:       accountv1alpha1.ExternalVolume_Kind,
+		List:       accountObjects("EXTERNAL VOLUMES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Database_Kind,
+		List:       listDatabases,
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.DatabaseRole_Kind,
+		List:       listDatabaseRoles,
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Schema_Kind,
+		List:       listSchemas,
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Table_Kind,
+		List:       schemaObjects("TABLES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.View_Kind,
+		List:       listViews,
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.MaterializedView_Kind,
+		List:       schemaObjects("MATERIALIZED VIEWS"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.DynamicTable_Kind,
+		List:       schemaObjects("DYNAMIC TABLES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Task_Kind,
+		List:       quotedSchemaObjects("TASKS"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.StreamOnTable_Kind,
+		List:       listStreams("Table"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.StreamOnView_Kind,
+		List:       listStreams("View"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.StreamOnExternalTable_Kind,
+		List:       listStreams("External Table"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.StreamOnDirectoryTable_Kind,
+		List:       listStreams("Stage"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Alert_Kind,
+		List:       schemaObjects("ALERTS"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Sequence_Kind,
+		List:       schemaObjects("SEQUENCES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Tag_Kind,
+		List:       quotedSchemaObjects("TAGS"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.MaskingPolicy_Kind,
+		List:       quotedSchemaObjects("MASKING POLICIES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.RowAccessPolicy_Kind,
+		List:       quotedSchemaObjects("ROW ACCESS POLICIES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FileFormat_Kind,
+		List:       schemaObjects("FILE FORMATS"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Stage_Kind,
+		List:       schemaObjects("STAGES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.Pipe_Kind,
+		List:       schemaObjects("PIPES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ExternalTable_Kind,
+		List:       schemaObjects("EXTERNAL TABLES"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ExternalFunction_Kind,
+		List:       functions("EXTERNAL FUNCTIONS", ""),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FunctionSQL_Kind,
+		List:       functions("USER FUNCTIONS", "SQL"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FunctionPython_Kind,
+		List:       functions("USER FUNCTIONS", "PYTHON"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FunctionJava_Kind,
+		List:       functions("USER FUNCTIONS", "JAVA"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FunctionJavaScript_Kind,
+		List:       functions("USER FUNCTIONS", "JAVASCRIPT"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.FunctionScala_Kind,
+		List:       functions("USER FUNCTIONS", "SCALA"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ProcedureSQL_Kind,
+		List:       functions("PROCEDURES", "SQL"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ProcedurePython_Kind,
+		List:       functions("PROCEDURES", "PYTHON"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ProcedureJava_Kind,
+		List:       functions("PROCEDURES", "JAVA"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ProcedureJavaScript_Kind,
+		List:       functions("PROCEDURES", "JAVASCRIPT"),
+	},
+	{
+		APIVersion: databasev1alpha1.CRDGroupVersion.String(),
+		Kind:       databasev1alpha1.ProcedureScala_Kind,
+		List:       functions("PROCEDURES", "SCALA"),
+	},
+	{
+		APIVersion: integrationv1alpha1.CRDGroupVersion.String(),
+		Kind:       integrationv1alpha1.EmailNotificationIntegration_Kind,
+		List:       listNotificationIntegrations(true),
+	},
+	{
+		APIVersion: integrationv1alpha1.CRDGroupVersion.String(),
+		Kind:       integrationv1alpha1.NotificationIntegration_Kind,
+		List:       listNotificationIntegrations(false),
+	},
+	{
+		APIVersion: integrationv1alpha1.CRDGroupVersion.String(),
+		Kind:       integrationv1alpha1.StorageIntegration_Kind,
+		List:       integrations("STORAGE INTEGRATIONS"),
+	},
+	{
+		APIVersion: integrationv1alpha1.CRDGroupVersion.String(),
+		Kind:       integrationv1alpha1.ApiIntegration_Kind,
+		List:       integrations("API INTEGRATIONS"),
+	},
+	{
+		APIVersion: networkv1alpha1.CRDGroupVersion.String(),
+		Kind:       networkv1alpha1.NetworkRule_Kind,
+		List:       schemaObjects("NETWORK RULES"),
+	},
+	{
+		APIVersion: networkv1alpha1.CRDGroupVersion.String(),
+		Kind:       networkv1alpha1.NetworkPolicy_Kind,
+		List:       accountObjects("NETWORK POLICIES"),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.PasswordPolicy_Kind,
+		List:       schemaObjects("PASSWORD POLICIES"),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.AuthenticationPolicy_Kind,
+		List:       quotedSchemaObjects("AUTHENTICATION POLICIES"),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.AccountPasswordPolicyAttachment_Kind,
+		List:       listPolicyAttachments("PASSWORD_POLICY", "passwordPolicy", false),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.AccountAuthenticationPolicyAttachment_Kind,
+		List:       listPolicyAttachments("AUTHENTICATION_POLICY", "authenticationPolicy", false),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.UserPasswordPolicyAttachment_Kind,
+		List:       listPolicyAttachments("PASSWORD_POLICY", "passwordPolicyName", true),
+	},
+	{
+		APIVersion: securityv1alpha1.CRDGroupVersion.String(),
+		Kind:       securityv1alpha1.UserAuthenticationPolicyAttachment_Kind,
+		List:       listPolicyAttachments("AUTHENTICATION_POLICY", "authenticationPolicyName", true),
+	},
+}
+
+func listAccounts(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	rows, err := c.Query(ctx, "SHOW ACCOUNTS")
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(rows))
+	for _, r := range rows {
+		objs = append(objs, Object{
+			Name:         r["account_name"],
+			ExternalName: clients.FullyQualifiedName(r["organization_name"], r["account_name"]),
+			ForProvider:  map[string]any{"name": r["account_name"]},
+		})
+	}
+	return objs, nil
+}
+
+func listAccountRoles(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	rows, err := c.Query(ctx, "SHOW ROLES")
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(rows))
+	for _, r := range rows {
+		// System-defined roles such as ACCOUNTADMIN have no owner.
+		if r["owner"] == "" {
+			continue
+		}
+		objs = append(objs, Object{
+			Name:         r["name"],
+			ExternalName: clients.FullyQualifiedName(r["name"]),
+			ForProvider:  map[string]any{"name": r["name"]},
+		})
+	}
+	return objs, nil
+}
+
+// accountObjects returns a List function for account-level objects, whose
+// Terraform identifiers are quoted names.
+func accountObjects(objects string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW "+objects)
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			objs = append(objs, Object{
+				Name:         r["name"],
+				ExternalName: clients.FullyQualifiedName(r["name"]),
+				ForProvider:  map[string]any{"name": r["name"]},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// integrations returns a List function for integrations, whose Terraform
+// identifiers are unquoted names.
+func integrations(objects string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW "+objects)
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			objs = append(objs, Object{
+				Name:         r["name"],
+				ExternalName: r["name"],
+				ForProvider:  map[string]any{"name": r["name"]},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// listNotificationIntegrations returns a List function for either the email
+// or the queue notification integrations.
+func listNotificationIntegrations(email bool) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW NOTIFICATION INTEGRATIONS")
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			if strings.HasPrefix(strings.ToUpper(r["type"]), "EMAIL") != email {
+				continue
+			}
+			objs = append(objs, Object{
+				Name:         r["name"],
+				ExternalName: r["name"],
+				ForProvider:  map[string]any{"name": r["name"]},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// listPolicyAttachments returns a List function for the attachments of the
+// supplied kind of policy to either users or the account, which sets the
+// policy on the supplied spec.forProvider field. Attachments are read from
+// the POLICY_REFERENCES view of the shared SNOWFLAKE database, as SHOW USERS
+// and SHOW PARAMETERS do not tell which policies are set.
+func listPolicyAttachments(policyKind, policyField string, users bool) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SELECT POLICY_DB, POLICY_SCHEMA, POLICY_NAME, POLICY_KIND, REF_ENTITY_NAME, REF_ENTITY_DOMAIN FROM SNOWFLAKE.ACCOUNT_USAGE.POLICY_REFERENCES")
+		if err != nil {
+			return nil, err
+		}
+		domain := "ACCOUNT"
+		if users {
+			domain = "USER"
+		}
+		objs := []Object{}
+		for _, r := range rows {
+			if !strings.EqualFold(r["policy_kind"], policyKind) || !strings.EqualFold(r["ref_entity_domain"], domain) {
+				continue
+			}
+			db, schema, policy := r["policy_db"], r["policy_schema"], r["policy_name"]
+			o := Object{
+				Name:         strings.Join([]string{"account", db, schema, policy}, "-"),
+				ExternalName: strings.Join([]string{db, schema, policy}, "|"),
+				ForProvider:  map[string]any{policyField: clients.FullyQualifiedName(db, schema, policy)},
+			}
+			if users {
+				user := r["ref_entity_name"]
+				o.Name = strings.Join([]string{user, db, schema, policy}, "-")
+				o.ExternalName = clients.FullyQualifiedName(user) + "|" + clients.FullyQualifiedName(db, schema, policy)
+				o.ForProvider["userName"] = user
+			}
+			objs = append(objs, o)
+		}
+		return objs, nil
+	}
+}
+
+// standardDatabases returns the names of the databases that can be managed
+// as Databases, skipping shared and application databases.
+func standardDatabases(ctx context.Context, c clients.SQLClient) ([]string, error) {
+	rows, err := c.Query(ctx, "SHOW DATABASES")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		if k := r["kind"]; k != "" && k != "STANDARD" {
+			continue
+		}
+		names = append(names, r["name"])
+	}
+	return names, nil
+}
+
+func listDatabases(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	names, err := standardDatabases(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(names))
+	for _, n := range names {
+		objs = append(objs, Object{
+			Name:         n,
+			ExternalName: clients.FullyQualifiedName(n),
+			ForProvider:  map[string]any{"name": n},
+		})
+	}
+	return objs, nil
+}
+
+func listDatabaseRoles(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	dbs, err := standardDatabases(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var objs []Object
+	for _, db := range dbs {
+		rows, err := c.Query(ctx, "SHOW DATABASE ROLES IN DATABASE "+clients.QuoteIdentifier(db))
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			objs = append(objs, Object{
+				Name:         db + "-" + r["name"],
+				ExternalName: clients.FullyQualifiedName(db, r["name"]),
+				ForProvider:  map[string]any{"database": db, "name": r["name"]},
+			})
+		}
+	}
+	return objs, nil
+}
+
+func listSchemas(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	rows, err := c.Query(ctx, "SHOW SCHEMAS IN ACCOUNT")
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(rows))
+	for _, r := range rows {
+		db, name := r["database_name"], r["name"]
+		// Every database has an INFORMATION_SCHEMA that Snowflake manages.
+		if name == "INFORMATION_SCHEMA" {
+			continue
+		}
+		objs = append(objs, Object{
+			Name:         db + "-" + name,
+			ExternalName: clients.FullyQualifiedName(db, name),
+			ForProvider:  map[string]any{"database": db, "name": name},
+		})
+	}
+	return objs, nil
+}
+
+func listViews(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	rows, err := c.Query(ctx, "SHOW VIEWS IN ACCOUNT")
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(rows))
+	for _, r := range rows {
+		db, schema, name := r["database_name"], r["schema_name"], r["name"]
+		// SHOW VIEWS lists materialized views and the views Snowflake keeps
+		// in every INFORMATION_SCHEMA too.
+		if strings.EqualFold(r["is_materialized"], "true") || schema == "INFORMATION_SCHEMA" {
+			continue
+		}
+		objs = append(objs, Object{
+			Name:         strings.Join([]string{db, schema, name}, "-"),
+			ExternalName: clients.FullyQualifiedName(db, schema, name),
+			ForProvider:  map[string]any{"database": db, "schema": schema, "name": name},
+		})
+	}
+	return objs, nil
+}
+
+// listStreams returns a List function for the streams on the supplied type of
+// source object, as reported in the source_type column of SHOW STREAMS.
+func listStreams(sourceType string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW STREAMS IN ACCOUNT")
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			if !strings.EqualFold(r["source_type"], sourceType) {
+				continue
+			}
+			db, schema, name := r["database_name"], r["schema_name"], r["name"]
+			objs = append(objs, Object{
+				Name:         strings.Join([]string{db, schema, name}, "-"),
+				ExternalName: clients.FullyQualifiedName(db, schema, name),
+				ForProvider:  map[string]any{"database": db, "schema": schema, "name": name},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// schemaObjects returns a List function for schema-level objects, whose
+// Terraform identifiers are database|schema|name.
+func schemaObjects(objects string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW "+objects+" IN ACCOUNT")
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			db, schema, name := r["database_name"], r["schema_name"], r["name"]
+			objs = append(objs, Object{
+				Name:         strings.Join([]string{db, schema, name}, "-"),
+				ExternalName: strings.Join([]string{db, schema, name}, "|"),
+				ForProvider:  map[string]any{"database": db, "schema": schema, "name": name},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// quotedSchemaObjects returns a List function for schema-level objects, whose
+// Terraform identifiers are quoted, dot separated identifiers.
+func quotedSchemaObjects(objects string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW "+objects+" IN ACCOUNT")
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			db, schema, name := r["database_name"], r["schema_name"], r["name"]
+			objs = append(objs, Object{
+				Name:         strings.Join([]string{db, schema, name}, "-"),
+				ExternalName: clients.FullyQualifiedName(db, schema, name),
+				ForProvider:  map[string]any{"database": db, "schema": schema, "name": name},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// functions returns a List function for the functions or procedures listed
+// by SHOW objects IN ACCOUNT, whose Terraform identifiers are quoted, dot
+// separated identifiers followed by their argument types, e.g.
+// "DB"."SCHEMA"."F"(VARCHAR, NUMBER), as they are overloaded by their
+// arguments. If language is not empty, only the ones written in it are
+// listed.
+func functions(objects, language string) func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+	return func(ctx context.Context, c clients.SQLClient) ([]Object, error) {
+		rows, err := c.Query(ctx, "SHOW "+objects+" IN ACCOUNT")
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]Object, 0, len(rows))
+		for _, r := range rows {
+			// Built-in functions and procedures have no schema.
+			db, schema, name := r["catalog_name"], r["schema_name"], r["name"]
+			if db == "" || schema == "" {
+				continue
+			}
+			types := argumentTypes(r["arguments"])
+			id := clients.FullyQualifiedName(db, schema, name) + "(" + strings.Join(types, ", ") + ")"
+			if language != "" {
+				l, err := functionLanguage(ctx, c, objects, id, r)
+				if err != nil {
+					return nil, err
+				}
+				if !strings.EqualFold(l, language) {
+					continue
+				}
+			}
+			objs = append(objs, Object{
+				Name:         strings.Join([]string{db, schema, name, strings.Join(types, "-")}, "-"),
+				ExternalName: id,
+				ForProvider:  map[string]any{"database": db, "schema": schema, "name": name},
+			})
+		}
+		return objs, nil
+	}
+}
+
+// functionLanguage returns the language of the function or procedure with
+// the supplied identifier and SHOW output. SHOW FUNCTIONS reports it, while
+// procedures have to be described.
+func functionLanguage(ctx context.Context, c clients.SQLClient, objects, id string, r map[string]string) (string, error) {
+	if l := r["language"]; l != "" {
+		return l, nil
+	}
+	object := "FUNCTION"
+	if strings.HasSuffix(objects, "PROCEDURES") {
+		object = "PROCEDURE"
+	}
+	rows, err := c.Query(ctx, "DESCRIBE "+object+" "+id)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range rows {
+		if p["property"] == "language" {
+			return p["value"], nil
+		}
+	}
+	return "", nil
+}
+
+// argumentTypes returns the argument types of a function from the arguments
+// column of SHOW FUNCTIONS, e.g. VARCHAR and NUMBER from
+// F(VARCHAR, [NUMBER]) RETURN VARIANT, where optional arguments are in
+// brackets. They are the types function.Identifier derives identifiers from.
+func argumentTypes(arguments string) []string {
+	signature, _, _ := strings.Cut(arguments, ") RETURN ")
+	_, list, _ := strings.Cut(signature, "(")
+	list = strings.TrimSuffix(list, ")")
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	types := strings.Split(list, ",")
+	for i := range types {
+		types[i] = function.DataType(strings.Trim(types[i], " []"))
+	}
+	return types
+}
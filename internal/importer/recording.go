@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errReadRecording   = "cannot read recording"
+	errParseRecording  = "cannot parse recording"
+	errNotRecordedFmt  = "no recorded output for %q"
+	errExecNotRecorded = "recordings are read only"
+)
+
+// A Recording is a clients.SQLClient that replays recorded query output
+// instead of connecting to Snowflake. It maps each statement to the rows it
+// returned, keyed by lower-cased column names, e.g.:
+//
+//	SHOW DATABASES:
+//	- name: ANALYTICS
+//	  kind: STANDARD
+type Recording map[string][]map[string]string
+
+var _ clients.SQLClient = Recording{}
+
+// LoadRecording reads a Recording from a YAML or JSON file.
+func LoadRecording(path string) (Recording, error) {
+	b, err := os.ReadFile(path) //nolint:gosec // the path is supplied by the user on purpose
+	if err != nil {
+		return nil, errors.Wrap(err, errReadRecording)
+	}
+	r := Recording{}
+	return r, errors.Wrap(yaml.Unmarshal(b, &r), errParseRecording)
+}
+
+// Query returns the rows recorded for the supplied statement. Statements
+// are matched case-insensitively, ignoring surrounding whitespace.
+func (r Recording) Query(_ context.Context, query string, _ ...any) ([]map[string]string, error) {
+	for q, rows := range r {
+		if strings.EqualFold(strings.TrimSpace(q), strings.TrimSpace(query)) {
+			return rows, nil
+		}
+	}
+	return nil, errors.Errorf(errNotRecordedFmt, query)
+}
+
+// Exec always fails, since importing never modifies Snowflake.
+func (r Recording) Exec(_ context.Context, _ string, _ ...any) error {
+	return errors.New(errExecNotRecorded)
+}
+
+// Close does nothing.
+func (r Recording) Close() error {
+	return nil
+}
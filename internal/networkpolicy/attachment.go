@@ -0,0 +1,24 @@
+package networkpolicy
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	fieldPolicyName  = "network_policy_name"
+	attachmentSuffix = "_attachment"
+
+	errIdentifierFn = "cannot derive identifier: %s is not set"
+)
+
+// AttachmentIdentifier returns the identifier of the NetworkPolicyAttachment
+// with the supplied Terraform parameters, which is the name of the network
+// policy followed by _attachment, as the Terraform provider builds it. There
+// is at most one attachment of each policy, to the account and its users.
+func AttachmentIdentifier(parameters map[string]any) (string, error) {
+	name, _ := parameters[fieldPolicyName].(string)
+	if name == "" {
+		return "", errors.Errorf(errIdentifierFn, fieldPolicyName)
+	}
+	return name + attachmentSuffix, nil
+}
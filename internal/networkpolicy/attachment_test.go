@@ -0,0 +1,62 @@
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+)
+
+func TestAttachmentIdentifier(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+	cases := map[string]struct {
+		reason     string
+		parameters map[string]any
+		want       want
+	}{
+		"Policy": {
+			reason:     "The identifier should be the policy name followed by _attachment.",
+			parameters: map[string]any{fieldPolicyName: "OFFICE_ONLY", "set_for_account": true},
+			want:       want{id: "OFFICE_ONLY_attachment"},
+		},
+		"NoPolicy": {
+			reason:     "An identifier cannot be derived without a policy.",
+			parameters: map[string]any{"set_for_account": true},
+			want:       want{err: errors.Errorf(errIdentifierFn, fieldPolicyName)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := common.IdentifierFromParameters(config.IdentifierFromProvider, AttachmentIdentifier)
+			id, err := e.GetIDFn(context.Background(), "", tc.parameters, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			tfstate := map[string]any{"id": id}
+			for k, v := range tc.parameters {
+				tfstate[k] = v
+			}
+			externalName, err := e.GetExternalNameFn(tfstate)
+			if err != nil {
+				t.Fatalf("\n%s\nGetExternalNameFn(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(id, externalName); diff != "" {
+				t.Errorf("\n%s\nGetExternalNameFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	// AnnotationKeyAllowSelfLockout is the annotation that lets a
+	// NetworkPolicyAttachment attach a policy that does not allow the IP
+	// address the provider connects to Snowflake from.
+	AnnotationKeyAllowSelfLockout = "snowflake.com/allow-self-lockout"
+
+	errPaveObject      = "cannot pave object"
+	errGetClient       = "cannot determine the user and IP address of the provider"
+	errDescribePolicy  = "cannot describe network policy"
+	errDescribeRule    = "cannot describe network rule"
+	errParseRuleList   = "cannot parse network rule list"
+	errParseClientIPFn = "cannot parse client IP address %q"
+	errSelfLockoutFn   = "%q would attach network policy %q to %s, which does not allow the provider's IP address %s: set the %q annotation to \"true\" to attach it anyway"
+
+	queryClient = `SELECT CURRENT_USER() AS "USER_NAME", CURRENT_IP_ADDRESS() AS "IP_ADDRESS"`
+
+	// Rows of DESCRIBE NETWORK POLICY.
+	policyAllowedIPs   = "ALLOWED_IP_LIST"
+	policyBlockedIPs   = "BLOCKED_IP_LIST"
+	policyAllowedRules = "ALLOWED_NETWORK_RULE_LIST"
+	policyBlockedRules = "BLOCKED_NETWORK_RULE_LIST"
+	ruleNameKey        = "fullyQualifiedRuleName"
+
+	ruleTypeIPv4    = "IPV4"
+	ruleModeIngress = "INGRESS"
+)
+
+// NetworkAccess is what a network policy allows and blocks, as far as it
+// concerns public IPv4 addresses.
+type NetworkAccess struct {
+	// Allowed and Blocked are IPv4 addresses and CIDR ranges, from the IP
+	// lists of the policy and its IPV4 ingress network rules.
+	Allowed []string
+	Blocked []string
+	// OtherAllowed is true if the policy allows other network identifiers,
+	// such as private endpoints, which restricts access to them too.
+	OtherAllowed bool
+}
+
+// Allows returns true if the supplied IP address may connect. Blocked
+// addresses are refused, and when anything is allowed only the allowed
+// addresses may connect.
+func (a NetworkAccess) Allows(ip net.IP) bool {
+	if matches(a.Blocked, ip) {
+		return false
+	}
+	if len(a.Allowed) == 0 && !a.OtherAllowed {
+		return true
+	}
+	return matches(a.Allowed, ip)
+}
+
+func matches(entries []string, ip net.IP) bool {
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if _, n, err := net.ParseCIDR(e); err == nil {
+			if n.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if other := net.ParseIP(e); other != nil && other.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// referenceResolver is a managed resource that resolves its references, as
+// the generated managed resources do.
+type referenceResolver interface {
+	resource.Managed
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// Guard is a managed.Initializer for NetworkPolicyAttachments. Before a
+// network policy is attached to the account, or to the user the provider
+// connects as, it checks that the policy allows the IP address the provider
+// connects from. Attaching a policy that does not would lock the
+// provider, and possibly everyone else, out of Snowflake, so it is refused
+// unless the attachment carries the AnnotationKeyAllowSelfLockout annotation.
+// Attachments that are already applied are not checked again.
+type Guard struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+}
+
+// NewGuard returns a new Guard. It satisfies the signature of upjet's
+// config.NewInitializerFn.
+func NewGuard(kube client.Client) managed.Initializer {
+	return &Guard{kube: kube, newClient: clients.NewSQLClient}
+}
+
+// Initialize refuses to attach a network policy that would lock the provider
+// out.
+func (g *Guard) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || strings.EqualFold(mg.GetAnnotations()[AnnotationKeyAllowSelfLockout], "true") {
+		return nil
+	}
+	// Initializers run before references are resolved, so the policy and
+	// users may not be set yet when they are referenced. Check what they
+	// resolve to, on a copy as the reconciler resolves them itself. If they
+	// cannot be resolved yet, neither can the reconciler, and nothing is
+	// attached.
+	if r, ok := mg.DeepCopyObject().(referenceResolver); ok {
+		if err := r.ResolveReferences(ctx, g.kube); err != nil {
+			return nil //nolint:nilerr // the reconciler reports it
+		}
+		mg = r
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	policy, checkAccount, newUsers := attaching(mg, p)
+	if policy == "" || (!checkAccount && len(newUsers) == 0) {
+		return nil
+	}
+
+	c, err := g.newClient(ctx, g.kube, mg)
+	if err != nil {
+		return errors.Wrap(err, errGetClient)
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	rows, err := c.Query(ctx, queryClient)
+	if err != nil {
+		return errors.Wrap(err, errGetClient)
+	}
+	if len(rows) == 0 {
+		return errors.New(errGetClient)
+	}
+	user, address := rows[0]["user_name"], rows[0]["ip_address"]
+	target := "the account"
+	if !checkAccount {
+		if !containsName(newUsers, user) {
+			return nil
+		}
+		target = fmt.Sprintf("its own user %q", user)
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return errors.Errorf(errParseClientIPFn, address)
+	}
+
+	access, err := describeNetworkPolicy(ctx, c, policy)
+	if err != nil {
+		return err
+	}
+	if access.Allows(ip) {
+		return nil
+	}
+	return errors.Errorf(errSelfLockoutFn, mg.GetName(), policy, target, address, AnnotationKeyAllowSelfLockout)
+}
+
+// attaching returns the network policy of the supplied attachment, whether
+// the next apply attaches it to the account, and the users it attaches it to.
+// What is already attached is not returned.
+func attaching(mg resource.Managed, p *fieldpath.Paved) (policy string, account bool, users []string) {
+	policy, _ = p.GetString("spec.forProvider.networkPolicyName")
+	account, _ = p.GetBool("spec.forProvider.setForAccount")
+	users, _ = p.GetStringArray("spec.forProvider.users")
+	observedPolicy, _ := p.GetString("status.atProvider.networkPolicyName")
+	observedAccount, _ := p.GetBool("status.atProvider.setForAccount")
+	observedUsers, _ := p.GetStringArray("status.atProvider.users")
+
+	if meta.GetExternalName(mg) == "" || !strings.EqualFold(policy, observedPolicy) {
+		return policy, account, users
+	}
+	var added []string
+	for _, u := range users {
+		if !containsName(observedUsers, u) {
+			added = append(added, u)
+		}
+	}
+	return policy, account && !observedAccount, added
+}
+
+// describeNetworkPolicy returns the access the supplied network policy and
+// its network rules grant.
+func describeNetworkPolicy(ctx context.Context, c clients.SQLClient, policy string) (NetworkAccess, error) {
+	a := NetworkAccess{}
+	rows, err := c.Query(ctx, "DESCRIBE NETWORK POLICY "+clients.QuoteIdentifier(policy))
+	if err != nil {
+		return a, errors.Wrap(err, errDescribePolicy)
+	}
+	for _, r := range rows {
+		switch strings.ToUpper(r["name"]) {
+		case policyAllowedIPs:
+			a.Allowed = append(a.Allowed, splitList(r["value"])...)
+		case policyBlockedIPs:
+			a.Blocked = append(a.Blocked, splitList(r["value"])...)
+		case policyAllowedRules, policyBlockedRules:
+			allowed := strings.EqualFold(r["name"], policyAllowedRules)
+			rules := []map[string]string{}
+			if err := json.Unmarshal([]byte(r["value"]), &rules); err != nil {
+				return a, errors.Wrap(err, errParseRuleList)
+			}
+			for _, rule := range rules {
+				if err := describeNetworkRule(ctx, c, rule[ruleNameKey], allowed, &a); err != nil {
+					return a, err
+				}
+			}
+		}
+	}
+	return a, nil
+}
+
+// describeNetworkRule adds the identifiers of the supplied network rule to
+// the supplied access.
+func describeNetworkRule(ctx context.Context, c clients.SQLClient, rule string, allowed bool, a *NetworkAccess) error {
+	rows, err := c.Query(ctx, "DESCRIBE NETWORK RULE "+ruleIdentifier(rule))
+	if err != nil {
+		return errors.Wrap(err, errDescribeRule)
+	}
+	for _, r := range rows {
+		if !strings.EqualFold(r["mode"], ruleModeIngress) {
+			continue
+		}
+		switch {
+		case !strings.EqualFold(r["type"], ruleTypeIPv4):
+			a.OtherAllowed = a.OtherAllowed || allowed
+		case allowed:
+			a.Allowed = append(a.Allowed, splitList(r["value_list"])...)
+		default:
+			a.Blocked = append(a.Blocked, splitList(r["value_list"])...)
+		}
+	}
+	return nil
+}
+
+// ruleIdentifier returns the supplied fully qualified network rule name with
+// each of its parts quoted, so that it is used as a single identifier whatever
+// it contains.
+func ruleIdentifier(name string) string {
+	parts := clients.SplitIdentifier(name)
+	for i, p := range parts {
+		parts[i] = clients.QuoteIdentifier(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+func splitList(s string) []string {
+	var l []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			l = append(l, e)
+		}
+	}
+	return l
+}
+
+// containsName returns true if the supplied identifiers contain the supplied
+// name, ignoring quotes and case as Snowflake does for unquoted names.
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(strings.Trim(n, `"`), strings.Trim(name, `"`)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package networkpolicy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	accountv1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	sqlfake "github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+type attachmentOption func(*v1alpha1.NetworkPolicyAttachment)
+
+// newAttachment returns a NetworkPolicyAttachment that is not yet created.
+func newAttachment(o ...attachmentOption) *v1alpha1.NetworkPolicyAttachment {
+	cr := &v1alpha1.NetworkPolicyAttachment{ObjectMeta: metav1.ObjectMeta{Name: "office-only"}}
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+func withPolicy(name string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		cr.Spec.ForProvider.NetworkPolicyName = &name
+	}
+}
+
+func withPolicyRef(name string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		cr.Spec.ForProvider.NetworkPolicyNameRef = &xpv1.Reference{Name: name}
+	}
+}
+
+func forAccount() attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		account := true
+		cr.Spec.ForProvider.SetForAccount = &account
+	}
+}
+
+func forUsers(names ...string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		for i := range names {
+			cr.Spec.ForProvider.Users = append(cr.Spec.ForProvider.Users, &names[i])
+		}
+	}
+}
+
+func forUserRefs(names ...string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		for _, n := range names {
+			cr.Spec.ForProvider.UsersRefs = append(cr.Spec.ForProvider.UsersRefs, xpv1.Reference{Name: n})
+		}
+	}
+}
+
+// applied marks the attachment created with its spec applied, except for the
+// supplied users.
+func applied(except ...string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		meta.SetExternalName(cr, "office-only")
+		p := cr.Spec.ForProvider
+		cr.Status.AtProvider = v1alpha1.NetworkPolicyAttachmentObservation{NetworkPolicyName: p.NetworkPolicyName, SetForAccount: p.SetForAccount}
+		for _, u := range p.Users {
+			if !containsName(except, *u) {
+				cr.Status.AtProvider.Users = append(cr.Status.AtProvider.Users, u)
+			}
+		}
+	}
+}
+
+func withAnnotation(key, value string) attachmentOption {
+	return func(cr *v1alpha1.NetworkPolicyAttachment) {
+		meta.AddAnnotations(cr, map[string]string{key: value})
+	}
+}
+
+func TestNetworkAccessAllows(t *testing.T) {
+	cases := map[string]struct {
+		access NetworkAccess
+		ip     string
+		want   bool
+	}{
+		"Unrestricted":   {access: NetworkAccess{}, ip: "203.0.113.7", want: true},
+		"AllowedCIDR":    {access: NetworkAccess{Allowed: []string{"203.0.113.0/24"}}, ip: "203.0.113.7", want: true},
+		"AllowedAddress": {access: NetworkAccess{Allowed: []string{"198.51.100.1", " 203.0.113.7"}}, ip: "203.0.113.7", want: true},
+		"NotAllowed":     {access: NetworkAccess{Allowed: []string{"198.51.100.0/24"}}, ip: "203.0.113.7", want: false},
+		"Blocked":        {access: NetworkAccess{Allowed: []string{"0.0.0.0/0"}, Blocked: []string{"203.0.113.7"}}, ip: "203.0.113.7", want: false},
+		"OnlyPrivate":    {access: NetworkAccess{OtherAllowed: true}, ip: "203.0.113.7", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.access.Allows(net.ParseIP(tc.ip)); got != tc.want {
+				t.Errorf("Allows(%s): want %t, got %t", tc.ip, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGuardInitialize(t *testing.T) {
+	me := map[string][]map[string]string{queryClient: {{"user_name": "CROSSPLANE", "ip_address": "203.0.113.7"}}}
+	policy := func(rows ...map[string]string) map[string][]map[string]string {
+		r := map[string][]map[string]string{`DESCRIBE NETWORK POLICY "OFFICE_ONLY"`: rows}
+		for k, v := range me {
+			r[k] = v
+		}
+		return r
+	}
+	office := map[string]string{"name": "ALLOWED_IP_LIST", "value": "198.51.100.0/24"}
+	policyName, userName := "OFFICE_ONLY", "CROSSPLANE"
+	objs := []client.Object{
+		&v1alpha1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-only"},
+			Spec:       v1alpha1.NetworkPolicySpec{ForProvider: v1alpha1.NetworkPolicyParameters{Name: &policyName}},
+		},
+		&accountv1alpha1.User{
+			ObjectMeta: metav1.ObjectMeta{Name: "crossplane"},
+			Spec:       accountv1alpha1.UserSpec{ForProvider: accountv1alpha1.UserParameters{Name: &userName}},
+		},
+	}
+	lockout := func(target string) error {
+		return errors.Errorf(errSelfLockoutFn, "office-only", "OFFICE_ONLY", target, "203.0.113.7", AnnotationKeyAllowSelfLockout)
+	}
+
+	type want struct {
+		err     error
+		queries int
+	}
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.NetworkPolicyAttachment
+		rows   map[string][]map[string]string
+		want   want
+	}{
+		"AllowedByAnnotation": {
+			reason: "An attachment annotated to allow a lockout should not be checked.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forAccount(), withAnnotation(AnnotationKeyAllowSelfLockout, "true")),
+		},
+		"AlreadyAttached": {
+			reason: "An attachment that is already applied should not be checked again.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forAccount(), applied()),
+		},
+		"AccountAllowed": {
+			reason: "A policy that allows the provider's IP address may be attached to the account.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forAccount()),
+			rows:   policy(office, map[string]string{"name": "ALLOWED_IP_LIST", "value": "203.0.113.0/24"}),
+			want:   want{queries: 2},
+		},
+		"AccountLockout": {
+			reason: "A policy that does not allow the provider's IP address must not be attached to the account.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forAccount()),
+			rows:   policy(office),
+			want:   want{err: lockout("the account"), queries: 2},
+		},
+		"OtherUser": {
+			reason: "A policy may be attached to users other than the provider's own.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forUsers("ANALYST")),
+			rows:   policy(office),
+			want:   want{queries: 1},
+		},
+		"OwnUserBlockedByRule": {
+			reason: "Network rules that block the provider's IP address should be taken into account.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forUsers("ANALYST", `"crossplane"`), applied(`"crossplane"`)),
+			rows: func() map[string][]map[string]string {
+				r := policy(map[string]string{"name": "BLOCKED_NETWORK_RULE_LIST", "value": `[{"fullyQualifiedRuleName":"\"SECURITY\".\"NETWORK\".\"BLOCKLIST\""}]`})
+				r[`DESCRIBE NETWORK RULE "SECURITY"."NETWORK"."BLOCKLIST"`] = []map[string]string{{"type": "IPV4", "mode": "INGRESS", "value_list": "192.0.2.1,203.0.113.0/28"}}
+				return r
+			}(),
+			want: want{err: lockout(`its own user "CROSSPLANE"`), queries: 3},
+		},
+		"ReferencedPolicy": {
+			reason: "A referenced policy should be checked, although references are resolved after initializers run.",
+			mg:     newAttachment(withPolicyRef("office-only"), forAccount()),
+			rows:   policy(office),
+			want:   want{err: lockout("the account"), queries: 2},
+		},
+		"ReferencedOwnUser": {
+			reason: "Referenced users should be checked, although references are resolved after initializers run.",
+			mg:     newAttachment(withPolicy("OFFICE_ONLY"), forUserRefs("crossplane")),
+			rows:   policy(office),
+			want:   want{err: lockout(`its own user "CROSSPLANE"`), queries: 2},
+		},
+		"UnresolvedReference": {
+			reason: "Nothing is attached while references cannot be resolved.",
+			mg:     newAttachment(withPolicyRef("missing"), forAccount()),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := accountv1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			sql := &sqlfake.SQLClient{Rows: tc.rows}
+			g := &Guard{
+				kube:      fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+				newClient: sqlfake.NewSQLClientFn(sql),
+			}
+			err := g.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.queries, len(sql.Queries)); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want queries, +got queries:\n%s\n%v", tc.reason, diff, sql.Queries)
+			}
+		})
+	}
+}
+
+func TestRuleIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Quoted":        {name: `"SECURITY"."NETWORK"."BLOCKLIST"`, want: `"SECURITY"."NETWORK"."BLOCKLIST"`},
+		"Unquoted":      {name: "security.network.blocklist", want: `"SECURITY"."NETWORK"."BLOCKLIST"`},
+		"QuotedDot":     {name: `SECURITY.NETWORK."block.list"`, want: `"SECURITY"."NETWORK"."block.list"`},
+		"EscapedQuote":  {name: `SECURITY.NETWORK."a""b"`, want: `"SECURITY"."NETWORK"."a""b"`},
+		"StatementText": {name: `SECURITY.NETWORK.X; DROP DATABASE SECURITY`, want: `"SECURITY"."NETWORK"."X; DROP DATABASE SECURITY"`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ruleIdentifier(tc.name); got != tc.want {
+				t.Errorf("ruleIdentifier(%q): want %s, got %s", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	// AnnotationKeyRefresh requests a one-shot ALTER PIPE ... REFRESH, which
+	// queues the staged files the pipe has not loaded yet, for example after
+	// a backfill. A refresh is run whenever the value of the annotation, such
+	// as a timestamp, differs from the one the latest refresh was run for.
+	AnnotationKeyRefresh = "snowflake.com/refresh"
+
+	// AnnotationKeyRefreshPrefix limits a refresh to the staged files whose
+	// path starts with the value of the annotation.
+	AnnotationKeyRefreshPrefix = "snowflake.com/refresh-prefix"
+
+	// AnnotationKeyRefreshModifiedAfter limits a refresh to the staged files
+	// modified after the RFC 3339 timestamp the annotation is set to.
+	// Snowflake only accepts timestamps of the last 7 days.
+	AnnotationKeyRefreshModifiedAfter = "snowflake.com/refresh-modified-after"
+
+	// ExecutionStateRunning and ExecutionStatePaused are the values of
+	// spec.forProvider.executionState.
+	ExecutionStateRunning = "RUNNING"
+	ExecutionStatePaused  = "PAUSED"
+
+	// Spec and observation fields that are added to the Terraform schema of
+	// snowflake_pipe in the config package. The spec field is left out of the
+	// Terraform configuration.
+	fieldExecutionState         = "spec.forProvider.executionState"
+	fieldObservedExecutionState = "status.atProvider.executionState"
+	fieldLastRefreshRequest     = "status.atProvider.lastRefreshRequest"
+	fieldLastRefreshTime        = "status.atProvider.lastRefreshTime"
+	fieldLastRefreshFiles       = "status.atProvider.lastRefreshFiles"
+	fieldLastRefreshError       = "status.atProvider.lastRefreshError"
+
+	errPaveObject              = "cannot pave object"
+	errConnect                 = "cannot connect to Snowflake to operate the pipe"
+	errSetExecutionState       = "cannot set pipe execution state"
+	errUpdateStatus            = "cannot update managed resource status"
+	errUnknownExecutionStateFn = "unknown execution state %q, must be RUNNING or PAUSED"
+	errParseModifiedAfterFn    = "cannot parse %s annotation %q as an RFC 3339 timestamp"
+
+	statementSetExecutionPausedFmt = "ALTER PIPE %s SET PIPE_EXECUTION_PAUSED = %t"
+	statementRefreshFmt            = "ALTER PIPE %s REFRESH"
+
+	// refreshStatusSent is the status of the files a refresh queued for
+	// loading.
+	refreshStatusSent = "SENT"
+)
+
+// Operator is a managed.Initializer that runs the operations Terraform does
+// not offer for pipes. It pauses or resumes a pipe to match its
+// spec.forProvider.executionState, and runs the refreshes requested with
+// AnnotationKeyRefresh, recording their outcome in the status. The fields it
+// sets are not part of the Terraform state and so survive the observation.
+type Operator struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+	now       func() time.Time
+}
+
+// NewOperator returns a new Operator. It satisfies the signature of upjet's
+// config.NewInitializerFn.
+func NewOperator(kube client.Client) managed.Initializer {
+	return &Operator{kube: kube, newClient: clients.NewSQLClient, now: time.Now}
+}
+
+// Initialize reconciles the execution state of the pipe and runs a requested
+// refresh, if the pipe exists.
+func (o *Operator) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || meta.GetExternalName(mg) == "" {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	fqn, ok := clients.SchemaObjectName(p)
+	if !ok {
+		return nil
+	}
+	desired, _ := p.GetString(fieldExecutionState)
+	desired = strings.ToUpper(desired)
+	if desired != "" && desired != ExecutionStateRunning && desired != ExecutionStatePaused {
+		return errors.Errorf(errUnknownExecutionStateFn, desired)
+	}
+	request := mg.GetAnnotations()[AnnotationKeyRefresh]
+	handled, _ := p.GetString(fieldLastRefreshRequest)
+	refresh := request != "" && request != handled
+	if desired == "" && !refresh {
+		return nil
+	}
+
+	c, err := o.newClient(ctx, o.kube, mg)
+	if err != nil {
+		return errors.Wrap(err, errConnect)
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	observation := map[string]any{}
+	if desired != "" {
+		state, err := o.executionState(ctx, c, desired, fqn)
+		if err != nil {
+			return err
+		}
+		observation[fieldObservedExecutionState] = state
+	}
+	if refresh {
+		for field, value := range o.refresh(ctx, c, mg.GetAnnotations(), fqn) {
+			observation[field] = value
+		}
+	}
+	if err := clients.SetObservation(mg, p, observation); err != nil {
+		return err
+	}
+	if !refresh {
+		return nil
+	}
+	// Persist the refresh right away. The reconciler may update the managed
+	// resource before it updates its status, which would reset the status
+	// to the stored one and run the refresh again.
+	return errors.Wrap(o.kube.Status().Update(ctx, mg), errUpdateStatus)
+}
+
+// executionState pauses or resumes the pipe with the supplied fully qualified
+// name if it is not in the desired state, and returns its state. A pipe that
+// Snowflake stopped, for example because its stage was dropped, or that an
+// administrator paused, is left alone.
+func (o *Operator) executionState(ctx context.Context, c clients.SQLClient, desired, fqn string) (string, error) {
+	status, err := GetStatus(ctx, c, fqn)
+	if err != nil {
+		return "", err
+	}
+
+	// Only a running pipe can be paused, and only a paused one resumed.
+	paused := desired == ExecutionStatePaused
+	from := ExecutionStatePaused
+	if paused {
+		from = ExecutionStateRunning
+	}
+	if status.ExecutionState != from {
+		return status.ExecutionState, nil
+	}
+	if err := c.Exec(ctx, fmt.Sprintf(statementSetExecutionPausedFmt, fqn, paused)); err != nil {
+		return "", errors.Wrap(err, errSetExecutionState)
+	}
+	return desired, nil
+}
+
+// refresh runs ALTER PIPE ... REFRESH for the pipe with the supplied fully
+// qualified name and returns the observation of its outcome. A failed refresh
+// is recorded rather than retried; it is retried when the refresh annotation
+// changes.
+func (o *Operator) refresh(ctx context.Context, c clients.SQLClient, annotations map[string]string, fqn string) map[string]any {
+	files := 0
+	stmt, err := refreshStatement(annotations, fqn)
+	if err == nil {
+		var rows []map[string]string
+		rows, err = c.Query(ctx, stmt)
+		for _, r := range rows {
+			if r["status"] == refreshStatusSent {
+				files++
+			}
+		}
+	}
+	message := ""
+	if err != nil {
+		message = err.Error()
+	}
+	return map[string]any{
+		fieldLastRefreshRequest: annotations[AnnotationKeyRefresh],
+		fieldLastRefreshTime:    o.now().UTC().Format(time.RFC3339),
+		fieldLastRefreshFiles:   int64(files),
+		fieldLastRefreshError:   message,
+	}
+}
+
+// refreshStatement returns the ALTER PIPE ... REFRESH statement requested by
+// the supplied annotations.
+func refreshStatement(annotations map[string]string, fqn string) (string, error) {
+	stmt := fmt.Sprintf(statementRefreshFmt, fqn)
+	if prefix := annotations[AnnotationKeyRefreshPrefix]; prefix != "" {
+		stmt += " PREFIX = " + clients.QuoteString(prefix)
+	}
+	if after := annotations[AnnotationKeyRefreshModifiedAfter]; after != "" {
+		t, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return "", errors.Errorf(errParseModifiedAfterFn, AnnotationKeyRefreshModifiedAfter, after)
+		}
+		stmt += " MODIFIED_AFTER = " + clients.QuoteString(t.Format(time.RFC3339))
+	}
+	return stmt, nil
+}
@@ -0,0 +1,224 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	sqlfake "github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+type pipeOption func(*v1alpha1.Pipe)
+
+// newPipe returns a Pipe that has been created.
+func newPipe(o ...pipeOption) *v1alpha1.Pipe {
+	db, schema, name := "ANALYTICS", "RAW", "EVENTS"
+	cr := &v1alpha1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{Name: "events"},
+		Spec: v1alpha1.PipeSpec{
+			ForProvider: v1alpha1.PipeParameters{Database: &db, Schema: &schema, Name: &name},
+		},
+	}
+	meta.SetExternalName(cr, `"ANALYTICS"|"RAW"|"EVENTS"`)
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+func withExecutionState(s string) pipeOption {
+	return func(cr *v1alpha1.Pipe) {
+		cr.Spec.ForProvider.ExecutionState = &s
+	}
+}
+
+func withAnnotations(a map[string]string) pipeOption {
+	return func(cr *v1alpha1.Pipe) {
+		meta.AddAnnotations(cr, a)
+	}
+}
+
+func withObservation(o v1alpha1.PipeObservation) pipeOption {
+	return func(cr *v1alpha1.Pipe) {
+		cr.Status.AtProvider = o
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestOperatorInitialize(t *testing.T) {
+	const fqn = `"ANALYTICS"."RAW"."EVENTS"`
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	statusQuery := fmt.Sprintf(queryPipeStatusFmt, `'"ANALYTICS"."RAW"."EVENTS"'`)
+	status := func(state string) map[string][]map[string]string {
+		return map[string][]map[string]string{statusQuery: {{"status": `{"executionState":"` + state + `","pendingFileCount":0}`}}}
+	}
+	refreshed := v1alpha1.PipeObservation{
+		LastRefreshRequest: ptr("2024-05-10"),
+		LastRefreshTime:    ptr("2024-05-10T12:00:00Z"),
+		LastRefreshFiles:   ptr(int64(2)),
+		LastRefreshError:   ptr(""),
+	}
+
+	type want struct {
+		err   error
+		execs []string
+		obs   v1alpha1.PipeObservation
+	}
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Pipe
+		rows   map[string][]map[string]string
+		err    error
+		want   want
+	}{
+		"NotCreated": {
+			reason: "A pipe without an external name should be left alone.",
+			cr: newPipe(withExecutionState("PAUSED"), func(cr *v1alpha1.Pipe) {
+				meta.SetExternalName(cr, "")
+			}),
+			rows: status("RUNNING"),
+		},
+		"Unmanaged": {
+			reason: "A pipe without an execution state or refresh request should not be queried.",
+			cr:     newPipe(),
+			err:    errors.New("boom"),
+		},
+		"UnknownExecutionState": {
+			reason: "An execution state other than RUNNING or PAUSED should return an error.",
+			cr:     newPipe(withExecutionState("STOPPED")),
+			want:   want{err: errors.Errorf(errUnknownExecutionStateFn, "STOPPED")},
+		},
+		"Pause": {
+			reason: "A running pipe should be paused if it should be paused.",
+			cr:     newPipe(withExecutionState("paused")),
+			rows:   status("RUNNING"),
+			want: want{
+				execs: []string{`ALTER PIPE "ANALYTICS"."RAW"."EVENTS" SET PIPE_EXECUTION_PAUSED = true`},
+				obs:   v1alpha1.PipeObservation{ExecutionState: ptr("PAUSED")},
+			},
+		},
+		"Resume": {
+			reason: "A paused pipe should be resumed if it should be running.",
+			cr:     newPipe(withExecutionState("RUNNING")),
+			rows:   status("PAUSED"),
+			want: want{
+				execs: []string{`ALTER PIPE "ANALYTICS"."RAW"."EVENTS" SET PIPE_EXECUTION_PAUSED = false`},
+				obs:   v1alpha1.PipeObservation{ExecutionState: ptr("RUNNING")},
+			},
+		},
+		"InDesiredState": {
+			reason: "A pipe in the desired state should not be altered.",
+			cr:     newPipe(withExecutionState("RUNNING")),
+			rows:   status("RUNNING"),
+			want:   want{obs: v1alpha1.PipeObservation{ExecutionState: ptr("RUNNING")}},
+		},
+		"Stopped": {
+			reason: "A pipe stopped by Snowflake should not be resumed.",
+			cr:     newPipe(withExecutionState("RUNNING")),
+			rows:   status("STOPPED_STAGE_DROPPED"),
+			want:   want{obs: v1alpha1.PipeObservation{ExecutionState: ptr("STOPPED_STAGE_DROPPED")}},
+		},
+		"StatusFailed": {
+			reason: "Failing to get the pipe status should return an error.",
+			cr:     newPipe(withExecutionState("RUNNING")),
+			err:    errors.New("boom"),
+			want:   want{err: errors.Wrap(errors.New("boom"), errPipeStatus)},
+		},
+		"Refresh": {
+			reason: "A new refresh request should refresh the pipe and record the files it queued.",
+			cr: newPipe(withAnnotations(map[string]string{
+				AnnotationKeyRefresh:              "2024-05-10",
+				AnnotationKeyRefreshPrefix:        "2024/05/",
+				AnnotationKeyRefreshModifiedAfter: "2024-05-09T00:00:00+02:00",
+			})),
+			rows: map[string][]map[string]string{
+				"ALTER PIPE " + fqn + ` REFRESH PREFIX = '2024/05/' MODIFIED_AFTER = '2024-05-09T00:00:00+02:00'`: {
+					{"file": "2024/05/a.json", "status": "SENT"},
+					{"file": "2024/05/b.json", "status": "SENT"},
+				},
+			},
+			want: want{obs: refreshed},
+		},
+		"Refreshed": {
+			reason: "A refresh request that was handled should not refresh the pipe again.",
+			cr: newPipe(
+				withAnnotations(map[string]string{AnnotationKeyRefresh: "2024-05-10"}),
+				withObservation(refreshed),
+			),
+			err:  errors.New("boom"),
+			want: want{obs: refreshed},
+		},
+		"RefreshFailed": {
+			reason: "A failed refresh should be recorded rather than returned.",
+			cr:     newPipe(withAnnotations(map[string]string{AnnotationKeyRefresh: "again"}), withObservation(refreshed)),
+			err:    errors.New("boom"),
+			want: want{obs: v1alpha1.PipeObservation{
+				LastRefreshRequest: ptr("again"),
+				LastRefreshTime:    ptr("2024-05-10T12:00:00Z"),
+				LastRefreshFiles:   ptr(int64(0)),
+				LastRefreshError:   ptr("boom"),
+			}},
+		},
+		"MalformedModifiedAfter": {
+			reason: "A refresh with an unparseable modified-after timestamp should be recorded as failed.",
+			cr: newPipe(withAnnotations(map[string]string{
+				AnnotationKeyRefresh:              "1",
+				AnnotationKeyRefreshModifiedAfter: "yesterday",
+			})),
+			want: want{obs: v1alpha1.PipeObservation{
+				LastRefreshRequest: ptr("1"),
+				LastRefreshTime:    ptr("2024-05-10T12:00:00Z"),
+				LastRefreshFiles:   ptr(int64(0)),
+				LastRefreshError:   ptr(fmt.Sprintf(errParseModifiedAfterFn, AnnotationKeyRefreshModifiedAfter, "yesterday")),
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.cr).WithStatusSubresource(tc.cr).Build()
+			sql := &sqlfake.SQLClient{Rows: tc.rows, Err: tc.err}
+			o := &Operator{
+				kube:      kube,
+				newClient: sqlfake.NewSQLClientFn(sql),
+				now:       func() time.Time { return now },
+			}
+
+			err := o.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.execs, sql.Execs); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want statements, +got statements:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+			if tc.want.obs.LastRefreshRequest == nil {
+				return
+			}
+			stored := &v1alpha1.Pipe{}
+			if err := kube.Get(context.Background(), client.ObjectKeyFromObject(tc.cr), stored); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.obs, stored.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want stored observation, +got stored observation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errShowStages = "cannot show stages"
+	errNoStageFn  = "stage %s does not exist"
+
+	queryShowStagesFmt = "SHOW STAGES LIKE %s IN SCHEMA %s"
+)
+
+// StageOf returns the fully qualified name of the stage the supplied COPY
+// statement of a pipe loads files from, e.g. ["ANALYTICS", "RAW", "LANDING"]
+// for @landing/events/ in a pipe of ANALYTICS.RAW. Stage names that are not
+// fully qualified are resolved against the database and schema of the pipe.
+// It returns false for statements that load from the stage of a user or
+// table, which have no URL, or that name no stage.
+func StageOf(copyStatement, database, schema string) ([]string, bool) {
+	name := ""
+	for i := 0; i < len(copyStatement); i++ {
+		switch c := copyStatement[i]; c {
+		case '\'', '"':
+			end := strings.IndexByte(copyStatement[i+1:], c)
+			if end < 0 {
+				return nil, false
+			}
+			i += end + 1
+		case '@':
+			name = stageName(copyStatement[i+1:])
+			i = len(copyStatement)
+		}
+	}
+	if name == "" || name[0] == '~' || name[0] == '%' {
+		return nil, false
+	}
+	parts := clients.SplitIdentifier(name)
+	switch len(parts) {
+	case 1:
+		return []string{database, schema, parts[0]}, true
+	case 2:
+		return []string{database, parts[0], parts[1]}, true
+	case 3:
+		return parts, true
+	}
+	return nil, false
+}
+
+// stageName returns the name of the stage that s, the text following an @,
+// starts with, without the path that may follow it.
+func stageName(s string) string {
+	inQuotes := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && (c == '/' || c == ')' || c == ',' || c == ';' || strings.IndexByte(" \t\r\n", c) >= 0):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// GetStageURL returns the URL of the stage with the supplied database, schema
+// and name, which is empty for internal stages.
+func GetStageURL(ctx context.Context, c clients.SQLClient, database, schema, name string) (string, error) {
+	rows, err := c.Query(ctx, fmt.Sprintf(queryShowStagesFmt, clients.QuoteString(name), clients.FullyQualifiedName(database, schema)))
+	if err != nil {
+		return "", errors.Wrap(err, errShowStages)
+	}
+	// LIKE is case-insensitive and treats _ as a wildcard.
+	for _, r := range rows {
+		if r["name"] == name {
+			return r["url"], nil
+		}
+	}
+	return "", errors.Errorf(errNoStageFn, clients.FullyQualifiedName(database, schema, name))
+}
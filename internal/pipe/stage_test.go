@@ -0,0 +1,101 @@
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func TestStageOf(t *testing.T) {
+	type want struct {
+		stage []string
+		ok    bool
+	}
+	cases := map[string]struct {
+		reason        string
+		copyStatement string
+		want          want
+	}{
+		"Unqualified": {
+			reason:        "A stage name should be resolved against the database and schema of the pipe, and its path dropped.",
+			copyStatement: "COPY INTO events FROM @landing/events/ FILE_FORMAT = (TYPE = JSON)",
+			want:          want{stage: []string{"ANALYTICS", "RAW", "LANDING"}, ok: true},
+		},
+		"SchemaQualified": {
+			reason:        "A stage name with a schema should be resolved against the database of the pipe.",
+			copyStatement: "COPY INTO events FROM @staging.landing",
+			want:          want{stage: []string{"ANALYTICS", "STAGING", "LANDING"}, ok: true},
+		},
+		"Quoted": {
+			reason:        "Quoted parts of a stage name should keep their case, and may contain dots and spaces.",
+			copyStatement: `COPY INTO "Events" FROM @SHARED."Landing zone"."s3.events" FILE_FORMAT = (TYPE = JSON)`,
+			want:          want{stage: []string{"SHARED", "Landing zone", "s3.events"}, ok: true},
+		},
+		"Transformation": {
+			reason:        "The stage of a COPY statement that transforms the data should be found, but not an @ in a string.",
+			copyStatement: "COPY INTO events (id, email) FROM (SELECT $1:id, '@' || $1:email FROM @landing) FILE_FORMAT = (TYPE = JSON)",
+			want:          want{stage: []string{"ANALYTICS", "RAW", "LANDING"}, ok: true},
+		},
+		"TableStage": {
+			reason:        "The stage of a table has no URL.",
+			copyStatement: "COPY INTO events FROM @%events",
+		},
+		"UserStage": {
+			reason:        "The stage of a user has no URL.",
+			copyStatement: "COPY INTO events FROM @~/events/",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stage, ok := StageOf(tc.copyStatement, "ANALYTICS", "RAW")
+			if diff := cmp.Diff(tc.want, want{stage: stage, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nStageOf(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetStageURL(t *testing.T) {
+	query := `SHOW STAGES LIKE 'LANDING' IN SCHEMA "ANALYTICS"."RAW"`
+	type want struct {
+		url string
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		rows   []map[string]string
+		want   want
+	}{
+		"External": {
+			reason: "The URL of the stage with the exact name should be returned.",
+			rows:   []map[string]string{{"name": "LANDING_OLD", "url": "s3://old/"}, {"name": "LANDING", "url": "s3://landing/"}},
+			want:   want{url: "s3://landing/"},
+		},
+		"Internal": {
+			reason: "An internal stage has no URL.",
+			rows:   []map[string]string{{"name": "LANDING", "url": ""}},
+		},
+		"NotFound": {
+			reason: "A stage that does not exist should return an error.",
+			rows:   []map[string]string{{"name": "LANDING_OLD", "url": "s3://old/"}},
+			want:   want{err: errors.Errorf(errNoStageFn, `"ANALYTICS"."RAW"."LANDING"`)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.SQLClient{Rows: map[string][]map[string]string{query: tc.rows}}
+			url, err := GetStageURL(context.Background(), c, "ANALYTICS", "RAW", "LANDING")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetStageURL(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.url, url); diff != "" {
+				t.Errorf("\n%s\nGetStageURL(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package pipe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errPipeStatus      = "cannot get pipe status"
+	errParsePipeStatus = "cannot parse pipe status"
+
+	queryPipeStatusFmt = "SELECT SYSTEM$PIPE_STATUS(%s) AS status"
+)
+
+// Status is the part of the status of a pipe reported by SYSTEM$PIPE_STATUS
+// that the provider records.
+type Status struct {
+	// ExecutionState is RUNNING, PAUSED, or the reason Snowflake stopped or
+	// stalled the pipe, such as STOPPED_STAGE_DROPPED.
+	ExecutionState string `json:"executionState"`
+
+	// PendingFileCount is the number of files queued for loading.
+	PendingFileCount int64 `json:"pendingFileCount"`
+
+	// LastIngestedTimestamp is when the most recently loaded file was loaded.
+	LastIngestedTimestamp string `json:"lastIngestedTimestamp"`
+
+	// Error is the error of the latest attempt to compile the pipe, for
+	// example because its table or stage was dropped or cannot be accessed.
+	Error string `json:"error"`
+
+	// Fault is the latest internal Snowflake error of the pipe.
+	Fault string `json:"fault"`
+}
+
+// GetStatus returns the status of the pipe with the supplied fully qualified
+// name.
+func GetStatus(ctx context.Context, c clients.SQLClient, fqn string) (Status, error) {
+	s := Status{}
+	rows, err := c.Query(ctx, fmt.Sprintf(queryPipeStatusFmt, clients.QuoteString(fqn)))
+	if err != nil {
+		return s, errors.Wrap(err, errPipeStatus)
+	}
+	if len(rows) == 0 {
+		return s, errors.New(errPipeStatus)
+	}
+	return s, errors.Wrap(json.Unmarshal([]byte(rows[0]["status"]), &s), errParsePipeStatus)
+}
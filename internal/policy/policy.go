@@ -0,0 +1,70 @@
+// Package policy derives the identifiers of the attachments of password and
+// authentication policies to the account and to users, which the Terraform
+// provider builds from what they attach.
+package policy
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errNotSetFn    = "cannot derive identifier: %s is not set"
+	errNotValidFn  = "cannot derive identifier: %s is not a valid %s: %q"
+	partsUser      = 1
+	partsPolicy    = 3
+	kindUserName   = "user name"
+	kindPolicyName = "fully qualified policy name"
+)
+
+// AccountAttachmentIdentifier returns a function that derives the identifier
+// of an attachment of the policy named by the supplied field to the account,
+// which is the database, schema and name of the policy separated by |, e.g.
+// SECURITY|POLICIES|STRONG_PASSWORDS. The parts are kept as written, as the
+// Terraform provider treats every name as quoted.
+func AccountAttachmentIdentifier(policyField string) func(parameters map[string]any) (string, error) {
+	return func(parameters map[string]any) (string, error) {
+		policy, err := identifier(parameters, policyField, partsPolicy, kindPolicyName)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(policy, "|"), nil
+	}
+}
+
+// UserAttachmentIdentifier returns a function that derives the identifier of
+// an attachment of the policy named by the supplied field to a user, which is
+// the quoted name of the user and the quoted, fully qualified name of the
+// policy separated by |, e.g. "JANE"|"SECURITY"."POLICIES"."STRONG_PASSWORDS".
+// The parts are kept as written, as for AccountAttachmentIdentifier.
+func UserAttachmentIdentifier(policyField string) func(parameters map[string]any) (string, error) {
+	return func(parameters map[string]any) (string, error) {
+		user, err := identifier(parameters, "user_name", partsUser, kindUserName)
+		if err != nil {
+			return "", err
+		}
+		policy, err := identifier(parameters, policyField, partsPolicy, kindPolicyName)
+		if err != nil {
+			return "", err
+		}
+		return clients.FullyQualifiedName(user...) + "|" + clients.FullyQualifiedName(policy...), nil
+	}
+}
+
+// identifier returns the parts of the identifier in the supplied field, as
+// the Terraform provider parses them, which must have the supplied number of
+// parts.
+func identifier(parameters map[string]any, field string, parts int, kind string) ([]string, error) {
+	name, _ := parameters[field].(string)
+	if name == "" {
+		return nil, errors.Errorf(errNotSetFn, field)
+	}
+	p := clients.ParseIdentifier(name)
+	if len(p) != parts {
+		return nil, errors.Errorf(errNotValidFn, field, kind, name)
+	}
+	return p, nil
+}
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/allenkallz/provider-snowflake/config/common"
+)
+
+func TestAttachmentIdentifier(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+	cases := map[string]struct {
+		reason     string
+		id         func(map[string]any) (string, error)
+		parameters map[string]any
+		want       want
+	}{
+		"Account": {
+			reason:     "The identifier of an account attachment should be the parts of the policy name separated by |.",
+			id:         AccountAttachmentIdentifier("password_policy"),
+			parameters: map[string]any{"password_policy": `"SECURITY"."POLICIES"."STRONG_PASSWORDS"`},
+			want:       want{id: "SECURITY|POLICIES|STRONG_PASSWORDS"},
+		},
+		"AccountLowerCase": {
+			reason:     "Unquoted policy names should be kept as written, as the Terraform provider treats them as quoted.",
+			id:         AccountAttachmentIdentifier("authentication_policy"),
+			parameters: map[string]any{"authentication_policy": "security.policies.require_mfa"},
+			want:       want{id: "security|policies|require_mfa"},
+		},
+		"AccountNotQualified": {
+			reason:     "A policy name that is not fully qualified should return an error.",
+			id:         AccountAttachmentIdentifier("password_policy"),
+			parameters: map[string]any{"password_policy": "STRONG_PASSWORDS"},
+			want:       want{err: errors.Errorf(errNotValidFn, "password_policy", kindPolicyName, "STRONG_PASSWORDS")},
+		},
+		"User": {
+			reason:     "The identifier of a user attachment should be the quoted user and policy names separated by |, kept as written.",
+			id:         UserAttachmentIdentifier("password_policy_name"),
+			parameters: map[string]any{"user_name": "analyst_jane", "password_policy_name": `SECURITY.POLICIES."Strong"`},
+			want:       want{id: `"analyst_jane"|"SECURITY"."POLICIES"."Strong"`},
+		},
+		"UserQuoted": {
+			reason:     "Quoted user names should be kept as they are.",
+			id:         UserAttachmentIdentifier("authentication_policy_name"),
+			parameters: map[string]any{"user_name": `"Jane"`, "authentication_policy_name": `"SECURITY"."POLICIES"."REQUIRE_MFA"`},
+			want:       want{id: `"Jane"|"SECURITY"."POLICIES"."REQUIRE_MFA"`},
+		},
+		"UserLowerCase": {
+			reason:     "Lower-case user and policy names should be kept as written.",
+			id:         UserAttachmentIdentifier("authentication_policy_name"),
+			parameters: map[string]any{"user_name": "jane", "authentication_policy_name": "security.policies.require_mfa"},
+			want:       want{id: `"jane"|"security"."policies"."require_mfa"`},
+		},
+		"NoUser": {
+			reason:     "An identifier cannot be derived without a user.",
+			id:         UserAttachmentIdentifier("password_policy_name"),
+			parameters: map[string]any{"password_policy_name": `"SECURITY"."POLICIES"."STRONG_PASSWORDS"`},
+			want:       want{err: errors.Errorf(errNotSetFn, "user_name")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := common.IdentifierFromParameters(config.IdentifierFromProvider, tc.id)
+			id, err := e.GetIDFn(context.Background(), "", tc.parameters, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			// Terraform stores the arguments as they were configured, next
+			// to the identifier it was given.
+			tfstate := map[string]any{"id": id}
+			for k, v := range tc.parameters {
+				tfstate[k] = v
+			}
+			externalName, err := e.GetExternalNameFn(tfstate)
+			if err != nil {
+				t.Fatalf("\n%s\nGetExternalNameFn(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(id, externalName); diff != "" {
+				t.Errorf("\n%s\nGetExternalNameFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			got, err := e.GetIDFn(context.Background(), externalName, tc.parameters, nil)
+			if err != nil {
+				t.Fatalf("\n%s\nGetIDFn(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(id, got); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...) with the external name: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
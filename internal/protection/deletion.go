@@ -0,0 +1,96 @@
+package protection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyDeletionProtection is the annotation that guards a managed
+	// resource against deletion. Deletes are refused while it is set to
+	// "true"; set it to "false" or remove it to lift the protection.
+	AnnotationKeyDeletionProtection = "snowflake.com/deletion-protection"
+
+	// TypeDeletionProtection is the condition type that reports whether a
+	// managed resource is currently protected against deletion.
+	TypeDeletionProtection xpv1.ConditionType = "DeletionProtection"
+
+	// ReasonProtected means the deletion protection annotation is set.
+	ReasonProtected xpv1.ConditionReason = "Protected"
+	// ReasonDeletionBlocked means a delete was requested but refused.
+	ReasonDeletionBlocked xpv1.ConditionReason = "DeletionBlocked"
+	// ReasonUnprotected means the deletion protection has been lifted.
+	ReasonUnprotected xpv1.ConditionReason = "Unprotected"
+
+	errDeletionProtectedFmt = "%q is protected against deletion: set the %q annotation to \"false\" to allow deleting it from Snowflake"
+)
+
+// DeletionProtected returns true if the supplied object carries the
+// deletion protection annotation.
+func DeletionProtected(o metav1.Object) bool {
+	return strings.EqualFold(o.GetAnnotations()[AnnotationKeyDeletionProtection], "true")
+}
+
+// ValidateDelete returns an error if deleting the supplied managed resource
+// would drop a protected object in Snowflake. Resources with the Orphan
+// deletion policy are always allowed to go since they leave the external
+// object in place.
+func ValidateDelete(mg resource.Managed) error {
+	if !DeletionProtected(mg) || mg.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		return nil
+	}
+	return errors.Errorf(errDeletionProtectedFmt, mg.GetName(), AnnotationKeyDeletionProtection)
+}
+
+// DeletionProtector is a managed.Initializer that refuses to let the managed
+// reconciler proceed with the deletion of a protected resource. Initializers
+// run before the external client is asked to delete, so returning an error
+// here keeps the Snowflake object in place until the protection is lifted.
+type DeletionProtector struct{}
+
+// NewDeletionProtector returns a new DeletionProtector. It satisfies the
+// signature of upjet's config.NewInitializerFn.
+func NewDeletionProtector(_ client.Client) managed.Initializer {
+	return &DeletionProtector{}
+}
+
+// Initialize reports the protection state as a condition and refuses
+// deletion of protected resources.
+func (d *DeletionProtector) Initialize(_ context.Context, mg resource.Managed) error {
+	protected := DeletionProtected(mg)
+	switch {
+	case meta.WasDeleted(mg) && protected:
+		err := ValidateDelete(mg)
+		if err == nil {
+			return nil
+		}
+		mg.SetConditions(deletionProtection(corev1.ConditionTrue, ReasonDeletionBlocked, err.Error()))
+		return err
+	case protected:
+		mg.SetConditions(deletionProtection(corev1.ConditionTrue, ReasonProtected,
+			fmt.Sprintf("Deletes are refused while the %q annotation is \"true\"", AnnotationKeyDeletionProtection)))
+	case mg.GetCondition(TypeDeletionProtection).Status == corev1.ConditionTrue:
+		mg.SetConditions(deletionProtection(corev1.ConditionFalse, ReasonUnprotected, ""))
+	}
+	return nil
+}
+
+func deletionProtection(s corev1.ConditionStatus, r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionProtection,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
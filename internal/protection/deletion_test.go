@@ -0,0 +1,93 @@
+package protection
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newManaged(annotation string, deleted bool, policy xpv1.DeletionPolicy, c ...xpv1.Condition) *fake.Managed {
+	mg := &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{Name: "analytics"},
+		Orphanable: fake.Orphanable{Policy: policy},
+	}
+	if annotation != "" {
+		mg.SetAnnotations(map[string]string{AnnotationKeyDeletionProtection: annotation})
+	}
+	if deleted {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+	mg.SetConditions(c...)
+	return mg
+}
+
+func TestDeletionProtectorInitialize(t *testing.T) {
+	blocked := errors.Errorf(errDeletionProtectedFmt, "analytics", AnnotationKeyDeletionProtection)
+
+	type want struct {
+		err       error
+		status    corev1.ConditionStatus
+		reason    xpv1.ConditionReason
+		condition bool
+	}
+	cases := map[string]struct {
+		reason string
+		mg     *fake.Managed
+		want   want
+	}{
+		"Unprotected": {
+			reason: "A resource without the annotation should not get a condition.",
+			mg:     newManaged("", false, xpv1.DeletionDelete),
+		},
+		"Protected": {
+			reason: "A protected resource should report that it is protected.",
+			mg:     newManaged("true", false, xpv1.DeletionDelete),
+			want:   want{status: corev1.ConditionTrue, reason: ReasonProtected, condition: true},
+		},
+		"ProtectedCaseInsensitive": {
+			reason: "The annotation value should be compared case-insensitively.",
+			mg:     newManaged("True", false, xpv1.DeletionDelete),
+			want:   want{status: corev1.ConditionTrue, reason: ReasonProtected, condition: true},
+		},
+		"DeletionBlocked": {
+			reason: "Deleting a protected resource should be refused.",
+			mg:     newManaged("true", true, xpv1.DeletionDelete),
+			want:   want{err: blocked, status: corev1.ConditionTrue, reason: ReasonDeletionBlocked, condition: true},
+		},
+		"DeletionOrphaned": {
+			reason: "Deleting a protected resource with the Orphan policy leaves the object in Snowflake and should be allowed.",
+			mg:     newManaged("true", true, xpv1.DeletionOrphan),
+		},
+		"ProtectionLifted": {
+			reason: "Removing the annotation from a protected resource should report that it is unprotected.",
+			mg:     newManaged("false", false, xpv1.DeletionDelete, deletionProtection(corev1.ConditionTrue, ReasonProtected, "")),
+			want:   want{status: corev1.ConditionFalse, reason: ReasonUnprotected, condition: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewDeletionProtector(nil).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			c := tc.mg.GetCondition(TypeDeletionProtection)
+			if !tc.want.condition {
+				if c.Status != corev1.ConditionUnknown {
+					t.Errorf("\n%s\nInitialize(...): want no condition, got %+v", tc.reason, c)
+				}
+				return
+			}
+			if c.Status != tc.want.status || c.Reason != tc.want.reason {
+				t.Errorf("\n%s\nInitialize(...): want condition %s/%s, got %s/%s", tc.reason, tc.want.status, tc.want.reason, c.Status, c.Reason)
+			}
+		})
+	}
+}
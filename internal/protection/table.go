@@ -0,0 +1,233 @@
+package protection
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyAllowDestructiveChanges is the annotation that allows
+	// changes to a Table that lose data, such as dropping a column or
+	// narrowing its type. They are refused unless it is set to "true".
+	AnnotationKeyAllowDestructiveChanges = "snowflake.com/allow-destructive-changes"
+
+	fieldDesiredColumns  = "spec.forProvider.column"
+	fieldObservedColumns = "status.atProvider.column"
+
+	errPaveObject          = "cannot pave object"
+	errGetColumnsFmt       = "cannot get columns from %s"
+	errDestructiveChangeFn = "refusing destructive changes to %q: %s; set the %q annotation to \"true\" to allow them"
+)
+
+// AllowDestructiveChanges returns true if the supplied object carries the
+// annotation that allows destructive changes.
+func AllowDestructiveChanges(o metav1.Object) bool {
+	return strings.EqualFold(o.GetAnnotations()[AnnotationKeyAllowDestructiveChanges], "true")
+}
+
+// A Column of a table, as far as the guard is concerned.
+type Column struct {
+	Name string
+	Type string
+}
+
+// DestructiveColumnChanges returns a description of each change from the
+// current to the desired columns that would lose data: dropping a column,
+// changing its type, or narrowing its length or precision. Widening a VARCHAR
+// or the precision of a NUMBER is not destructive.
+func DestructiveColumnChanges(current, desired []Column) []string {
+	var changes []string
+	for _, c := range current {
+		d, ok := findColumn(desired, c.Name)
+		if !ok {
+			changes = append(changes, fmt.Sprintf("drops column %s", c.Name))
+			continue
+		}
+		if narrows(parseType(c.Type), parseType(d.Type)) {
+			changes = append(changes, fmt.Sprintf("changes column %s from %s to %s", c.Name, c.Type, d.Type))
+		}
+	}
+	return changes
+}
+
+// ValidateTableUpdate returns an error if updating a table from the old to
+// the updated managed resource would make destructive column changes, and
+// the updated resource does not allow them.
+func ValidateTableUpdate(old, updated client.Object) error {
+	if AllowDestructiveChanges(updated) {
+		return nil
+	}
+	current, err := columns(old, fieldDesiredColumns)
+	if err != nil {
+		return err
+	}
+	desired, err := columns(updated, fieldDesiredColumns)
+	if err != nil {
+		return err
+	}
+	return destructiveChangeError(updated, DestructiveColumnChanges(current, desired))
+}
+
+// TableGuard is a managed.Initializer that stops the managed reconciler from
+// applying destructive column changes to an existing table. It compares the
+// desired columns against the ones last observed in Snowflake, so it also
+// catches changes that were admitted while the webhooks were not running.
+type TableGuard struct{}
+
+// NewTableGuard returns a new TableGuard. It satisfies the signature of
+// upjet's config.NewInitializerFn.
+func NewTableGuard(_ client.Client) managed.Initializer {
+	return &TableGuard{}
+}
+
+// Initialize refuses to proceed if the table has been observed and its
+// desired columns would destroy data.
+func (g *TableGuard) Initialize(_ context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || AllowDestructiveChanges(mg) {
+		return nil
+	}
+	current, err := columns(mg, fieldObservedColumns)
+	if err != nil || len(current) == 0 {
+		return err
+	}
+	desired, err := columns(mg, fieldDesiredColumns)
+	if err != nil {
+		return err
+	}
+	return destructiveChangeError(mg, DestructiveColumnChanges(current, desired))
+}
+
+func destructiveChangeError(o metav1.Object, changes []string) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return errors.Errorf(errDestructiveChangeFn, o.GetName(), strings.Join(changes, ", "), AnnotationKeyAllowDestructiveChanges)
+}
+
+func columns(o runtime.Object, path string) ([]Column, error) {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil, errors.Wrap(err, errPaveObject)
+	}
+	var raw []struct {
+		Name *string `json:"name"`
+		Type *string `json:"type"`
+	}
+	if err := p.GetValueInto(path, &raw); err != nil {
+		if fieldpath.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, errGetColumnsFmt, path)
+	}
+	cols := make([]Column, 0, len(raw))
+	for _, c := range raw {
+		if c.Name == nil {
+			continue
+		}
+		col := Column{Name: *c.Name}
+		if c.Type != nil {
+			col.Type = *c.Type
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+func findColumn(cols []Column, name string) (Column, bool) {
+	for _, c := range cols {
+		if strings.EqualFold(c.Name, name) {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+// dataType is a Snowflake data type reduced to its family and size. Size is
+// the length of text and binary types and the precision of numbers and
+// times; scale is only used by numbers.
+type dataType struct {
+	family string
+	size   int
+	scale  int
+}
+
+var typeRegexp = regexp.MustCompile(`^([A-Z][A-Z0-9_ ]*?)\s*(?:\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\))?$`)
+
+// typeFamilies maps synonyms to the type Snowflake stores them as, with the
+// default size it uses when none is given.
+var typeFamilies = map[string]dataType{
+	"NUMBER":                         {family: "NUMBER", size: 38},
+	"DECIMAL":                        {family: "NUMBER", size: 38},
+	"DEC":                            {family: "NUMBER", size: 38},
+	"NUMERIC":                        {family: "NUMBER", size: 38},
+	"INT":                            {family: "NUMBER", size: 38},
+	"INTEGER":                        {family: "NUMBER", size: 38},
+	"BIGINT":                         {family: "NUMBER", size: 38},
+	"SMALLINT":                       {family: "NUMBER", size: 38},
+	"TINYINT":                        {family: "NUMBER", size: 38},
+	"BYTEINT":                        {family: "NUMBER", size: 38},
+	"FLOAT":                          {family: "FLOAT"},
+	"FLOAT4":                         {family: "FLOAT"},
+	"FLOAT8":                         {family: "FLOAT"},
+	"DOUBLE":                         {family: "FLOAT"},
+	"DOUBLE PRECISION":               {family: "FLOAT"},
+	"REAL":                           {family: "FLOAT"},
+	"VARCHAR":                        {family: "VARCHAR", size: 16777216},
+	"STRING":                         {family: "VARCHAR", size: 16777216},
+	"TEXT":                           {family: "VARCHAR", size: 16777216},
+	"NVARCHAR":                       {family: "VARCHAR", size: 16777216},
+	"NVARCHAR2":                      {family: "VARCHAR", size: 16777216},
+	"CHAR VARYING":                   {family: "VARCHAR", size: 16777216},
+	"NCHAR VARYING":                  {family: "VARCHAR", size: 16777216},
+	"CHAR":                           {family: "VARCHAR", size: 1},
+	"CHARACTER":                      {family: "VARCHAR", size: 1},
+	"NCHAR":                          {family: "VARCHAR", size: 1},
+	"BINARY":                         {family: "BINARY", size: 8388608},
+	"VARBINARY":                      {family: "BINARY", size: 8388608},
+	"TIME":                           {family: "TIME", size: 9},
+	"DATETIME":                       {family: "TIMESTAMP_NTZ", size: 9},
+	"TIMESTAMP":                      {family: "TIMESTAMP_NTZ", size: 9},
+	"TIMESTAMP_NTZ":                  {family: "TIMESTAMP_NTZ", size: 9},
+	"TIMESTAMP WITHOUT TIME ZONE":    {family: "TIMESTAMP_NTZ", size: 9},
+	"TIMESTAMP_LTZ":                  {family: "TIMESTAMP_LTZ", size: 9},
+	"TIMESTAMP WITH LOCAL TIME ZONE": {family: "TIMESTAMP_LTZ", size: 9},
+	"TIMESTAMP_TZ":                   {family: "TIMESTAMP_TZ", size: 9},
+	"TIMESTAMP WITH TIME ZONE":       {family: "TIMESTAMP_TZ", size: 9},
+}
+
+func parseType(t string) dataType {
+	t = strings.ToUpper(strings.TrimSpace(t))
+	m := typeRegexp.FindStringSubmatch(t)
+	if m == nil {
+		return dataType{family: t}
+	}
+	dt, ok := typeFamilies[m[1]]
+	if !ok {
+		return dataType{family: m[1]}
+	}
+	if m[2] != "" {
+		dt.size, _ = strconv.Atoi(m[2])
+	}
+	if m[3] != "" {
+		dt.scale, _ = strconv.Atoi(m[3])
+	}
+	return dt
+}
+
+// narrows returns true if changing a column from type a to type b cannot be
+// done in place without losing data.
+func narrows(a, b dataType) bool {
+	return a.family != b.family || b.size < a.size || a.scale != b.scale
+}
@@ -0,0 +1,172 @@
+package protection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type column struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+type tableColumns struct {
+	Column []column `json:"column,omitempty"`
+}
+
+// table is a managed resource with the column fields of a Table.
+type table struct {
+	fake.Managed
+	Spec struct {
+		ForProvider tableColumns `json:"forProvider"`
+	} `json:"spec"`
+	Status struct {
+		AtProvider tableColumns `json:"atProvider"`
+	} `json:"status"`
+}
+
+func cols(nameType ...string) []column {
+	c := make([]column, 0, len(nameType)/2)
+	for i := 0; i+1 < len(nameType); i += 2 {
+		c = append(c, column{Name: &nameType[i], Type: &nameType[i+1]})
+	}
+	return c
+}
+
+func newTable(annotations map[string]string, desired, observed []column) *table {
+	t := &table{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "events", Annotations: annotations}}}
+	t.Spec.ForProvider.Column = desired
+	t.Status.AtProvider.Column = observed
+	return t
+}
+
+func TestDestructiveColumnChanges(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		current []Column
+		desired []Column
+		want    []string
+	}{
+		"Unchanged": {
+			reason:  "Synonyms and implicit sizes of the same type are not changes.",
+			current: []Column{{Name: "ID", Type: "NUMBER(38,0)"}, {Name: "DATA", Type: "VARCHAR(16777216)"}, {Name: "TS", Type: "TIMESTAMP_NTZ(9)"}},
+			desired: []Column{{Name: "id", Type: "int"}, {Name: "data", Type: "text"}, {Name: "ts", Type: "timestamp"}},
+		},
+		"AddColumn": {
+			reason:  "Adding a column is not destructive.",
+			current: []Column{{Name: "ID", Type: "NUMBER(38,0)"}},
+			desired: []Column{{Name: "ID", Type: "NUMBER(38,0)"}, {Name: "DATA", Type: "VARIANT"}},
+		},
+		"Widen": {
+			reason:  "Widening a VARCHAR or the precision of a NUMBER is not destructive.",
+			current: []Column{{Name: "ID", Type: "NUMBER(10,2)"}, {Name: "DATA", Type: "VARCHAR(10)"}},
+			desired: []Column{{Name: "ID", Type: "NUMBER(20, 2)"}, {Name: "DATA", Type: "STRING"}},
+		},
+		"DropColumn": {
+			reason:  "Dropping a column is destructive.",
+			current: []Column{{Name: "ID", Type: "NUMBER(38,0)"}, {Name: "DATA", Type: "VARIANT"}},
+			desired: []Column{{Name: "ID", Type: "NUMBER(38,0)"}},
+			want:    []string{"drops column DATA"},
+		},
+		"Narrow": {
+			reason:  "Shrinking a VARCHAR, the precision or the scale of a NUMBER is destructive.",
+			current: []Column{{Name: "ID", Type: "NUMBER(38,0)"}, {Name: "AMOUNT", Type: "NUMBER(10,2)"}, {Name: "DATA", Type: "VARCHAR"}},
+			desired: []Column{{Name: "ID", Type: "NUMBER(10,0)"}, {Name: "AMOUNT", Type: "NUMBER(10,0)"}, {Name: "DATA", Type: "CHAR(10)"}},
+			want: []string{
+				"changes column ID from NUMBER(38,0) to NUMBER(10,0)",
+				"changes column AMOUNT from NUMBER(10,2) to NUMBER(10,0)",
+				"changes column DATA from VARCHAR to CHAR(10)",
+			},
+		},
+		"ChangeType": {
+			reason:  "Changing the type of a column is destructive.",
+			current: []Column{{Name: "DATA", Type: "VARIANT"}, {Name: "RATE", Type: "FLOAT8"}},
+			desired: []Column{{Name: "DATA", Type: "VARCHAR"}, {Name: "RATE", Type: "DOUBLE"}},
+			want:    []string{"changes column DATA from VARIANT to VARCHAR"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DestructiveColumnChanges(tc.current, tc.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDestructiveColumnChanges(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateTableUpdate(t *testing.T) {
+	allow := map[string]string{AnnotationKeyAllowDestructiveChanges: "true"}
+	cases := map[string]struct {
+		reason  string
+		old     *table
+		updated *table
+		want    error
+	}{
+		"Widen": {
+			reason:  "Non-destructive updates should be admitted.",
+			old:     newTable(nil, cols("ID", "NUMBER(10,0)"), nil),
+			updated: newTable(nil, cols("ID", "NUMBER(38,0)", "DATA", "VARIANT"), nil),
+		},
+		"DropColumn": {
+			reason:  "Dropping a column should be refused.",
+			old:     newTable(nil, cols("ID", "NUMBER(38,0)", "DATA", "VARIANT"), nil),
+			updated: newTable(nil, cols("ID", "NUMBER(38,0)"), nil),
+			want:    errors.Errorf(errDestructiveChangeFn, "events", "drops column DATA", AnnotationKeyAllowDestructiveChanges),
+		},
+		"Allowed": {
+			reason:  "Dropping a column should be admitted when the updated Table allows it.",
+			old:     newTable(nil, cols("ID", "NUMBER(38,0)", "DATA", "VARIANT"), nil),
+			updated: newTable(allow, cols("ID", "NUMBER(38,0)"), nil),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTableUpdate(tc.old, tc.updated)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateTableUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTableGuardInitialize(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *table
+		want   error
+	}{
+		"NotObserved": {
+			reason: "A table that has not been observed yet has nothing to guard.",
+			mg:     newTable(nil, cols("ID", "NUMBER(38,0)"), nil),
+		},
+		"InSync": {
+			reason: "A table whose spec matches the observed columns should proceed.",
+			mg:     newTable(nil, cols("id", "int"), cols("id", "NUMBER(38,0)")),
+		},
+		"Narrow": {
+			reason: "A table whose spec narrows an observed column should be refused.",
+			mg:     newTable(nil, cols("DATA", "VARCHAR(10)"), cols("DATA", "VARCHAR(100)")),
+			want: errors.Errorf(errDestructiveChangeFn, "events", "changes column DATA from VARCHAR(100) to VARCHAR(10)",
+				AnnotationKeyAllowDestructiveChanges),
+		},
+		"Allowed": {
+			reason: "A table that allows destructive changes should proceed.",
+			mg:     newTable(map[string]string{AnnotationKeyAllowDestructiveChanges: "true"}, nil, cols("DATA", "VARCHAR(100)")),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewTableGuard(nil).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
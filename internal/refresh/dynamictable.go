@@ -0,0 +1,86 @@
+package refresh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	// Observation fields that hold the outcome of the latest refresh. They
+	// are added to the Terraform schema of snowflake_dynamic_table in the
+	// config package.
+	fieldLastRefreshState        = "status.atProvider.lastRefreshState"
+	fieldLastRefreshStateMessage = "status.atProvider.lastRefreshStateMessage"
+	fieldLastRefreshEndTime      = "status.atProvider.lastRefreshEndTime"
+
+	errPaveObject = "cannot pave object"
+
+	// The refresh history also lists the next scheduled refresh, which has
+	// not started yet.
+	queryRefreshHistoryFmt = `SELECT state, state_message, refresh_end_time
+  FROM TABLE(%s.INFORMATION_SCHEMA.DYNAMIC_TABLE_REFRESH_HISTORY(NAME => %s))
+ WHERE state <> 'SCHEDULED'
+ ORDER BY data_timestamp DESC
+ LIMIT 1`
+)
+
+// DynamicTableObserver is a managed.Initializer that records the outcome of
+// the latest refresh of a dynamic table in its status, so that stalled or
+// failing refreshes can be alerted on. Terraform only reports the scheduling
+// state, so the refresh history is queried before every observation. The
+// fields it sets are not part of the Terraform state and so survive the
+// observation.
+type DynamicTableObserver struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+}
+
+// NewDynamicTableObserver returns a new DynamicTableObserver. It satisfies
+// the signature of upjet's config.NewInitializerFn.
+func NewDynamicTableObserver(kube client.Client) managed.Initializer {
+	return &DynamicTableObserver{kube: kube, newClient: clients.NewSQLClient}
+}
+
+// Initialize records the latest refresh of the dynamic table, if it exists.
+// Failing to read the refresh history does not block managing the table; the
+// previously recorded status is kept instead.
+func (o *DynamicTableObserver) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || meta.GetExternalName(mg) == "" {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	fqn, ok := clients.SchemaObjectName(p)
+	if !ok {
+		return nil
+	}
+	db, _ := p.GetString("spec.forProvider.database")
+
+	c, err := o.newClient(ctx, o.kube, mg)
+	if err != nil {
+		return nil //nolint:nilerr // best effort, see above
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	rows, err := c.Query(ctx, fmt.Sprintf(queryRefreshHistoryFmt, clients.QuoteIdentifier(db), clients.QuoteString(fqn)))
+	if err != nil || len(rows) == 0 {
+		return nil //nolint:nilerr // best effort, see above
+	}
+
+	return clients.SetObservation(mg, p, map[string]any{
+		fieldLastRefreshState:        rows[0]["state"],
+		fieldLastRefreshStateMessage: rows[0]["state_message"],
+		fieldLastRefreshEndTime:      rows[0]["refresh_end_time"],
+	})
+}
@@ -0,0 +1,84 @@
+package refresh
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func dynamicTable(externalName string, o v1alpha1.DynamicTableObservation) *v1alpha1.DynamicTable {
+	db, schema, name := "ANALYTICS", "PUBLIC", "DAILY_EVENTS"
+	cr := &v1alpha1.DynamicTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "daily-events"},
+		Spec: v1alpha1.DynamicTableSpec{
+			ForProvider: v1alpha1.DynamicTableParameters{Database: &db, Schema: &schema, Name: &name},
+		},
+		Status: v1alpha1.DynamicTableStatus{AtProvider: o},
+	}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func ptr(s string) *string { return &s }
+
+func TestDynamicTableObserverInitialize(t *testing.T) {
+	query := fmt.Sprintf(queryRefreshHistoryFmt, `"ANALYTICS"`, `'"ANALYTICS"."PUBLIC"."DAILY_EVENTS"'`)
+	failed := map[string]string{"state": "FAILED", "state_message": "Warehouse suspended", "refresh_end_time": "2024-05-10 12:00:00.000 -0700"}
+	previous := v1alpha1.DynamicTableObservation{LastRefreshState: ptr("SUCCEEDED")}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.DynamicTable
+		sql    *fake.SQLClient
+		want   v1alpha1.DynamicTableObservation
+	}{
+		"NotCreated": {
+			reason: "A dynamic table without an external name has no refresh history.",
+			cr:     dynamicTable("", v1alpha1.DynamicTableObservation{}),
+			sql:    &fake.SQLClient{Rows: map[string][]map[string]string{query: {failed}}},
+		},
+		"Refreshed": {
+			reason: "The outcome of the latest refresh should be recorded.",
+			cr:     dynamicTable("x", previous),
+			sql:    &fake.SQLClient{Rows: map[string][]map[string]string{query: {failed}}},
+			want: v1alpha1.DynamicTableObservation{
+				LastRefreshState:        ptr("FAILED"),
+				LastRefreshStateMessage: ptr("Warehouse suspended"),
+				LastRefreshEndTime:      ptr("2024-05-10 12:00:00.000 -0700"),
+			},
+		},
+		"NoHistory": {
+			reason: "The previously recorded status should be kept if there is no refresh history yet.",
+			cr:     dynamicTable("x", previous),
+			sql:    &fake.SQLClient{},
+			want:   previous,
+		},
+		"QueryFailed": {
+			reason: "The previously recorded status should be kept if the refresh history cannot be read.",
+			cr:     dynamicTable("x", previous),
+			sql:    &fake.SQLClient{Err: errors.New("boom")},
+			want:   previous,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &DynamicTableObserver{newClient: fake.NewSQLClientFn(tc.sql)}
+			if err := o.Initialize(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nInitialize(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
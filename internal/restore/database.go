@@ -0,0 +1,152 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	// AnnotationKeyRestoreDropped opts a Database in to being restored with
+	// UNDROP, instead of created from scratch, when a dropped database with
+	// the same name is still within its Time Travel retention period.
+	AnnotationKeyRestoreDropped = "snowflake.com/restore-dropped"
+
+	// TypeRestored is the condition type recording that a managed resource
+	// was restored from Time Travel rather than created.
+	TypeRestored xpv1.ConditionType = "Restored"
+
+	// ReasonUndropped means the external object was restored with UNDROP.
+	ReasonUndropped xpv1.ConditionReason = "Undropped"
+
+	errNotDatabase      = "managed resource is not a Database"
+	errConnect          = "cannot connect to Snowflake to look for a dropped database"
+	errShowDatabases    = "cannot list databases"
+	errShowHistory      = "cannot list dropped databases"
+	errUndrop           = "cannot undrop database"
+	errUpdateManaged    = "cannot update managed resource"
+	errParseDroppedOnFn = "cannot parse dropped_on %q of database %q"
+)
+
+// DatabaseRestorer is a managed.Initializer that undrops a Database whose
+// name matches a database dropped within its Time Travel retention period.
+// It runs before the first Observe, and points the external name at the
+// restored database so that it is imported rather than created.
+type DatabaseRestorer struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+	now       func() time.Time
+}
+
+// NewDatabaseRestorer returns a new DatabaseRestorer. It satisfies the
+// signature of upjet's config.NewInitializerFn.
+func NewDatabaseRestorer(kube client.Client) managed.Initializer {
+	return &DatabaseRestorer{kube: kube, newClient: clients.NewSQLClient, now: time.Now}
+}
+
+// Initialize restores a dropped database if the Database opted in and has not
+// been created yet.
+func (r *DatabaseRestorer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if !strings.EqualFold(mg.GetAnnotations()[AnnotationKeyRestoreDropped], "true") ||
+		meta.WasDeleted(mg) || meta.GetExternalName(mg) != "" {
+		return nil
+	}
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return errors.New(errNotDatabase)
+	}
+	if cr.Spec.ForProvider.Name == nil {
+		return nil
+	}
+	name := *cr.Spec.ForProvider.Name
+
+	c, err := r.newClient(ctx, r.kube, mg)
+	if err != nil {
+		return errors.Wrap(err, errConnect)
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	live, err := c.Query(ctx, "SHOW DATABASES LIKE "+clients.QuoteString(name))
+	if err != nil {
+		return errors.Wrap(err, errShowDatabases)
+	}
+	for _, row := range live {
+		if row["name"] == name {
+			// A database with this name exists, so there is nothing to
+			// restore. Creation will surface the conflict.
+			return nil
+		}
+	}
+
+	history, err := c.Query(ctx, "SHOW DATABASES HISTORY LIKE "+clients.QuoteString(name))
+	if err != nil {
+		return errors.Wrap(err, errShowHistory)
+	}
+	droppedOn, ok, err := r.latestRestorable(history, name)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := c.Exec(ctx, "UNDROP DATABASE "+clients.QuoteIdentifier(name)); err != nil {
+		return errors.Wrap(err, errUndrop)
+	}
+
+	// The Terraform identifier of a database is its quoted name.
+	meta.SetExternalName(mg, clients.QuoteIdentifier(name))
+	if err := r.kube.Update(ctx, mg); err != nil {
+		return errors.Wrap(err, errUpdateManaged)
+	}
+	// Set after the update, which would otherwise overwrite the in-memory
+	// status with the stored one. The reconciler persists it.
+	mg.SetConditions(xpv1.Condition{
+		Type:               TypeRestored,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUndropped,
+		Message:            fmt.Sprintf("Database %q dropped on %s was restored with UNDROP", name, droppedOn.Format(time.RFC3339)),
+	})
+	return nil
+}
+
+// latestRestorable returns the drop time of the most recently dropped
+// database with the supplied name that is still within its retention period.
+func (r *DatabaseRestorer) latestRestorable(history []map[string]string, name string) (time.Time, bool, error) {
+	var dropped []time.Time
+	for _, row := range history {
+		if row["name"] != name || row["dropped_on"] == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, row["dropped_on"])
+		if err != nil {
+			return time.Time{}, false, errors.Wrapf(err, errParseDroppedOnFn, row["dropped_on"], name)
+		}
+		// SHOW DATABASES HISTORY only lists databases that are still in
+		// Time Travel, but check retention_time in case one expires
+		// between listing and undropping.
+		if days, err := strconv.Atoi(row["retention_time"]); err == nil && !t.Add(time.Duration(days)*24*time.Hour).After(r.now()) {
+			continue
+		}
+		dropped = append(dropped, t)
+	}
+	if len(dropped) == 0 {
+		return time.Time{}, false, nil
+	}
+	// UNDROP restores the most recently dropped version.
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i].After(dropped[j]) })
+	return dropped[0], true, nil
+}
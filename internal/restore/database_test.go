@@ -0,0 +1,143 @@
+package restore
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	sqlfake "github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func database(annotations map[string]string) *v1alpha1.Database {
+	name := "ANALYTICS"
+	cr := &v1alpha1.Database{
+		ObjectMeta: metav1.ObjectMeta{Name: "analytics"},
+		Spec: v1alpha1.DatabaseSpec{
+			ForProvider: v1alpha1.DatabaseParameters{Name: &name},
+		},
+	}
+	for k, v := range annotations {
+		meta.AddAnnotations(cr, map[string]string{k: v})
+	}
+	return cr
+}
+
+func TestDatabaseRestorerInitialize(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	optIn := map[string]string{AnnotationKeyRestoreDropped: "true"}
+	history := func(rows ...map[string]string) map[string][]map[string]string {
+		return map[string][]map[string]string{"SHOW DATABASES HISTORY LIKE 'ANALYTICS'": rows}
+	}
+
+	type want struct {
+		err          error
+		execs        []string
+		externalName string
+		restored     bool
+	}
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Database
+		rows   map[string][]map[string]string
+		want   want
+	}{
+		"NotOptedIn": {
+			reason: "A Database without the annotation should be created as usual.",
+			cr:     database(nil),
+			rows:   history(map[string]string{"name": "ANALYTICS", "dropped_on": "2024-05-09T12:00:00Z", "retention_time": "1"}),
+		},
+		"AlreadyExists": {
+			reason: "A Database whose external name is set has already been created or imported.",
+			cr: func() *v1alpha1.Database {
+				cr := database(optIn)
+				meta.SetExternalName(cr, `"ANALYTICS"`)
+				return cr
+			}(),
+			want: want{externalName: `"ANALYTICS"`},
+		},
+		"LiveDatabase": {
+			reason: "Nothing should be undropped when a live database has the same name.",
+			cr:     database(optIn),
+			rows: map[string][]map[string]string{
+				"SHOW DATABASES LIKE 'ANALYTICS'":         {{"name": "ANALYTICS"}},
+				"SHOW DATABASES HISTORY LIKE 'ANALYTICS'": {{"name": "ANALYTICS", "dropped_on": "2024-05-10T11:00:00Z", "retention_time": "1"}},
+			},
+		},
+		"NothingDropped": {
+			reason: "A Database with no dropped namesake should be created as usual.",
+			cr:     database(optIn),
+			rows:   history(),
+		},
+		"RetentionExpired": {
+			reason: "A dropped database past its retention period cannot be undropped.",
+			cr:     database(optIn),
+			rows:   history(map[string]string{"name": "ANALYTICS", "dropped_on": "2024-05-08T12:00:00Z", "retention_time": "1"}),
+		},
+		"MalformedDroppedOn": {
+			reason: "An unparseable drop time should return an error.",
+			cr:     database(optIn),
+			rows:   history(map[string]string{"name": "ANALYTICS", "dropped_on": "yesterday", "retention_time": "1"}),
+			want: want{err: errors.Wrapf(func() error {
+				_, err := time.Parse(time.RFC3339Nano, "yesterday")
+				return err
+			}(), errParseDroppedOnFn, "yesterday", "ANALYTICS")},
+		},
+		"Undropped": {
+			reason: "A dropped database within its retention period should be undropped and imported.",
+			cr:     database(optIn),
+			rows: history(
+				map[string]string{"name": "ANALYTICS", "dropped_on": "2024-05-10T09:00:00Z", "retention_time": "1"},
+				map[string]string{"name": "analytics", "dropped_on": "2024-05-10T10:00:00Z", "retention_time": "1"},
+			),
+			want: want{
+				execs:        []string{`UNDROP DATABASE "ANALYTICS"`},
+				externalName: `"ANALYTICS"`,
+				restored:     true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.cr).Build()
+			sql := &sqlfake.SQLClient{Rows: tc.rows}
+			r := &DatabaseRestorer{
+				kube:      kube,
+				newClient: sqlfake.NewSQLClientFn(sql),
+				now:       func() time.Time { return now },
+			}
+
+			err := r.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.execs, sql.Execs); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want statements, +got statements:\n%s", tc.reason, diff)
+			}
+			if got := meta.GetExternalName(tc.cr); got != tc.want.externalName {
+				t.Errorf("\n%s\nInitialize(...): want external name %q, got %q", tc.reason, tc.want.externalName, got)
+			}
+			c := tc.cr.GetCondition(TypeRestored)
+			if restored := c.Status == corev1.ConditionTrue; restored != tc.want.restored {
+				t.Errorf("\n%s\nInitialize(...): want restored %t, got condition %+v", tc.reason, tc.want.restored, c)
+			}
+			if tc.want.restored && !strings.Contains(c.Message, "2024-05-10T09:00:00Z") {
+				t.Errorf("\n%s\nInitialize(...): want the most recent drop in the condition, got %q", tc.reason, c.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+// Package stage models the credentials and encryption of a Stage, which
+// Terraform only accepts as free-form strings, as distinct fields. Secrets
+// are read from secret references, and the strings Terraform expects are
+// built from the fields right before the Terraform configuration is written.
+package stage
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Terraform arguments of snowflake_stage. The Terraform schema in the config
+// package adds the others to credentials and encryption, which are deprecated
+// and kept for Stages created before.
+const (
+	ArgumentCredentials         = "credentials"
+	ArgumentEncryption          = "encryption"
+	ArgumentAWSKeyID            = "aws_key_id"
+	ArgumentAWSSecretKey        = "aws_secret_key"
+	ArgumentAWSToken            = "aws_token"
+	ArgumentAzureSASToken       = "azure_sas_token"
+	ArgumentEncryptionType      = "encryption_type"
+	ArgumentEncryptionKMSKeyID  = "encryption_kms_key_id"
+	ArgumentEncryptionMasterKey = "encryption_master_key"
+)
+
+const (
+	fieldForProvider = "spec.forProvider"
+
+	errPaveObject = "cannot pave object"
+	errInvalidFn  = "invalid stage: %s"
+)
+
+// validatedFields are the fields of spec.forProvider Validate checks.
+var validatedFields = []string{
+	"url",
+	"storageIntegration", "storageIntegrationRef", "storageIntegrationSelector",
+	"awsKeyIdSecretRef", "awsSecretKeySecretRef", "awsTokenSecretRef", "azureSasTokenSecretRef", "credentialsSecretRef",
+	"encryptionType", "encryptionKmsKeyId", "encryptionMasterKeySecretRef", "encryption",
+}
+
+// A cloud a stage can store files in. Internal stages store them in
+// Snowflake.
+type cloud string
+
+const (
+	cloudInternal cloud = "internal"
+	cloudAWS      cloud = "S3"
+	cloudAzure    cloud = "Azure"
+	cloudGCS      cloud = "GCS"
+)
+
+// encryptionTypes are the encryption types Snowflake supports for the stages
+// of each cloud.
+var encryptionTypes = map[cloud][]string{
+	cloudInternal: {"SNOWFLAKE_FULL", "SNOWFLAKE_SSE"},
+	cloudAWS:      {"AWS_CSE", "AWS_SSE_S3", "AWS_SSE_KMS", "NONE"},
+	cloudAzure:    {"AZURE_CSE", "NONE"},
+	cloudGCS:      {"GCS_SSE_KMS", "NONE"},
+}
+
+// cloudOf returns the cloud a stage with the supplied URL stores its files in.
+func cloudOf(url string) cloud {
+	switch u := strings.ToLower(url); {
+	case u == "":
+		return cloudInternal
+	case strings.HasPrefix(u, "s3://"), strings.HasPrefix(u, "s3gov://"), strings.HasPrefix(u, "s3china://"):
+		return cloudAWS
+	case strings.HasPrefix(u, "azure://"):
+		return cloudAzure
+	case strings.HasPrefix(u, "gcs://"):
+		return cloudGCS
+	}
+	// Left for Snowflake to refuse, or for S3-compatible storage.
+	return cloudAWS
+}
+
+// credentialOptions and encryptionOptions are the arguments the options of
+// the credentials and encryption strings are built from.
+var (
+	credentialOptions = []argumentOption{
+		{ArgumentAWSKeyID, "AWS_KEY_ID"},
+		{ArgumentAWSSecretKey, "AWS_SECRET_KEY"},
+		{ArgumentAWSToken, "AWS_TOKEN"},
+		{ArgumentAzureSASToken, "AZURE_SAS_TOKEN"},
+	}
+	encryptionOptions = []argumentOption{
+		{ArgumentEncryptionType, "TYPE"},
+		{ArgumentEncryptionKMSKeyID, "KMS_KEY_ID"},
+		{ArgumentEncryptionMasterKey, "MASTER_KEY"},
+	}
+)
+
+type argumentOption struct{ argument, option string }
+
+// Arguments replaces the credential and encryption arguments of the supplied
+// snowflake_stage parameters with the credentials and encryption strings
+// Terraform expects. The deprecated credentials and encryption arguments are
+// mapped to the others first, unless any of those is set. It satisfies the
+// signature of the fn of common.ConfigureArguments.
+func Arguments(parameters map[string]any) {
+	legacyCredentials := migrate(parameters, ArgumentCredentials, credentialOptions)
+	legacyEncryption := migrate(parameters, ArgumentEncryption, encryptionOptions)
+	str := func(key string) string {
+		v, _ := parameters[key].(string)
+		delete(parameters, key)
+		return v
+	}
+
+	var credentials []string
+	for _, a := range credentialOptions {
+		if v := str(a.argument); v != "" {
+			credentials = append(credentials, option(a.option, v))
+		}
+	}
+	switch {
+	case len(credentials) > 0:
+		parameters[ArgumentCredentials] = strings.Join(credentials, " ")
+	case legacyCredentials != "":
+		parameters[ArgumentCredentials] = legacyCredentials
+	}
+
+	t, kmsKeyID, masterKey := str(ArgumentEncryptionType), str(ArgumentEncryptionKMSKeyID), str(ArgumentEncryptionMasterKey)
+	if t == "" {
+		if legacyEncryption != "" {
+			parameters[ArgumentEncryption] = legacyEncryption
+		}
+		return
+	}
+	encryption := []string{option("TYPE", strings.ToUpper(t))}
+	if kmsKeyID != "" {
+		encryption = append(encryption, option("KMS_KEY_ID", kmsKeyID))
+	}
+	if masterKey != "" {
+		encryption = append(encryption, option("MASTER_KEY", masterKey))
+	}
+	parameters[ArgumentEncryption] = strings.Join(encryption, " ")
+}
+
+// migrate removes the supplied deprecated argument from the supplied
+// parameters and sets the arguments of its options instead. It leaves the
+// parameters as they are if any of those arguments is set already, in which
+// case the deprecated argument is ignored. It returns the deprecated argument
+// if its options cannot be parsed or are not known, so that it is passed to
+// Terraform as it is.
+func migrate(parameters map[string]any, argument string, options []argumentOption) string {
+	legacy, _ := parameters[argument].(string)
+	delete(parameters, argument)
+	if legacy == "" {
+		return ""
+	}
+	arguments := make(map[string]string, len(options))
+	for _, o := range options {
+		if v, _ := parameters[o.argument].(string); v != "" {
+			return ""
+		}
+		arguments[o.option] = o.argument
+	}
+	parsed, ok := parseOptions(legacy)
+	if !ok {
+		return legacy
+	}
+	for name := range parsed {
+		if _, ok := arguments[name]; !ok {
+			return legacy
+		}
+	}
+	for name, value := range parsed {
+		parameters[arguments[name]] = value
+	}
+	return ""
+}
+
+// parseOptions parses stage options such as AWS_KEY_ID = 'AKIA' TYPE = NONE
+// into their upper-cased names and values. It returns false if s is not a
+// list of such options.
+func parseOptions(s string) (map[string]string, bool) {
+	options := map[string]string{}
+	s = strings.TrimSpace(s)
+	for s != "" {
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			return nil, false
+		}
+		name := strings.ToUpper(strings.TrimSpace(s[:eq]))
+		if name == "" || strings.ContainsAny(name, " \t\r\n'") {
+			return nil, false
+		}
+		s = strings.TrimSpace(s[eq+1:])
+		value, n, ok := literal(s)
+		if !ok {
+			return nil, false
+		}
+		options[name] = value
+		s = strings.TrimSpace(s[n:])
+	}
+	return options, true
+}
+
+// literal returns the value of the string literal or unquoted word s starts
+// with, and its length in s.
+func literal(s string) (string, int, bool) {
+	if !strings.HasPrefix(s, "'") {
+		n := strings.IndexAny(s, " \t\r\n")
+		if n < 0 {
+			n = len(s)
+		}
+		return s[:n], n, n > 0
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+			b.WriteByte('\'')
+		case s[i] == '\'':
+			return b.String(), i + 1, true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, false
+}
+
+// option returns a stage option with the supplied value as a string literal.
+func option(name, value string) string {
+	return name + " = '" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// Validate returns an error if the credentials or encryption of the supplied
+// Stage do not fit the cloud it stores its files in, or are incomplete.
+func Validate(o runtime.Object) error {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	has := func(field string) bool {
+		_, err := p.GetValue(fieldForProvider + "." + field)
+		return err == nil
+	}
+	str := func(field string) string {
+		v, _ := p.GetString(fieldForProvider + "." + field)
+		return v
+	}
+
+	var problems []string
+	url := str("url")
+	c := cloudOf(url)
+	aws := has("awsKeyIdSecretRef") || has("awsSecretKeySecretRef") || has("awsTokenSecretRef")
+	azure := has("azureSasTokenSecretRef")
+	integration := has("storageIntegration") || has("storageIntegrationRef") || has("storageIntegrationSelector")
+	if aws && c != cloudAWS {
+		problems = append(problems, fmt.Sprintf("AWS credentials can only be used with S3 URLs, not %s", describe(c, url)))
+	}
+	if aws && !(has("awsKeyIdSecretRef") && has("awsSecretKeySecretRef")) {
+		problems = append(problems, "AWS credentials require both awsKeyIdSecretRef and awsSecretKeySecretRef")
+	}
+	if azure && c != cloudAzure {
+		problems = append(problems, fmt.Sprintf("Azure credentials can only be used with Azure URLs, not %s", describe(c, url)))
+	}
+	if c == cloudInternal && integration {
+		problems = append(problems, "internal stages cannot use a storage integration")
+	}
+	if c == cloudGCS && !integration {
+		problems = append(problems, "GCS stages require a storage integration, as they do not accept credentials")
+	}
+	if (aws || azure) && integration {
+		problems = append(problems, "credentials and a storage integration are mutually exclusive")
+	}
+
+	t := strings.ToUpper(str("encryptionType"))
+	if t == "" && (has("encryptionKmsKeyId") || has("encryptionMasterKeySecretRef")) {
+		problems = append(problems, "encryptionKmsKeyId and encryptionMasterKeySecretRef require encryptionType")
+	}
+	if t != "" && !contains(encryptionTypes[c], t) {
+		problems = append(problems, fmt.Sprintf("encryptionType %s cannot be used with %s, use one of %s", t, describe(c, url), strings.Join(encryptionTypes[c], ", ")))
+	}
+	if t != "" && strings.HasSuffix(t, "_CSE") != has("encryptionMasterKeySecretRef") {
+		problems = append(problems, "encryptionMasterKeySecretRef is required for, and only allowed with, client-side encryption (AWS_CSE and AZURE_CSE)")
+	}
+	if t != "" && has("encryptionKmsKeyId") && !strings.HasSuffix(t, "_SSE_KMS") {
+		problems = append(problems, "encryptionKmsKeyId is only allowed with KMS encryption (AWS_SSE_KMS and GCS_SSE_KMS)")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.Errorf(errInvalidFn, strings.Join(problems, "; "))
+}
+
+// ValidateUpdate returns an error if updating a Stage from the old to the
+// updated object changes its URL, storage integration, credentials or
+// encryption to ones Validate refuses. Other updates, such as the ones that
+// add or remove finalizers or late-initialize other fields, are always
+// allowed, so that Stages created before the validation was added can still
+// be reconciled and deleted.
+func ValidateUpdate(old, updated runtime.Object) error {
+	o, err := fieldpath.PaveObject(old)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	u, err := fieldpath.PaveObject(updated)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	for _, f := range validatedFields {
+		before, _ := o.GetValue(fieldForProvider + "." + f)
+		after, _ := u.GetValue(fieldForProvider + "." + f)
+		if !reflect.DeepEqual(before, after) {
+			return Validate(updated)
+		}
+	}
+	return nil
+}
+
+// Warnings returns warnings about the deprecated credentials and encryption
+// fields of the supplied Stage, which are ignored when the fields that
+// replace them are set.
+func Warnings(o runtime.Object) []string {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil
+	}
+	has := func(fields ...string) bool {
+		for _, f := range fields {
+			if _, err := p.GetValue(fieldForProvider + "." + f); err == nil {
+				return true
+			}
+		}
+		return false
+	}
+
+	var warnings []string
+	credentials := []string{"awsKeyIdSecretRef", "awsSecretKeySecretRef", "awsTokenSecretRef", "azureSasTokenSecretRef"}
+	switch {
+	case has("credentialsSecretRef") && has(credentials...):
+		warnings = append(warnings, "spec.forProvider.credentialsSecretRef is deprecated and ignored, as credentials are set with "+strings.Join(credentials, ", "))
+	case has("credentialsSecretRef"):
+		warnings = append(warnings, "spec.forProvider.credentialsSecretRef is deprecated, set "+strings.Join(credentials, ", ")+" instead")
+	}
+	encryption := []string{"encryptionType", "encryptionKmsKeyId", "encryptionMasterKeySecretRef"}
+	switch {
+	case has("encryption") && has(encryption...):
+		warnings = append(warnings, "spec.forProvider.encryption is deprecated and ignored, as encryption is set with "+strings.Join(encryption, ", "))
+	case has("encryption"):
+		warnings = append(warnings, "spec.forProvider.encryption is deprecated, set "+strings.Join(encryption, ", ")+" instead")
+	}
+	return warnings
+}
+
+// describe returns a description of a stage URL for error messages.
+func describe(c cloud, url string) string {
+	if c == cloudInternal {
+		return "internal stages"
+	}
+	return fmt.Sprintf("%s URL %q", c, url)
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
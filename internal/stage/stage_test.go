@@ -0,0 +1,277 @@
+package stage
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestArguments(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		parameters map[string]any
+		want       map[string]any
+	}{
+		"Internal": {
+			reason:     "A stage without credentials or encryption should be left as is.",
+			parameters: map[string]any{"name": "LANDING"},
+			want:       map[string]any{"name": "LANDING"},
+		},
+		"AWS": {
+			reason: "AWS credentials and KMS encryption should be passed as the strings Terraform expects.",
+			parameters: map[string]any{
+				"name":                      "LANDING",
+				ArgumentAWSKeyID:            "AKIA",
+				ArgumentAWSSecretKey:        `it's\secret`,
+				ArgumentAWSToken:            "token",
+				ArgumentEncryptionType:      "aws_sse_kms",
+				ArgumentEncryptionKMSKeyID:  "alias/landing",
+				ArgumentEncryptionMasterKey: "",
+			},
+			want: map[string]any{
+				"name":              "LANDING",
+				ArgumentCredentials: `AWS_KEY_ID = 'AKIA' AWS_SECRET_KEY = 'it\'s\\secret' AWS_TOKEN = 'token'`,
+				ArgumentEncryption:  `TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = 'alias/landing'`,
+			},
+		},
+		"Azure": {
+			reason: "An Azure SAS token and master key should be passed as the strings Terraform expects.",
+			parameters: map[string]any{
+				ArgumentAzureSASToken:       "?sv=2024",
+				ArgumentEncryptionType:      "AZURE_CSE",
+				ArgumentEncryptionMasterKey: "a2V5",
+			},
+			want: map[string]any{
+				ArgumentCredentials: `AZURE_SAS_TOKEN = '?sv=2024'`,
+				ArgumentEncryption:  `TYPE = 'AZURE_CSE' MASTER_KEY = 'a2V5'`,
+			},
+		},
+		"Legacy": {
+			reason: "Deprecated credentials and encryption should be mapped to the fields that replace them.",
+			parameters: map[string]any{
+				ArgumentCredentials: `aws_key_id='AKIA'  AWS_SECRET_KEY = 'it''s\\secret'`,
+				ArgumentEncryption:  "TYPE = AWS_SSE_S3",
+			},
+			want: map[string]any{
+				ArgumentCredentials: `AWS_KEY_ID = 'AKIA' AWS_SECRET_KEY = 'it\'s\\secret'`,
+				ArgumentEncryption:  `TYPE = 'AWS_SSE_S3'`,
+			},
+		},
+		"LegacyUnknownOptions": {
+			reason: "Deprecated credentials and encryption that cannot be mapped should be passed as they are.",
+			parameters: map[string]any{
+				ArgumentCredentials: "AWS_ROLE = 'arn:aws:iam::123456789012:role/landing'",
+				ArgumentEncryption:  "TYPE =",
+			},
+			want: map[string]any{
+				ArgumentCredentials: "AWS_ROLE = 'arn:aws:iam::123456789012:role/landing'",
+				ArgumentEncryption:  "TYPE =",
+			},
+		},
+		"LegacyIgnored": {
+			reason: "Deprecated credentials and encryption should be ignored when the fields that replace them are set.",
+			parameters: map[string]any{
+				ArgumentCredentials:    "AWS_KEY_ID = 'OLD' AWS_SECRET_KEY = 'OLD'",
+				ArgumentEncryption:     "TYPE = 'AWS_CSE' MASTER_KEY = 'b2xk'",
+				ArgumentAzureSASToken:  "?sv=2024",
+				ArgumentEncryptionType: "NONE",
+			},
+			want: map[string]any{
+				ArgumentCredentials: `AZURE_SAS_TOKEN = '?sv=2024'`,
+				ArgumentEncryption:  `TYPE = 'NONE'`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			Arguments(tc.parameters)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("\n%s\nArguments(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func newStage(forProvider map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "database.snowflake.com/v1alpha1",
+		"kind":       "Stage",
+		"spec":       map[string]any{"forProvider": forProvider},
+	}}
+}
+
+func TestValidate(t *testing.T) {
+	secret := map[string]any{"name": "landing", "namespace": "crossplane-system", "key": "k"}
+	invalid := func(problems string) error { return errors.Errorf(errInvalidFn, problems) }
+
+	cases := map[string]struct {
+		reason string
+		stage  map[string]any
+		want   error
+	}{
+		"Internal": {
+			reason: "An internal stage with Snowflake encryption is valid.",
+			stage:  map[string]any{"encryptionType": "SNOWFLAKE_SSE"},
+		},
+		"AWSCredentials": {
+			reason: "An S3 stage with an access key and client-side encryption is valid.",
+			stage: map[string]any{
+				"url":                          "s3://landing/events/",
+				"awsKeyIdSecretRef":            secret,
+				"awsSecretKeySecretRef":        secret,
+				"encryptionType":               "AWS_CSE",
+				"encryptionMasterKeySecretRef": secret,
+			},
+		},
+		"GCSIntegration": {
+			reason: "A GCS stage with a storage integration and KMS encryption is valid.",
+			stage: map[string]any{
+				"url":                   "gcs://landing/events/",
+				"storageIntegrationRef": map[string]any{"name": "landing-gcs"},
+				"encryptionType":        "GCS_SSE_KMS",
+				"encryptionKmsKeyId":    "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+			},
+		},
+		"IncompleteAWSCredentials": {
+			reason: "An AWS key ID without a secret key is invalid.",
+			stage:  map[string]any{"url": "s3://landing/", "awsKeyIdSecretRef": secret},
+			want:   invalid("AWS credentials require both awsKeyIdSecretRef and awsSecretKeySecretRef"),
+		},
+		"AzureCredentialsOnS3": {
+			reason: "An Azure SAS token cannot be used with an S3 stage.",
+			stage:  map[string]any{"url": "s3://landing/", "azureSasTokenSecretRef": secret},
+			want:   invalid(`Azure credentials can only be used with Azure URLs, not S3 URL "s3://landing/"`),
+		},
+		"CredentialsAndIntegration": {
+			reason: "Credentials and a storage integration are mutually exclusive.",
+			stage:  map[string]any{"url": "azure://landing.blob.core.windows.net/events", "azureSasTokenSecretRef": secret, "storageIntegration": "LANDING"},
+			want:   invalid("credentials and a storage integration are mutually exclusive"),
+		},
+		"GCSWithoutIntegration": {
+			reason: "A GCS stage requires a storage integration.",
+			stage:  map[string]any{"url": "gcs://landing/"},
+			want:   invalid("GCS stages require a storage integration, as they do not accept credentials"),
+		},
+		"WrongEncryptionType": {
+			reason: "An encryption type of another cloud is invalid, and so are credentials on an internal stage.",
+			stage:  map[string]any{"awsKeyIdSecretRef": secret, "awsSecretKeySecretRef": secret, "encryptionType": "AWS_SSE_S3"},
+			want: invalid("AWS credentials can only be used with S3 URLs, not internal stages; " +
+				"encryptionType AWS_SSE_S3 cannot be used with internal stages, use one of SNOWFLAKE_FULL, SNOWFLAKE_SSE"),
+		},
+		"MissingMasterKey": {
+			reason: "Client-side encryption requires a master key.",
+			stage:  map[string]any{"url": "azure://landing.blob.core.windows.net/events", "storageIntegration": "LANDING", "encryptionType": "AZURE_CSE"},
+			want:   invalid("encryptionMasterKeySecretRef is required for, and only allowed with, client-side encryption (AWS_CSE and AZURE_CSE)"),
+		},
+		"KMSKeyWithoutKMS": {
+			reason: "A KMS key requires KMS encryption.",
+			stage:  map[string]any{"url": "s3://landing/", "storageIntegration": "LANDING", "encryptionType": "AWS_SSE_S3", "encryptionKmsKeyId": "alias/landing"},
+			want:   invalid("encryptionKmsKeyId is only allowed with KMS encryption (AWS_SSE_KMS and GCS_SSE_KMS)"),
+		},
+		"AllProblems": {
+			reason: "Every problem should be reported, not only the first one of each kind.",
+			stage: map[string]any{
+				"url":                    "s3://landing/",
+				"awsKeyIdSecretRef":      secret,
+				"azureSasTokenSecretRef": secret,
+				"storageIntegration":     "LANDING",
+				"encryptionType":         "AWS_CSE",
+				"encryptionKmsKeyId":     "alias/landing",
+			},
+			want: invalid("AWS credentials require both awsKeyIdSecretRef and awsSecretKeySecretRef; " +
+				`Azure credentials can only be used with Azure URLs, not S3 URL "s3://landing/"; ` +
+				"credentials and a storage integration are mutually exclusive; " +
+				"encryptionKmsKeyId is only allowed with KMS encryption (AWS_SSE_KMS and GCS_SSE_KMS); " +
+				"encryptionMasterKeySecretRef is required for, and only allowed with, client-side encryption (AWS_CSE and AZURE_CSE)"),
+		},
+		"EncryptionWithoutType": {
+			reason: "Encryption keys require an encryption type.",
+			stage:  map[string]any{"url": "s3://landing/", "storageIntegration": "LANDING", "encryptionKmsKeyId": "alias/landing"},
+			want:   invalid("encryptionKmsKeyId and encryptionMasterKeySecretRef require encryptionType"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(newStage(tc.stage))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	invalid := map[string]any{"url": "gcs://landing/"}
+
+	cases := map[string]struct {
+		reason  string
+		old     map[string]any
+		updated map[string]any
+		want    error
+	}{
+		"Unchanged": {
+			reason:  "Updates that leave an invalid spec as is should be allowed.",
+			old:     invalid,
+			updated: invalid,
+		},
+		"OtherFieldChanged": {
+			reason:  "Updates that change fields Validate does not check, such as late-initialized ones, should be allowed.",
+			old:     invalid,
+			updated: map[string]any{"url": "gcs://landing/", "comment": "Landing zone"},
+		},
+		"Changed": {
+			reason:  "Updates that change the fields Validate checks should be validated.",
+			old:     map[string]any{"url": "gcs://landing/", "storageIntegration": "LANDING"},
+			updated: invalid,
+			want:    errors.Errorf(errInvalidFn, "GCS stages require a storage integration, as they do not accept credentials"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateUpdate(newStage(tc.old), newStage(tc.updated))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	secret := map[string]any{"name": "landing", "namespace": "crossplane-system", "key": "k"}
+	cases := map[string]struct {
+		reason string
+		stage  map[string]any
+		want   []string
+	}{
+		"Current": {
+			reason: "A Stage without deprecated fields should not be warned about.",
+			stage:  map[string]any{"url": "s3://landing/", "awsKeyIdSecretRef": secret, "encryptionType": "NONE"},
+		},
+		"Deprecated": {
+			reason: "Deprecated fields should be warned about.",
+			stage:  map[string]any{"url": "s3://landing/", "credentialsSecretRef": secret, "encryption": "TYPE = 'NONE'"},
+			want: []string{
+				"spec.forProvider.credentialsSecretRef is deprecated, set awsKeyIdSecretRef, awsSecretKeySecretRef, awsTokenSecretRef, azureSasTokenSecretRef instead",
+				"spec.forProvider.encryption is deprecated, set encryptionType, encryptionKmsKeyId, encryptionMasterKeySecretRef instead",
+			},
+		},
+		"Ignored": {
+			reason: "Deprecated fields that are ignored should be warned about.",
+			stage:  map[string]any{"url": "s3://landing/", "credentialsSecretRef": secret, "awsKeyIdSecretRef": secret, "encryption": "TYPE = 'NONE'", "encryptionType": "NONE"},
+			want: []string{
+				"spec.forProvider.credentialsSecretRef is deprecated and ignored, as credentials are set with awsKeyIdSecretRef, awsSecretKeySecretRef, awsTokenSecretRef, azureSasTokenSecretRef",
+				"spec.forProvider.encryption is deprecated and ignored, as encryption is set with encryptionType, encryptionKmsKeyId, encryptionMasterKeySecretRef",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Warnings(newStage(tc.stage))); diff != "" {
+				t.Errorf("\n%s\nWarnings(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
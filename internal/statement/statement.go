@@ -0,0 +1,158 @@
+package statement
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	attrStatement = "statement"
+	fieldDesired  = "spec.forProvider.statement"
+	fieldObserved = "status.atProvider.statement"
+
+	errPaveObject = "cannot pave object"
+
+	// punctuation that needs no surrounding whitespace.
+	punctuation = "(),;=<>+*/.|"
+	whitespace  = " \t\r\n\f\v"
+	// dollarQuote delimits string constants that may span several lines,
+	// such as the bodies of functions and procedures.
+	dollarQuote = "$$"
+)
+
+// Normalize returns the supplied SQL statement with insignificant differences
+// removed: runs of whitespace are collapsed, whitespace around punctuation and
+// trailing semicolons are dropped, and everything outside of quoted literals
+// and identifiers is upper-cased. Single-quoted, double-quoted and $$-quoted
+// text is kept as is.
+func Normalize(s string) string {
+	var b strings.Builder
+	var last byte
+	space := false
+	s = strings.TrimRight(strings.TrimSpace(s), "; \t\r\n")
+	for i := 0; i < len(s); {
+		c := s[i]
+		if strings.IndexByte(whitespace, c) >= 0 {
+			space = true
+			i++
+			continue
+		}
+		if space && strings.IndexByte(punctuation, c) < 0 && strings.IndexByte(punctuation, last) < 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		n := quoted(s[i:])
+		if n == 0 {
+			// unquoted identifiers and keywords are ASCII, so the bytes of
+			// other runes are copied as is.
+			n = 1
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b.WriteByte(c)
+		} else {
+			b.WriteString(s[i : i+n])
+		}
+		last = s[i+n-1]
+		i += n
+	}
+	return b.String()
+}
+
+// quoted returns the length of the quoted literal or identifier that s starts
+// with, including its delimiters, or 0 if s does not start with one. An
+// unterminated literal extends to the end of s.
+func quoted(s string) int {
+	switch {
+	case strings.HasPrefix(s, dollarQuote):
+		if end := strings.Index(s[len(dollarQuote):], dollarQuote); end >= 0 {
+			return end + 2*len(dollarQuote)
+		}
+		return len(s)
+	case s[0] == '\'' || s[0] == '"':
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				if s[0] == '\'' {
+					i++
+				}
+			case s[0]:
+				return i + 1
+			}
+		}
+		return len(s)
+	}
+	return 0
+}
+
+// Equal returns true if the supplied SQL statements are the same once
+// normalized.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// observed holds the statement Snowflake returned for each resource, keyed
+// by its external name, while it is equivalent to the desired one.
+var observed sync.Map
+
+type equivalent struct{ desired, observed string }
+
+// Recorder is a managed.Initializer for resources defined by a SQL statement,
+// such as views. Snowflake returns the statement with its own whitespace and
+// case, which Terraform would report as a change to apply on every poll.
+// When the desired statement is equal to the observed one once normalized,
+// Recorder records the observed text, which Arguments then passes to
+// Terraform instead, so that no update is planned. The managed resource is
+// never changed.
+type Recorder struct{}
+
+// NewRecorder returns a new Recorder. It satisfies the signature of upjet's
+// config.NewInitializerFn.
+func NewRecorder(_ client.Client) managed.Initializer {
+	return &Recorder{}
+}
+
+// Initialize records the observed statement of the resource if it is
+// equivalent to the desired one, and forgets it otherwise.
+func (r *Recorder) Initialize(_ context.Context, mg resource.Managed) error {
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	desired, _ := p.GetString(fieldDesired)
+	current, _ := p.GetString(fieldObserved)
+	if meta.WasDeleted(mg) || desired == "" || current == "" || desired == current || !Equal(desired, current) {
+		observed.Delete(name)
+		return nil
+	}
+	observed.Store(name, equivalent{desired: desired, observed: current})
+	return nil
+}
+
+// Arguments replaces the statement of the supplied Terraform parameters with
+// the observed statement Recorder recorded for the resource with the supplied
+// external name, if it was recorded for the same desired statement. Resources
+// of different ProviderConfigs may share an external name; the statement is
+// then still only replaced with an equivalent one. It satisfies the
+// signature of the fn of common.ConfigureArgumentsFor.
+func Arguments(parameters map[string]any, externalName string) {
+	e, ok := observed.Load(externalName)
+	if !ok {
+		return
+	}
+	if desired, _ := parameters[attrStatement].(string); desired == e.(equivalent).desired {
+		parameters[attrStatement] = e.(equivalent).observed
+	}
+}
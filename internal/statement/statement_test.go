@@ -0,0 +1,137 @@
+package statement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+func TestEqual(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"Whitespace": {
+			a:    "select id,\n       data\n  from events\n where id > 1",
+			b:    "select id, data from events where id > 1;",
+			want: true,
+		},
+		"Case": {
+			a:    "SELECT ID FROM EVENTS",
+			b:    "select id from events",
+			want: true,
+		},
+		"Punctuation": {
+			a:    "select count( * ) from events e where e.id=1",
+			b:    "select count(*) from events e where e . id = 1",
+			want: true,
+		},
+		"StringLiteralCase": {
+			a:    "select * from events where kind = 'Click'",
+			b:    "select * from events where kind = 'click'",
+			want: false,
+		},
+		"StringLiteralWhitespace": {
+			a:    "select * from events where kind = 'a  b'",
+			b:    "select * from events where kind = 'a b'",
+			want: false,
+		},
+		"StringLiteralEscapedQuote": {
+			a:    `select * from events where kind = 'it\'s  a'`,
+			b:    `select * from events where kind = 'it\'s a'`,
+			want: false,
+		},
+		"DollarQuotedWhitespace": {
+			a:    "select $$a  b$$ from events",
+			b:    "select $$a b$$ from events",
+			want: false,
+		},
+		"DollarQuotedCase": {
+			a:    "select $$Click$$ from events",
+			b:    "SELECT $$click$$ FROM events",
+			want: false,
+		},
+		"DollarQuotedEquivalent": {
+			a:    "select $$it's$$,\n  id from events",
+			b:    "SELECT $$it's$$, ID FROM EVENTS",
+			want: true,
+		},
+		"QuotedIdentifier": {
+			a:    `select "Id" from events`,
+			b:    `select "ID" from events`,
+			want: false,
+		},
+		"DifferentQuery": {
+			a:    "select id from events",
+			b:    "select id, data from events",
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := Equal(tc.a, tc.b); got != tc.want {
+				t.Errorf("Equal(%q, %q): want %t, got %t (%q, %q)", tc.a, tc.b, tc.want, got, Normalize(tc.a), Normalize(tc.b))
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	view := func(name, desired, current string) *v1alpha1.View {
+		v := &v1alpha1.View{}
+		v.Spec.ForProvider.Statement = &desired
+		v.Status.AtProvider.Statement = &current
+		meta.SetExternalName(v, name)
+		return v
+	}
+	cases := map[string]struct {
+		reason     string
+		view       *v1alpha1.View
+		parameters map[string]any
+		want       string
+	}{
+		"Equivalent": {
+			reason:     "An equivalent statement should be replaced with the observed one.",
+			view:       view("EQUIVALENT", "select id\n  from events", "SELECT id FROM events"),
+			parameters: map[string]any{attrStatement: "select id\n  from events"},
+			want:       "SELECT id FROM events",
+		},
+		"Changed": {
+			reason:     "A changed statement should be kept so that it is applied.",
+			view:       view("CHANGED", "select id, data from events", "SELECT id FROM events"),
+			parameters: map[string]any{attrStatement: "select id, data from events"},
+			want:       "select id, data from events",
+		},
+		"ChangedLiteral": {
+			reason:     "A statement whose literals changed should be kept so that it is applied.",
+			view:       view("LITERAL", "select id from events where kind = 'click'", "SELECT id FROM events WHERE kind = 'Click'"),
+			parameters: map[string]any{attrStatement: "select id from events where kind = 'click'"},
+			want:       "select id from events where kind = 'click'",
+		},
+		"OtherDesired": {
+			reason:     "A statement recorded for another desired statement should not be used.",
+			view:       view("OTHER", "select id\n  from events", "SELECT id FROM events"),
+			parameters: map[string]any{attrStatement: "select id, data from events"},
+			want:       "select id, data from events",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			before := tc.view.DeepCopy()
+			if err := NewRecorder(nil).Initialize(context.Background(), tc.view); err != nil {
+				t.Fatalf("\n%s\nInitialize(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(before, tc.view); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): resource changed, -want, +got:\n%s", tc.reason, diff)
+			}
+			Arguments(tc.parameters, meta.GetExternalName(tc.view))
+			if diff := cmp.Diff(tc.want, tc.parameters[attrStatement]); diff != "" {
+				t.Errorf("\n%s\nArguments(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
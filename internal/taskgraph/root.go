@@ -0,0 +1,262 @@
+package taskgraph
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	errPaveObject        = "cannot pave object"
+	errListTasks         = "cannot list tasks"
+	errSuspendRoot       = "cannot suspend root task"
+	errChildrenPendingFn = "root task is kept suspended until its child tasks are applied: %s"
+)
+
+// taskListGVK is the GroupVersionKind of a list of Tasks. It is spelled out
+// because the config package, which configures this initializer, is built
+// before the Task API is generated.
+var taskListGVK = schema.GroupVersionKind{Group: "database.snowflake.com", Version: "v1alpha1", Kind: "TaskList"}
+
+// RootSuspender is a managed.Initializer for Tasks. Snowflake refuses to
+// create, change or drop a task of a graph while its root task is running.
+// Terraform suspends the root task while it applies a child task, but the
+// controller of the root task would resume it as soon as it observes it
+// suspended, racing with the change. While any child task of a started root
+// task is not yet created, being deleted, not synced, or not yet started or
+// linked as desired, RootSuspender suspends the root task itself and stops
+// its reconciliation. The root task is resumed once all of its children have
+// been applied, as Snowflake requires children to be resumed before their
+// root.
+type RootSuspender struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+}
+
+// NewRootSuspender returns a new RootSuspender. It satisfies the signature of
+// upjet's config.NewInitializerFn.
+func NewRootSuspender(kube client.Client) managed.Initializer {
+	return &RootSuspender{kube: kube, newClient: clients.NewSQLClient}
+}
+
+// Initialize suspends a started root task while its children are pending.
+func (s *RootSuspender) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || meta.GetExternalName(mg) == "" {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	root := newTask(mg, p)
+	if !root.isRoot() || !root.started {
+		return nil
+	}
+
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(taskListGVK)
+	if err := s.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListTasks)
+	}
+	tasks := make([]task, 0, len(l.Items))
+	for i := range l.Items {
+		tasks = append(tasks, newTask(&l.Items[i], fieldpath.Pave(l.Items[i].Object)))
+	}
+	pending := pendingChildren(root, tasks)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if root.observedStarted {
+		c, err := s.newClient(ctx, s.kube, mg)
+		if err != nil {
+			return errors.Wrap(err, errSuspendRoot)
+		}
+		defer c.Close() //nolint:errcheck // nothing to do about it
+		if err := c.Exec(ctx, "ALTER TASK "+root.identifier()+" SUSPEND"); err != nil {
+			return errors.Wrap(err, errSuspendRoot)
+		}
+	}
+	return errors.Errorf(errChildrenPendingFn, strings.Join(pending, ", "))
+}
+
+// task holds the fields of a Task that describe its place in a task graph.
+type task struct {
+	name           string
+	providerConfig string
+
+	database, schema, taskName string
+	fqn                        string
+
+	after, observedAfter       []string
+	finalize, observedFinalize string
+	afterRefs                  []string
+	finalizeRef                string
+
+	started, observedStarted bool
+
+	created, deleted, synced bool
+}
+
+func newTask(o metav1.Object, p *fieldpath.Paved) task {
+	t := task{name: o.GetName(), created: meta.GetExternalName(o) != "", deleted: meta.WasDeleted(o)}
+	t.providerConfig, _ = p.GetString("spec.providerConfigRef.name")
+	t.database, _ = p.GetString("spec.forProvider.database")
+	t.schema, _ = p.GetString("spec.forProvider.schema")
+	t.taskName, _ = p.GetString("spec.forProvider.name")
+	t.fqn, _ = p.GetString("status.atProvider.fullyQualifiedName")
+	if t.fqn == "" && t.database != "" && t.schema != "" && t.taskName != "" {
+		t.fqn = t.identifier()
+	}
+	t.fqn = normalize(t.fqn)
+
+	t.after = normalizeAll(stringArray(p, "spec.forProvider.after"))
+	t.observedAfter = normalizeAll(stringArray(p, "status.atProvider.after"))
+	f, _ := p.GetString("spec.forProvider.finalize")
+	t.finalize = normalize(f)
+	f, _ = p.GetString("status.atProvider.finalize")
+	t.observedFinalize = normalize(f)
+
+	refs := []xpv1.Reference{}
+	_ = p.GetValueInto("spec.forProvider.afterRefs", &refs)
+	for _, r := range refs {
+		t.afterRefs = append(t.afterRefs, r.Name)
+	}
+	t.finalizeRef, _ = p.GetString("spec.forProvider.finalizeRef.name")
+
+	t.started, _ = p.GetBool("spec.forProvider.started")
+	t.observedStarted, _ = p.GetBool("status.atProvider.started")
+
+	conditions := []xpv1.Condition{}
+	_ = p.GetValueInto("status.conditions", &conditions)
+	for _, c := range conditions {
+		if c.Type == xpv1.TypeSynced && c.Status == corev1.ConditionTrue {
+			t.synced = true
+		}
+	}
+	return t
+}
+
+// identifier returns the quoted, fully qualified identifier of the task.
+func (t task) identifier() string {
+	return clients.FullyQualifiedName(t.database, t.schema, t.taskName)
+}
+
+// isRoot returns true if the task has no predecessors and finalizes no task.
+func (t task) isRoot() bool {
+	return len(t.after) == 0 && len(t.afterRefs) == 0 && t.finalize == "" && t.finalizeRef == ""
+}
+
+// follows returns true if the task runs after or finalizes a member of the
+// supplied graph.
+func (t task) follows(graph map[string]task) bool {
+	for _, m := range graph {
+		if (t.finalizeRef != "" && t.finalizeRef == m.name) || (t.finalize != "" && t.finalize == m.fqn) {
+			return true
+		}
+		for _, r := range t.afterRefs {
+			if r == m.name {
+				return true
+			}
+		}
+		for _, a := range t.after {
+			if m.fqn != "" && a == m.fqn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pending returns true if the task has changes that are not yet applied.
+func (t task) pending() bool {
+	return !t.created || t.deleted || !t.synced ||
+		t.started != t.observedStarted ||
+		t.finalize != t.observedFinalize ||
+		strings.Join(t.after, ",") != strings.Join(t.observedAfter, ",")
+}
+
+// pendingChildren returns the sorted names of the tasks of the graph of the
+// supplied root that have changes that are not yet applied. Only tasks using
+// the same ProviderConfig as the root are considered.
+func pendingChildren(root task, tasks []task) []string {
+	graph := map[string]task{root.name: root}
+	for added := true; added; {
+		added = false
+		for _, t := range tasks {
+			if _, ok := graph[t.name]; ok || t.providerConfig != root.providerConfig || !t.follows(graph) {
+				continue
+			}
+			graph[t.name] = t
+			added = true
+		}
+	}
+	pending := []string{}
+	for name, t := range graph {
+		if name != root.name && t.pending() {
+			pending = append(pending, name)
+		}
+	}
+	sort.Strings(pending)
+	return pending
+}
+
+// normalize returns the supplied qualified name with unquoted parts
+// upper-cased and quotes removed, so that names written with and without
+// quotes compare equal the way Snowflake resolves them.
+func normalize(name string) string {
+	var b, part strings.Builder
+	quoted, wasQuoted := false, false
+	flush := func() {
+		if wasQuoted {
+			b.WriteString(part.String())
+		} else {
+			b.WriteString(strings.ToUpper(part.String()))
+		}
+		part.Reset()
+		wasQuoted = false
+	}
+	for _, r := range strings.TrimSpace(name) {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			wasQuoted = true
+		case r == '.' && !quoted:
+			flush()
+			b.WriteRune('.')
+		default:
+			part.WriteRune(r)
+		}
+	}
+	flush()
+	return b.String()
+}
+
+// normalizeAll returns the supplied qualified names normalized and sorted.
+func normalizeAll(names []string) []string {
+	n := make([]string, 0, len(names))
+	for _, name := range names {
+		n = append(n, normalize(name))
+	}
+	sort.Strings(n)
+	return n
+}
+
+func stringArray(p *fieldpath.Paved, path string) []string {
+	v, _ := p.GetStringArray(path)
+	return v
+}
@@ -0,0 +1,196 @@
+package taskgraph
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	sqlfake "github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+type taskOption func(*v1alpha1.Task)
+
+// newTaskCR returns a created, synced and started Task named after its
+// Snowflake name in ANALYTICS.PIPELINES.
+func newTaskCR(name string, o ...taskOption) *v1alpha1.Task {
+	db, schema, started := "ANALYTICS", "PIPELINES", true
+	fqn := `"ANALYTICS"."PIPELINES"."` + name + `"`
+	cr := &v1alpha1.Task{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.TaskSpec{
+			ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+			ForProvider:  v1alpha1.TaskParameters{Database: &db, Schema: &schema, Name: &name, Started: &started},
+		},
+		Status: v1alpha1.TaskStatus{AtProvider: v1alpha1.TaskObservation{FullyQualifiedName: &fqn, Started: &started}},
+	}
+	meta.SetExternalName(cr, fqn)
+	cr.SetConditions(xpv1.ReconcileSuccess())
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+// after makes the Task run after the supplied Tasks, by reference.
+func after(names ...string) taskOption {
+	return func(cr *v1alpha1.Task) {
+		for _, n := range names {
+			fqn := `"ANALYTICS"."PIPELINES"."` + n + `"`
+			cr.Spec.ForProvider.AfterRefs = append(cr.Spec.ForProvider.AfterRefs, xpv1.Reference{Name: n})
+			cr.Spec.ForProvider.After = append(cr.Spec.ForProvider.After, &fqn)
+			cr.Status.AtProvider.After = append(cr.Status.AtProvider.After, &fqn)
+		}
+	}
+}
+
+// afterName makes the Task run after the supplied Snowflake task, written
+// unquoted.
+func afterName(name string) taskOption {
+	return func(cr *v1alpha1.Task) {
+		desired, observed := "analytics.pipelines."+name, `"ANALYTICS"."PIPELINES"."`+name+`"`
+		cr.Spec.ForProvider.After = append(cr.Spec.ForProvider.After, &desired)
+		cr.Status.AtProvider.After = append(cr.Status.AtProvider.After, &observed)
+	}
+}
+
+func started(desired, observed bool) taskOption {
+	return func(cr *v1alpha1.Task) {
+		cr.Spec.ForProvider.Started = &desired
+		cr.Status.AtProvider.Started = &observed
+	}
+}
+
+func notCreated() taskOption {
+	return func(cr *v1alpha1.Task) {
+		meta.SetExternalName(cr, "")
+		cr.Status.AtProvider = v1alpha1.TaskObservation{}
+	}
+}
+
+func failing() taskOption {
+	return func(cr *v1alpha1.Task) {
+		cr.SetConditions(xpv1.ReconcileError(errors.New("boom")))
+	}
+}
+
+func providerConfig(name string) taskOption {
+	return func(cr *v1alpha1.Task) {
+		cr.Spec.ProviderConfigReference = &xpv1.Reference{Name: name}
+	}
+}
+
+func TestRootSuspenderInitialize(t *testing.T) {
+	suspend := `ALTER TASK "ANALYTICS"."PIPELINES"."ROOT" SUSPEND`
+
+	type want struct {
+		err   error
+		execs []string
+	}
+	cases := map[string]struct {
+		reason string
+		root   *v1alpha1.Task
+		tasks  []*v1alpha1.Task
+		want   want
+	}{
+		"NotRoot": {
+			reason: "A task with predecessors is not a root task.",
+			root:   newTaskCR("LOAD", after("ROOT"), notCreated()),
+			tasks:  []*v1alpha1.Task{newTaskCR("ROOT")},
+		},
+		"SuspendedByUser": {
+			reason: "A root task that should be suspended needs no coordination.",
+			root:   newTaskCR("ROOT", started(false, true)),
+			tasks:  []*v1alpha1.Task{newTaskCR("LOAD", after("ROOT"), notCreated())},
+		},
+		"ChildrenApplied": {
+			reason: "A root task whose children are applied should be reconciled as usual.",
+			root:   newTaskCR("ROOT", started(true, false)),
+			tasks:  []*v1alpha1.Task{newTaskCR("LOAD", after("ROOT")), newTaskCR("MERGE", afterName("LOAD"))},
+		},
+		"ChildNotCreated": {
+			reason: "A started root task should be suspended while a child is created.",
+			root:   newTaskCR("ROOT"),
+			tasks:  []*v1alpha1.Task{newTaskCR("LOAD", after("ROOT"), notCreated())},
+			want: want{
+				err:   errors.Errorf(errChildrenPendingFn, "LOAD"),
+				execs: []string{suspend},
+			},
+		},
+		"AlreadySuspended": {
+			reason: "A suspended root task should stay suspended until its children are started.",
+			root:   newTaskCR("ROOT", started(true, false)),
+			tasks:  []*v1alpha1.Task{newTaskCR("LOAD", after("ROOT"), started(true, false))},
+			want:   want{err: errors.Errorf(errChildrenPendingFn, "LOAD")},
+		},
+		"Grandchildren": {
+			reason: "Tasks that follow children by name should be part of the graph.",
+			root:   newTaskCR("ROOT"),
+			tasks: []*v1alpha1.Task{
+				newTaskCR("LOAD", after("ROOT")),
+				newTaskCR("MERGE", afterName("LOAD"), failing()),
+				newTaskCR("CLEANUP", afterName("MERGE"), notCreated()),
+			},
+			want: want{
+				err:   errors.Errorf(errChildrenPendingFn, "CLEANUP, MERGE"),
+				execs: []string{suspend},
+			},
+		},
+		"OtherGraph": {
+			reason: "Pending tasks of other graphs or accounts should not suspend the root task.",
+			root:   newTaskCR("ROOT"),
+			tasks: []*v1alpha1.Task{
+				newTaskCR("OTHER"),
+				newTaskCR("LOAD", after("OTHER"), notCreated()),
+				newTaskCR("REMOTE", after("ROOT"), providerConfig("other"), notCreated()),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			objs := []client.Object{tc.root}
+			for _, o := range tc.tasks {
+				objs = append(objs, o)
+			}
+			sql := &sqlfake.SQLClient{}
+			r := &RootSuspender{
+				kube:      fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+				newClient: sqlfake.NewSQLClientFn(sql),
+			}
+			err := r.Initialize(context.Background(), tc.root)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.execs, sql.Execs); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want statements, +got statements:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		`analytics.pipelines.load`:        "ANALYTICS.PIPELINES.LOAD",
+		`"ANALYTICS"."PIPELINES"."LOAD"`:  "ANALYTICS.PIPELINES.LOAD",
+		`"analytics"."Pipe.lines"."load"`: "analytics.Pipe.lines.load",
+		` "ANALYTICS".pipelines."Load" `:  "ANALYTICS.PIPELINES.Load",
+	}
+	for name, want := range cases {
+		if got := normalize(name); got != want {
+			t.Errorf("normalize(%q): want %q, got %q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package usage
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/allenkallz/provider-snowflake/internal/clients"
+)
+
+const (
+	// Observation fields that hold the credit usage of a resource monitor.
+	// They are added to the Terraform schema of snowflake_resource_monitor in
+	// the config package.
+	fieldUsedCredits      = "status.atProvider.usedCredits"
+	fieldRemainingCredits = "status.atProvider.remainingCredits"
+
+	errPaveObject = "cannot pave object"
+)
+
+// ResourceMonitorObserver is a managed.Initializer that records the credits
+// a resource monitor has used and has left in the current interval in its
+// status, as top-level fields that are easy to export as metrics. Terraform
+// only reports them as part of show_output, and only as of the previous
+// observation, so SHOW RESOURCE MONITORS is queried before every observation.
+// The fields it sets are not part of the Terraform state and so survive the
+// observation.
+type ResourceMonitorObserver struct {
+	kube      client.Client
+	newClient clients.SQLClientFn
+}
+
+// NewResourceMonitorObserver returns a new ResourceMonitorObserver. It
+// satisfies the signature of upjet's config.NewInitializerFn.
+func NewResourceMonitorObserver(kube client.Client) managed.Initializer {
+	return &ResourceMonitorObserver{kube: kube, newClient: clients.NewSQLClient}
+}
+
+// Initialize records the credit usage of the resource monitor, if it exists.
+// Failing to read it does not block managing the monitor; the previously
+// recorded usage is kept instead.
+func (o *ResourceMonitorObserver) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) || meta.GetExternalName(mg) == "" {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveObject)
+	}
+	name, _ := p.GetString("spec.forProvider.name")
+	if name == "" {
+		return nil
+	}
+
+	c, err := o.newClient(ctx, o.kube, mg)
+	if err != nil {
+		return nil //nolint:nilerr // best effort, see above
+	}
+	defer c.Close() //nolint:errcheck // nothing to do about it
+
+	rows, err := c.Query(ctx, "SHOW RESOURCE MONITORS LIKE "+clients.QuoteString(name))
+	if err != nil {
+		return nil //nolint:nilerr // best effort, see above
+	}
+	// LIKE is case-insensitive and treats _ as a wildcard.
+	var monitor map[string]string
+	for _, r := range rows {
+		if r["name"] == name {
+			monitor = r
+		}
+	}
+	if monitor == nil {
+		return nil
+	}
+
+	observation := map[string]any{}
+	for field, column := range map[string]string{
+		fieldUsedCredits:      "used_credits",
+		fieldRemainingCredits: "remaining_credits",
+	} {
+		if credits, err := strconv.ParseFloat(monitor[column], 64); err == nil {
+			observation[field] = credits
+		}
+	}
+	return clients.SetObservation(mg, p, observation)
+}
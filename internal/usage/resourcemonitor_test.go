@@ -0,0 +1,89 @@
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/fake"
+)
+
+func resourceMonitor(externalName string, o v1alpha1.ResourceMonitorObservation) *v1alpha1.ResourceMonitor {
+	name := "MONTHLY_QUOTA"
+	cr := &v1alpha1.ResourceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "monthly-quota"},
+		Spec: v1alpha1.ResourceMonitorSpec{
+			ForProvider: v1alpha1.ResourceMonitorParameters{Name: &name},
+		},
+		Status: v1alpha1.ResourceMonitorStatus{AtProvider: o},
+	}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func ptr(f float64) *float64 { return &f }
+
+func TestResourceMonitorObserverInitialize(t *testing.T) {
+	query := `SHOW RESOURCE MONITORS LIKE 'MONTHLY_QUOTA'`
+	monitor := map[string]string{"name": "MONTHLY_QUOTA", "credit_quota": "100.00", "used_credits": "42.5", "remaining_credits": "57.5"}
+	previous := v1alpha1.ResourceMonitorObservation{UsedCredits: ptr(40), RemainingCredits: ptr(60)}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.ResourceMonitor
+		sql    *fake.SQLClient
+		want   v1alpha1.ResourceMonitorObservation
+	}{
+		"NotCreated": {
+			reason: "A resource monitor without an external name has no usage.",
+			cr:     resourceMonitor("", v1alpha1.ResourceMonitorObservation{}),
+			sql:    &fake.SQLClient{Rows: map[string][]map[string]string{query: {monitor}}},
+		},
+		"Observed": {
+			reason: "The credits used and left should be recorded.",
+			cr:     resourceMonitor("x", previous),
+			sql:    &fake.SQLClient{Rows: map[string][]map[string]string{query: {monitor}}},
+			want:   v1alpha1.ResourceMonitorObservation{UsedCredits: ptr(42.5), RemainingCredits: ptr(57.5)},
+		},
+		"OtherMonitor": {
+			reason: "Monitors that only match the LIKE pattern should be ignored.",
+			cr:     resourceMonitor("x", previous),
+			sql: &fake.SQLClient{Rows: map[string][]map[string]string{query: {
+				{"name": "MONTHLY-QUOTA", "used_credits": "1", "remaining_credits": "99"},
+			}}},
+			want: previous,
+		},
+		"NoQuota": {
+			reason: "Credits that are not reported should keep their previous value.",
+			cr:     resourceMonitor("x", previous),
+			sql: &fake.SQLClient{Rows: map[string][]map[string]string{query: {
+				{"name": "MONTHLY_QUOTA", "used_credits": "42.5", "remaining_credits": ""},
+			}}},
+			want: v1alpha1.ResourceMonitorObservation{UsedCredits: ptr(42.5), RemainingCredits: ptr(60)},
+		},
+		"QueryFailed": {
+			reason: "The previously recorded usage should be kept if it cannot be read.",
+			cr:     resourceMonitor("x", previous),
+			sql:    &fake.SQLClient{Err: errors.New("boom")},
+			want:   previous,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &ResourceMonitorObserver{newClient: fake.NewSQLClientFn(tc.sql)}
+			if err := o.Initialize(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nInitialize(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}